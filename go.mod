@@ -18,6 +18,8 @@ require (
 	github.com/tidwall/sjson v1.2.5
 	github.com/zRedShift/mimemagic v1.2.0
 	go.uber.org/zap v1.24.0
+	golang.org/x/term v0.6.0
+	gopkg.in/yaml.v2 v2.4.0
 )
 
 require (
@@ -32,8 +34,6 @@ require (
 	github.com/pkg/errors v0.9.1 // indirect
 	github.com/rivo/uniseg v0.2.0 // indirect
 	github.com/segmentio/backo-go v1.0.0 // indirect
-	golang.org/x/term v0.6.0 // indirect
-	gopkg.in/yaml.v2 v2.4.0 // indirect
 )
 
 require (
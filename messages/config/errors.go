@@ -0,0 +1,11 @@
+package config
+
+import "errors"
+
+var (
+	ErrorUnknownKey   = errors.New("Invalid setting provided: %s. The settings are %s")
+	ErrorReadOnlyKey  = errors.New("The %s setting can't be changed with 'azion config'. %s")
+	ErrorProjectKey   = errors.New("The %s setting can't be set for a project. Run the command again without the --project flag")
+	ErrorReadProject  = errors.New("Failed to read the project settings in %s: %w. Check the file and try again")
+	ErrorWriteProject = errors.New("Failed to write the project settings in %s: %w")
+)
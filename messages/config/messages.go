@@ -0,0 +1,57 @@
+package config
+
+var (
+	Usage            = "config"
+	ShortDescription = "Displays and changes the settings of the CLI"
+	LongDescription  = "Displays and changes the settings of the CLI for the profile in use or for the project in the current directory. Values are taken from, in order of precedence, flags, environment variables, the project's azion/config file, the profile in use and the defaults"
+	FlagHelp         = "Displays more information about the config command"
+	FlagShowOrigin   = "Displays where each value comes from"
+	FlagProject      = "Changes the setting for the project in the current directory, in its azion/config file, instead of the profile in use. The URLs, proxy, CA bundle and credential helper can't be set for a project"
+
+	// [ keys ]
+	KeyApiURL           = "URL of the Azion API"
+	KeyStorageURL       = "URL of the Azion storage API"
+	KeyToken            = "Personal token the commands authenticate with. Run 'azion login' to change it"
+	KeyCredentialHelper = "Credential helper that keeps the personal tokens: file, or the name of an azion-credential-<name> program"
+	KeyTimeout          = "Time limit of each call to the Azion API, such as 30s or 2m"
+	KeyUploadTimeout    = "Time limit of each file upload to the storage API, such as 30m"
+	KeyMaxRetries       = "How many times a request that failed because of a connection reset, a gateway error or a rate limit is sent again. 0 disables the retries"
+	KeyRetryMaxWait     = "Longest wait between two attempts of a request, such as 30s"
+	KeyProxy            = "URL of the proxy the requests go through. When empty, the HTTPS_PROXY, HTTP_PROXY and NO_PROXY environment variables are used"
+	KeyCABundle         = "Path of a PEM file with certificate authorities to trust besides the ones of the system, for proxies that inspect TLS traffic"
+
+	// [ origins ]
+	OriginFlag     = "flag --%s"
+	OriginEnv      = "environment variable %s"
+	OriginProject  = "project file %s"
+	OriginProfile  = "profile %s in %s"
+	OriginSettings = "settings file %s"
+	OriginDefault  = "default"
+
+	// [ get ]
+	GetUsage            = "get <key>"
+	GetShortDescription = "Displays the value of a setting"
+	GetLongDescription  = "Displays the value a setting has for the commands run in the current directory. Secret values are masked"
+	GetHelpFlag         = "Displays more information about the config get command"
+	GetOutput           = "%s\n"
+	GetOutputOrigin     = "%s\t(%s)\n"
+
+	// [ set ]
+	SetUsage            = "set <key> <value>"
+	SetShortDescription = "Changes the value of a setting"
+	SetLongDescription  = "Changes the value of a setting for the profile in use, or with the --project flag for the project in the current directory"
+	SetHelpFlag         = "Displays more information about the config set command"
+	SetOutputSuccess    = "Set %s to %s in %s\n"
+
+	// [ unset ]
+	UnsetUsage            = "unset <key>"
+	UnsetShortDescription = "Removes the value of a setting"
+	UnsetLongDescription  = "Removes the value of a setting from the profile in use, or with the --project flag from the project in the current directory, so that it's taken from the next source in order of precedence"
+	UnsetHelpFlag         = "Displays more information about the config unset command"
+	UnsetOutputSuccess    = "Removed %s from %s\n"
+
+	// [ list ]
+	ListShortDescription = "Displays the settings in a list"
+	ListLongDescription  = "Displays the settings and the values they have for the commands run in the current directory. Secret values are masked"
+	ListHelpFlag         = "Displays more information about the config list command"
+)
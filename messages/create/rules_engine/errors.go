@@ -3,7 +3,7 @@ package rules_engine
 import "errors"
 
 var (
-	ErrorCreateRulesEngine    = errors.New("Failed to create the rule in Rules Engine: %s. Check your settings and try again. If the error persists, contact Azion support.")
+	ErrorCreateRulesEngine    = errors.New("Failed to create the rule in Rules Engine: %w. Check your settings and try again. If the error persists, contact Azion support.")
 	ErrorNameEmpty            = errors.New("The name field shouldn't be empty")
 	ErrorConditionalEmpty     = errors.New("The conditional field shouldn't be empty")
 	ErrorVariableEmpty        = errors.New("The variable field shouldn't be empty")
@@ -0,0 +1,25 @@
+package datastreaming
+
+import "errors"
+
+var (
+	ErrorCreate          = errors.New("Failed to create the Data Streaming: %w. Check your settings and try again. If the error persists, contact Azion support.")
+	ErrorUpdate          = errors.New("Failed to update the Data Streaming: %w. Check your settings and try again. If the error persists, contact Azion support.")
+	ErrorGet             = errors.New("Failed to describe the Data Streaming: %w. Check your settings and try again. If the error persists, contact Azion support.")
+	ErrorList            = errors.New("Failed to list your Data Streamings: %w. Check your settings and try again. If the error persists, contact Azion support.")
+	ErrorDelete          = errors.New("Failed to delete the Data Streaming: %w. Check your settings and try again. If the error persists, contact Azion support.")
+	ErrorConvertID       = errors.New("The Data Streaming ID you provided is invalid. The value must be an integer. Run the command 'azion list data-streaming' to check your Data Streaming ID and try again")
+	ErrorConvertTemplate = errors.New("The template ID you provided is invalid. The value must be an integer. Run the command 'azion list data-streaming-template' to check the templates and try again")
+	ErrorActiveFlag      = errors.New("Invalid --active flag provided. The value must be 'true' or 'false'. Run the command 'azion <subcommand> data-streaming --help' to display more information and try again")
+	ErrorAllDomainsFlag  = errors.New("Invalid --all-domains flag provided. The value must be 'true' or 'false'. Run the command 'azion <subcommand> data-streaming --help' to display more information and try again")
+	ErrorDataSource      = errors.New("Invalid --data-source flag provided: %q. The sources are http, waf, cells_console and rtm_activity")
+	ErrorEndpointFlags   = errors.New("The --endpoint-file and --endpoint-url flags both set the endpoint, and can't be used together")
+	ErrorEndpointFile    = errors.New("Failed to read the endpoint from %s: %w")
+	ErrorNoEndpoint      = errors.New("The Data Streaming has no endpoint. Provide it with the --endpoint-file or --endpoint-url flags and try again")
+	ErrorEndpointType    = errors.New("Invalid endpoint_type provided: %q. The types are %s")
+	ErrorEndpointMissing = errors.New("The %s endpoint is missing the attributes %s. Add them and try again")
+	ErrorDomainsFlags    = errors.New("The --domains flag can't be used with --all-domains true, which already streams the events of every domain")
+	ErrorSampling        = errors.New("Invalid sampling percentage provided: %d. The value must be from 0 to 100")
+	ErrorSamplingFlags   = errors.New("The --sampling-percentage and --no-sampling flags can't be used together")
+	ErrorNoUpdateFlags   = errors.New("No values to update. Provide at least one flag with a new value, or the --file flag. Run the command 'azion update data-streaming --help' to display more information and try again")
+)
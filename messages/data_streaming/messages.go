@@ -0,0 +1,53 @@
+package datastreaming
+
+var (
+	Usage = "data-streaming"
+
+	// [ general ]
+	FlagDataStreamingID    = "Unique identifier of the Data Streaming"
+	FlagName               = "The Data Streaming's name"
+	FlagTemplateID         = "Unique identifier of the template that formats the events. Run the command 'azion list data-streaming-template' to check the templates"
+	FlagDataSource         = "The source of the events: <http|waf|cells_console|rtm_activity>"
+	FlagActive             = "Whether the Data Streaming is active or not <true|false>"
+	FlagEndpointFile       = "Path to a JSON file, or a YAML one with the .yaml or .yml extension, with the endpoint the events are sent to, such as an HTTP, S3-compatible or Kafka endpoint; you can use - for reading JSON from stdin"
+	FlagEndpointURL        = "URL that receives the events through HTTP POST requests, a shorthand for a standard endpoint"
+	FlagDomains            = "IDs of the domains whose events are streamed, replacing the current ones; for example: 1234,5678"
+	FlagAllDomains         = "Streams the events of all domains of the account, including the ones created later <true|false>"
+	FlagSamplingPercentage = "Streams only this percentage of the events, from 0 to 100"
+	FlagNoSampling         = "Disables sampling, streaming all of the events"
+	FileWritten            = "File successfully written to: %s\n"
+	AskDataStreamingID     = "Enter the Data Streaming's ID:"
+	AskName                = "Enter the Data Streaming's name:"
+	AskTemplateID          = "Enter the ID of the Data Streaming's template:"
+
+	// [ create ]
+	CreateShortDescription = "Creates a new Data Streaming"
+	CreateLongDescription  = "Creates a Data Streaming, which sends the events of your domains, such as HTTP requests and WAF matches, to an endpoint like a SIEM, an S3-compatible bucket or a Kafka topic"
+	CreateFlagFile         = "Path to a JSON file, or a YAML one with the .yaml or .yml extension, containing the attributes of the Data Streaming that will be created; you can use - for reading JSON from stdin"
+	CreateOutputSuccess    = "Created Data Streaming with ID %d\n"
+	CreateHelpFlag         = "Displays more information about the create data-streaming command"
+
+	// [ update ]
+	UpdateShortDescription = "Modifies a Data Streaming"
+	UpdateLongDescription  = "Modifies a Data Streaming based on its ID to update its endpoint, domains, sampling and other attributes. Attributes that aren't given keep their values"
+	UpdateFlagFile         = "Path to a JSON file, or a YAML one with the .yaml or .yml extension, containing the attributes of the Data Streaming that will be updated; you can use - for reading JSON from stdin"
+	UpdateOutputSuccess    = "Updated Data Streaming with ID %d\n"
+	UpdateHelpFlag         = "Displays more information about the update data-streaming command"
+
+	// [ describe ]
+	DescribeShortDescription = "Returns the Data Streaming data"
+	DescribeLongDescription  = "Displays information about the Data Streaming via a given ID to show its attributes in detail. The credentials of the endpoint are masked"
+	DescribeFlagOut          = "Exports the output to the given <file_path/file_name.ext>"
+	DescribeHelpFlag         = "Displays more information about the describe data-streaming command"
+
+	// [ list ]
+	ListShortDescription = "Displays your Data Streamings"
+	ListLongDescription  = "Displays all Data Streamings of your account"
+	ListHelpFlag         = "Displays more information about the list data-streaming command"
+
+	// [ delete ]
+	DeleteShortDescription = "Removes a Data Streaming"
+	DeleteLongDescription  = "Removes a Data Streaming based on its ID"
+	DeleteOutputSuccess    = "Data Streaming %d was successfully deleted\n"
+	DeleteHelpFlag         = "Displays more information about the delete data-streaming command"
+)
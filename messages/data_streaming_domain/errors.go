@@ -0,0 +1,8 @@
+package datastreamingdomain
+
+import "errors"
+
+var (
+	ErrorList          = errors.New("Failed to list the Data Streaming domains: %w. Check your settings and try again. If the error persists, contact Azion support.")
+	ErrorSelectedFlags = errors.New("The --selected flag requires the --data-streaming-id flag")
+)
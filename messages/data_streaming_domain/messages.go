@@ -0,0 +1,12 @@
+package datastreamingdomain
+
+var (
+	Usage = "data-streaming-domain"
+
+	// [ list ]
+	ListShortDescription    = "Displays the domains that can stream their events"
+	ListLongDescription     = "Displays the domains of your account that Data Streamings can send the events of. Given a Data Streaming, tells which of them it streams"
+	ListFlagDataStreamingID = "Unique identifier of a Data Streaming, to tell which domains it streams"
+	ListFlagSelected        = "Displays only the domains the Data Streaming streams"
+	ListHelpFlag            = "Displays more information about the list data-streaming-domain command"
+)
@@ -0,0 +1,9 @@
+package datastreamingtemplate
+
+import "errors"
+
+var (
+	ErrorGet       = errors.New("Failed to describe the Data Streaming template: %w. Check your settings and try again. If the error persists, contact Azion support.")
+	ErrorList      = errors.New("Failed to list the Data Streaming templates: %w. Check your settings and try again. If the error persists, contact Azion support.")
+	ErrorConvertID = errors.New("The template ID you provided is invalid. The value must be an integer. Run the command 'azion list data-streaming-template' to check the templates and try again")
+)
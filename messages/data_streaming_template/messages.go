@@ -0,0 +1,21 @@
+package datastreamingtemplate
+
+var (
+	Usage = "data-streaming-template"
+
+	// [ general ]
+	FlagTemplateID = "Unique identifier of the Data Streaming template"
+	FileWritten    = "File successfully written to: %s\n"
+	AskTemplateID  = "Enter the Data Streaming template's ID:"
+
+	// [ describe ]
+	DescribeShortDescription = "Returns the Data Streaming template data"
+	DescribeLongDescription  = "Displays the Data Streaming template via a given ID, including the model of the events it formats"
+	DescribeFlagOut          = "Exports the output to the given <file_path/file_name.ext>"
+	DescribeHelpFlag         = "Displays more information about the describe data-streaming-template command"
+
+	// [ list ]
+	ListShortDescription = "Displays the Data Streaming templates"
+	ListLongDescription  = "Displays the templates that format the events of Data Streamings, the ones Azion provides and the custom ones of your account"
+	ListHelpFlag         = "Displays more information about the list data-streaming-template command"
+)
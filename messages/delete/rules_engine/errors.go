@@ -5,5 +5,5 @@ import "errors"
 var (
 	ErrorConvertIdRule        = errors.New("The Rules Engine ID you provided is invalid. The value must be an integer. You may run the 'azion list rules-engine' command to check your Rules Engine ID")
 	ErrorConvertIdApplication = errors.New("The application ID you provided is invalid. The value must be an integer. You may run the 'azion list edge-application' command to check your application ID")
-	ErrorFailToDelete         = errors.New("Failed to delete the rule in Rules Engine: %s. Check your settings and try again. If the error persists, contact Azion support.")
+	ErrorFailToDelete         = errors.New("Failed to delete the rule in Rules Engine: %w. Check your settings and try again. If the error persists, contact Azion support.")
 )
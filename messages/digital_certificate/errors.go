@@ -0,0 +1,30 @@
+package digitalcertificate
+
+import "errors"
+
+var (
+	ErrorCreate                  = errors.New("Failed to create the Digital Certificate: %w. Check your settings and try again. If the error persists, contact Azion support.")
+	ErrorUpdate                  = errors.New("Failed to update the Digital Certificate: %w. Check your settings and try again. If the error persists, contact Azion support.")
+	ErrorGet                     = errors.New("Failed to describe the Digital Certificate: %w. Check your settings and try again. If the error persists, contact Azion support.")
+	ErrorList                    = errors.New("Failed to list your Digital Certificates: %w. Check your settings and try again. If the error persists, contact Azion support.")
+	ErrorDelete                  = errors.New("Failed to delete the Digital Certificate: %w. Check your settings and try again. If the error persists, contact Azion support.")
+	ErrorGetDomain               = errors.New("Failed to get the CNAMEs of the Domain %d: %w. Check your settings and try again. If the error persists, contact Azion support.")
+	ErrorConvertID               = errors.New("The Digital Certificate ID you provided is invalid. The value must be an integer. Run the command 'azion <command> digital-certificate --help' to display more information and try again")
+	ErrorMissingCertificateFlags = errors.New("Required flags are missing. You must provide the --certificate and --private-key flags, or the --file flag. Run the command 'azion create digital-certificate --help' to display more information and try again")
+	ErrorCertificateWithoutKey   = errors.New("The --certificate and --private-key flags must be provided together. Run the command 'azion update digital-certificate --help' to display more information and try again")
+	ErrorNoUpdateFlags           = errors.New("No values to update. You must provide --name, --certificate and --private-key, or the --file flag. Run the command 'azion update digital-certificate --help' to display more information and try again")
+	ErrorReadFile                = errors.New("Failed to read the file %s: %w. Verify that the path is correct and try again")
+	ErrorLocalValidation         = errors.New("The certificate failed the local validation:\n%w\nFix the files and try again, or use the --skip-validation flag to upload them as they are")
+
+	// [ local validation ]
+	ErrorNoCertificate       = errors.New("no PEM encoded certificate was found in %s")
+	ErrorParseCertificate    = errors.New("failed to parse the certificate in %s: %w")
+	ErrorNoPrivateKey        = errors.New("no PEM encoded private key was found in %s")
+	ErrorParsePrivateKey     = errors.New("failed to parse the private key in %s: %w")
+	ErrorCertificateExpired  = errors.New("the certificate expired on %s")
+	ErrorCertificateNotYet   = errors.New("the certificate is only valid from %s")
+	ErrorChainExpired        = errors.New("the chain certificate '%s' expired on %s")
+	ErrorChainOrder          = errors.New("the certificate was not issued by the first chain certificate '%s'; the chain must start with the issuer of the certificate")
+	ErrorKeyMismatch         = errors.New("the private key does not match the certificate's public key")
+	ErrorHostnamesNotCovered = errors.New("the certificate's subject alternative names (%s) do not cover %s")
+)
@@ -0,0 +1,49 @@
+package digitalcertificate
+
+var (
+	Usage = "digital-certificate"
+
+	// [ general ]
+	FlagDigitalCertificateID = "Unique identifier of the Digital Certificate"
+	FlagName                 = "The Digital Certificate's name"
+	FlagCertificate          = "Path to the PEM file with the certificate"
+	FlagChain                = "Path to the PEM file with the intermediate certificates, appended to the certificate on upload"
+	FlagPrivateKey           = "Path to the PEM file with the certificate's private key"
+	FlagDomainID             = "Unique identifier of a Domain whose CNAMEs the certificate must cover; repeat it to check more Domains"
+	FlagSkipValidation       = "Uploads the certificate without validating it locally first"
+	FileWritten              = "File successfully written to: %s\n"
+	WarningExpiresSoon       = "The certificate expires in %d days, on %s"
+	AskDigitalCertificateID  = "Enter the Digital Certificate's ID:"
+	AskName                  = "Enter the Digital Certificate's name:"
+
+	// [ create ]
+	CreateShortDescription = "Uploads a new Digital Certificate"
+	CreateLongDescription  = "Uploads a PEM certificate, its chain and private key, validating locally that they are in effect, match each other and cover the CNAMEs of the given Domains"
+	CreateFlagFile         = "Path to a JSON file containing the attributes of the Digital Certificate that will be created; you can use - for reading from stdin"
+	CreateOutputSuccess    = "Created Digital Certificate with ID %d\n"
+	CreateHelpFlag         = "Displays more information about the create digital-certificate command"
+
+	// [ update ]
+	UpdateShortDescription = "Modifies a Digital Certificate"
+	UpdateLongDescription  = "Renames a Digital Certificate or replaces its certificate, chain and private key, validating the new files locally before uploading them"
+	UpdateFlagFile         = "Path to a JSON file containing the attributes of the Digital Certificate that will be updated; you can use - for reading from stdin"
+	UpdateOutputSuccess    = "Updated Digital Certificate with ID %d\n"
+	UpdateHelpFlag         = "Displays more information about the update digital-certificate command"
+
+	// [ describe ]
+	DescribeShortDescription = "Returns the Digital Certificate data"
+	DescribeLongDescription  = "Displays information about the Digital Certificate via a given ID to show its attributes in detail"
+	DescribeFlagOut          = "Exports the output to the given <file_path/file_name.ext>"
+	DescribeHelpFlag         = "Displays more information about the describe digital-certificate command"
+
+	// [ list ]
+	ListShortDescription = "Displays your Digital Certificates"
+	ListLongDescription  = "Displays all Digital Certificates of your account with their expiry dates"
+	ListHelpFlag         = "Displays more information about the list digital-certificate command"
+
+	// [ delete ]
+	DeleteShortDescription = "Removes a Digital Certificate"
+	DeleteLongDescription  = "Removes a Digital Certificate based on its ID"
+	DeleteOutputSuccess    = "Digital Certificate %d was successfully deleted\n"
+	DeleteHelpFlag         = "Displays more information about the delete digital-certificate command"
+)
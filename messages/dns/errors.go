@@ -0,0 +1,15 @@
+package dns
+
+import "errors"
+
+var (
+	ErrorReadZoneFile = errors.New("Failed to read the zone file %s: %w. Check the file and try again")
+	ErrorNoDomain     = errors.New("The zone's domain is unknown. Add an $ORIGIN directive to the zone file or provide the --domain flag and try again")
+	ErrorNoRecords    = errors.New("The zone file has no records that Edge DNS can serve")
+	ErrorGetZone      = errors.New("Failed to read the Edge DNS Zone: %w. Check your settings and try again. If the error persists, contact Azion support.")
+	ErrorCreateZone   = errors.New("Failed to create the Edge DNS Zone: %w. Check your settings and try again. If the error persists, contact Azion support.")
+	ErrorListRecords  = errors.New("Failed to list the records of the Edge DNS Zone: %w. Check your settings and try again. If the error persists, contact Azion support.")
+	ErrorImport       = errors.New("%d of %d records failed to be created. Fix them and run the import again; the records that were created are skipped")
+	ErrorWriteZone    = errors.New("Failed to write the zone file: %w")
+	ErrorConvertID    = errors.New("The Edge DNS Zone ID you provided is invalid. The value must be an integer. Run the command 'azion list dns-zone' to check your Edge DNS Zone ID and try again")
+)
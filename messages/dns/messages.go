@@ -0,0 +1,39 @@
+package dns
+
+var (
+	Usage            = "dns"
+	ShortDescription = "Imports and exports Edge DNS Zones as zone files"
+	LongDescription  = "Moves DNS zones to and from Edge DNS using the BIND zone file format, which most DNS providers import and export"
+	FlagHelp         = "Displays more information about the dns command"
+
+	// [ general ]
+	FlagZoneID  = "Unique identifier of the Edge DNS Zone"
+	FileWritten = "File successfully written to: %s\n"
+	AskZoneID   = "Enter the Edge DNS Zone's ID:"
+	AskZoneFile = "Enter the path to the zone file:"
+
+	// [ import ]
+	ImportUsage            = "import"
+	ImportShortDescription = "Creates the records of a zone file in an Edge DNS Zone"
+	ImportLongDescription  = "Reads a BIND zone file and creates its records in an Edge DNS Zone, which is created when the account has none for the zone's domain. Records with the same name and type are created as a single record with many answers. SOA records and the zone's own NS records are left out, since Edge DNS answers with its own"
+	ImportFlagZoneFile     = "Path to the zone file; you can use - for reading from stdin"
+	ImportFlagDomain       = "The zone's domain, for zone files without an $ORIGIN directive. By default, it's the $ORIGIN of the zone file, or its name without a db. prefix"
+	ImportFlagTTL          = "TTL in seconds of the records without one in the zone file"
+	ImportFlagDryRun       = "Displays the records that would be created, without creating them"
+	ImportHelpFlag         = "Displays more information about the dns import command"
+	ImportZoneCreated      = "Created Edge DNS Zone %s with ID %d\n"
+	ImportRecord           = "%s %s: %s\n"
+	ImportRecordFailed     = "%s %s failed: %s\n"
+	ImportDryRun           = "%d records would be created in the %s zone\n"
+	ImportSkippedType      = "Skipping %s %s on line %d: Edge DNS doesn't serve %s records"
+	ImportSkippedSOA       = "Skipping %s %s on line %d: Edge DNS answers with its own %s records for the zone"
+	ImportSkippedExisting  = "Skipping %s %s: the zone already has a record with this name and type"
+	ImportOutputSuccess    = "Imported %d records into the %s zone"
+
+	// [ export ]
+	ExportUsage            = "export"
+	ExportShortDescription = "Writes an Edge DNS Zone as a zone file"
+	ExportLongDescription  = "Writes the records of an Edge DNS Zone as a BIND zone file, which other DNS providers and servers can import"
+	ExportFlagOut          = "Path of the zone file that will be written. By default, it's written to the standard output"
+	ExportHelpFlag         = "Displays more information about the dns export command"
+)
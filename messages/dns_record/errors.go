@@ -0,0 +1,17 @@
+package dnsrecord
+
+import "errors"
+
+var (
+	ErrorCreate          = errors.New("Failed to create the Edge DNS Record: %w. Check your settings and try again. If the error persists, contact Azion support.")
+	ErrorUpdate          = errors.New("Failed to update the Edge DNS Record: %w. Check your settings and try again. If the error persists, contact Azion support.")
+	ErrorGet             = errors.New("Failed to describe the Edge DNS Record: %w. Check your settings and try again. If the error persists, contact Azion support.")
+	ErrorList            = errors.New("Failed to list the Edge DNS Records: %w. Check your settings and try again. If the error persists, contact Azion support.")
+	ErrorDelete          = errors.New("Failed to delete the Edge DNS Record: %w. Check your settings and try again. If the error persists, contact Azion support.")
+	ErrorConvertZoneID   = errors.New("The Edge DNS Zone ID you provided is invalid. The value must be an integer. Run the command 'azion list dns-zone' to check your Edge DNS Zone ID and try again")
+	ErrorConvertRecordID = errors.New("The Edge DNS Record ID you provided is invalid. The value must be an integer. Run the command 'azion list dns-record' to check your Edge DNS Record ID and try again")
+	ErrorRecordType      = errors.New("Invalid --record-type flag provided: %q. The types are A, AAAA, ANAME, CAA, CNAME, DS, MX, NS, PTR, SRV and TXT")
+	ErrorPolicy          = errors.New("Invalid --policy flag provided: %q. The policies are simple and weighted")
+	ErrorMissingAnswers  = errors.New("The record has no answers. Provide them with the --answers flag and try again")
+	ErrorNoUpdateFlags   = errors.New("No values to update. Provide at least one flag with a new value, or the --file flag. Run the command 'azion update dns-record --help' to display more information and try again")
+)
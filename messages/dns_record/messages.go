@@ -0,0 +1,52 @@
+package dnsrecord
+
+var (
+	Usage = "dns-record"
+
+	// [ general ]
+	FlagZoneID      = "Unique identifier of the Edge DNS Zone the record belongs to"
+	FlagRecordID    = "Unique identifier of the Edge DNS Record"
+	FlagEntry       = "The name of the record within the zone, such as www; use @ for the zone's domain itself"
+	FlagRecordType  = "The record's type: <A|AAAA|ANAME|CAA|CNAME|DS|MX|NS|PTR|SRV|TXT>"
+	FlagAnswers     = "The values the record answers with; for example: 192.0.2.1,192.0.2.2"
+	FlagTTL         = "Time in seconds resolvers may cache the record's answers"
+	FlagDescription = "A description of the record"
+	FlagPolicy      = "How the record answers: <simple|weighted>. Weighted records answer with one of the records of the same entry, picked by their weights"
+	FlagWeight      = "The share of answers of a weighted record, from 0 to 255"
+	FileWritten     = "File successfully written to: %s\n"
+	AskZoneID       = "Enter the Edge DNS Zone's ID:"
+	AskRecordID     = "Enter the Edge DNS Record's ID:"
+	AskEntry        = "Enter the record's entry (use @ for the zone's domain):"
+	AskRecordType   = "Enter the record's type:"
+
+	// [ create ]
+	CreateShortDescription = "Creates a new Edge DNS Record"
+	CreateLongDescription  = "Creates a record in an Edge DNS Zone, which answers the queries of an entry and type"
+	CreateFlagFile         = "Path to a JSON file containing the attributes of the Edge DNS Record that will be created; you can use - for reading from stdin"
+	CreateOutputSuccess    = "Created Edge DNS Record with ID %d\n"
+	CreateHelpFlag         = "Displays more information about the create dns-record command"
+
+	// [ update ]
+	UpdateShortDescription = "Modifies an Edge DNS Record"
+	UpdateLongDescription  = "Modifies a record of an Edge DNS Zone based on their IDs"
+	UpdateFlagFile         = "Path to a JSON file containing the attributes of the Edge DNS Record that will be updated; you can use - for reading from stdin"
+	UpdateOutputSuccess    = "Updated Edge DNS Record with ID %d\n"
+	UpdateHelpFlag         = "Displays more information about the update dns-record command"
+
+	// [ describe ]
+	DescribeShortDescription = "Returns the Edge DNS Record data"
+	DescribeLongDescription  = "Displays information about a record of an Edge DNS Zone via their IDs to show its attributes in detail"
+	DescribeFlagOut          = "Exports the output to the given <file_path/file_name.ext>"
+	DescribeHelpFlag         = "Displays more information about the describe dns-record command"
+
+	// [ list ]
+	ListShortDescription = "Displays the records of an Edge DNS Zone"
+	ListLongDescription  = "Displays all records of an Edge DNS Zone"
+	ListHelpFlag         = "Displays more information about the list dns-record command"
+
+	// [ delete ]
+	DeleteShortDescription = "Removes an Edge DNS Record"
+	DeleteLongDescription  = "Removes a record of an Edge DNS Zone based on their IDs"
+	DeleteOutputSuccess    = "Edge DNS Record %d was successfully deleted\n"
+	DeleteHelpFlag         = "Displays more information about the delete dns-record command"
+)
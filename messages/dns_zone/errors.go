@@ -0,0 +1,14 @@
+package dnszone
+
+import "errors"
+
+var (
+	ErrorCreate        = errors.New("Failed to create the Edge DNS Zone: %w. Check your settings and try again. If the error persists, contact Azion support.")
+	ErrorUpdate        = errors.New("Failed to update the Edge DNS Zone: %w. Check your settings and try again. If the error persists, contact Azion support.")
+	ErrorGet           = errors.New("Failed to describe the Edge DNS Zone: %w. Check your settings and try again. If the error persists, contact Azion support.")
+	ErrorList          = errors.New("Failed to list your Edge DNS Zones: %w. Check your settings and try again. If the error persists, contact Azion support.")
+	ErrorDelete        = errors.New("Failed to delete the Edge DNS Zone: %w. Check your settings and try again. If the error persists, contact Azion support.")
+	ErrorConvertID     = errors.New("The Edge DNS Zone ID you provided is invalid. The value must be an integer. Run the command 'azion list dns-zone' to check your Edge DNS Zone ID and try again")
+	ErrorActiveFlag    = errors.New("Invalid --active flag provided. The value must be 'true' or 'false'. Run the command 'azion <subcommand> dns-zone --help' to display more information and try again")
+	ErrorNoUpdateFlags = errors.New("No values to update. Provide at least one flag with a new value, or the --file flag. Run the command 'azion update dns-zone --help' to display more information and try again")
+)
@@ -0,0 +1,45 @@
+package dnszone
+
+var (
+	Usage = "dns-zone"
+
+	// [ general ]
+	FlagZoneID  = "Unique identifier of the Edge DNS Zone"
+	FlagName    = "The Edge DNS Zone's name"
+	FlagDomain  = "The domain the Edge DNS Zone answers for; for example: example.com"
+	FlagActive  = "Whether the Edge DNS Zone is active or not <true|false>"
+	FileWritten = "File successfully written to: %s\n"
+	AskZoneID   = "Enter the Edge DNS Zone's ID:"
+	AskDomain   = "Enter the Edge DNS Zone's domain:"
+
+	// [ create ]
+	CreateShortDescription = "Creates a new Edge DNS Zone"
+	CreateLongDescription  = "Creates an Edge DNS Zone, which answers the DNS queries of a domain with its records"
+	CreateFlagFile         = "Path to a JSON file containing the attributes of the Edge DNS Zone that will be created; you can use - for reading from stdin"
+	CreateOutputSuccess    = "Created Edge DNS Zone with ID %d\n"
+	CreateHelpFlag         = "Displays more information about the create dns-zone command"
+
+	// [ update ]
+	UpdateShortDescription = "Modifies an Edge DNS Zone"
+	UpdateLongDescription  = "Modifies an Edge DNS Zone based on its ID to update its name, domain and activity status"
+	UpdateFlagFile         = "Path to a JSON file containing the attributes of the Edge DNS Zone that will be updated; you can use - for reading from stdin"
+	UpdateOutputSuccess    = "Updated Edge DNS Zone with ID %d\n"
+	UpdateHelpFlag         = "Displays more information about the update dns-zone command"
+
+	// [ describe ]
+	DescribeShortDescription = "Returns the Edge DNS Zone data"
+	DescribeLongDescription  = "Displays information about the Edge DNS Zone via a given ID to show its attributes in detail"
+	DescribeFlagOut          = "Exports the output to the given <file_path/file_name.ext>"
+	DescribeHelpFlag         = "Displays more information about the describe dns-zone command"
+
+	// [ list ]
+	ListShortDescription = "Displays your Edge DNS Zones"
+	ListLongDescription  = "Displays all Edge DNS Zones of your account"
+	ListHelpFlag         = "Displays more information about the list dns-zone command"
+
+	// [ delete ]
+	DeleteShortDescription = "Removes an Edge DNS Zone"
+	DeleteLongDescription  = "Removes an Edge DNS Zone, along with all of its records, based on its ID"
+	DeleteOutputSuccess    = "Edge DNS Zone %d was successfully deleted\n"
+	DeleteHelpFlag         = "Displays more information about the delete dns-zone command"
+)
@@ -0,0 +1,15 @@
+package edgefirewall
+
+import "errors"
+
+var (
+	ErrorCreate        = errors.New("Failed to create the Edge Firewall: %w. Check your settings and try again. If the error persists, contact Azion support.")
+	ErrorUpdate        = errors.New("Failed to update the Edge Firewall: %w. Check your settings and try again. If the error persists, contact Azion support.")
+	ErrorGet           = errors.New("Failed to describe the Edge Firewall: %w. Check your settings and try again. If the error persists, contact Azion support.")
+	ErrorList          = errors.New("Failed to list your Edge Firewalls: %w. Check your settings and try again. If the error persists, contact Azion support.")
+	ErrorDelete        = errors.New("Failed to delete the Edge Firewall: %w. Check your settings and try again. If the error persists, contact Azion support.")
+	ErrorConvertID     = errors.New("The Edge Firewall ID you provided is invalid. The value must be an integer. Run the command 'azion list edge-firewall' to check your Edge Firewall ID and try again")
+	ErrorBoolFlag      = errors.New("Invalid --%s flag provided. The flag must have 'true' or 'false' values. Run the command 'azion <command> edge-firewall --help' to display more information and try again")
+	ErrorDomainsFlags  = errors.New("The --domains flag can't be used with --add-domains or --remove-domains. Run the command 'azion update edge-firewall --help' to display more information and try again")
+	ErrorNoUpdateFlags = errors.New("No values to update. Provide at least one flag with a new value, or the --file flag. Run the command 'azion update edge-firewall --help' to display more information and try again")
+)
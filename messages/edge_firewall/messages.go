@@ -0,0 +1,50 @@
+package edgefirewall
+
+var (
+	Usage = "edge-firewall"
+
+	// [ general ]
+	FlagEdgeFirewallID           = "Unique identifier of the Edge Firewall"
+	FlagName                     = "The Edge Firewall's name"
+	FlagActive                   = "Whether the Edge Firewall is active or not: <true|false>"
+	FlagEdgeFunctionsEnabled     = "Whether the Edge Firewall can run Edge Functions or not: <true|false>"
+	FlagNetworkProtectionEnabled = "Whether the Edge Firewall enforces Network Lists or not: <true|false>"
+	FlagWafEnabled               = "Whether the Edge Firewall can apply WAF Rule Sets or not: <true|false>"
+	FlagDomains                  = "IDs of the Domains protected by the Edge Firewall, replacing the current ones; for example: 1234,5678"
+	FileWritten                  = "File successfully written to: %s\n"
+	AskEdgeFirewallID            = "Enter the Edge Firewall's ID:"
+	AskName                      = "Enter the Edge Firewall's name:"
+
+	// [ create ]
+	CreateShortDescription = "Creates a new Edge Firewall"
+	CreateLongDescription  = "Creates an Edge Firewall based on given attributes, optionally protecting the given Domains"
+	CreateFlagFile         = "Path to a JSON file containing the attributes of the Edge Firewall that will be created; you can use - for reading from stdin"
+	CreateOutputSuccess    = "Created Edge Firewall with ID %d\n"
+	CreateHelpFlag         = "Displays more information about the create edge-firewall command"
+
+	// [ update ]
+	UpdateShortDescription = "Modifies an Edge Firewall"
+	UpdateLongDescription  = "Modifies an Edge Firewall based on its ID, including the Domains it protects"
+	UpdateFlagFile         = "Path to a JSON file containing the attributes of the Edge Firewall that will be updated; you can use - for reading from stdin"
+	UpdateFlagAddDomains   = "IDs of Domains to protect with the Edge Firewall, keeping the current ones"
+	UpdateFlagRemoveDomain = "IDs of Domains the Edge Firewall stops protecting"
+	UpdateOutputSuccess    = "Updated Edge Firewall with ID %d\n"
+	UpdateHelpFlag         = "Displays more information about the update edge-firewall command"
+
+	// [ describe ]
+	DescribeShortDescription = "Returns the Edge Firewall data"
+	DescribeLongDescription  = "Displays information about the Edge Firewall via a given ID to show its attributes in detail"
+	DescribeFlagOut          = "Exports the output to the given <file_path/file_name.ext>"
+	DescribeHelpFlag         = "Displays more information about the describe edge-firewall command"
+
+	// [ list ]
+	ListShortDescription = "Displays your Edge Firewalls"
+	ListLongDescription  = "Displays all Edge Firewalls of your account"
+	ListHelpFlag         = "Displays more information about the list edge-firewall command"
+
+	// [ delete ]
+	DeleteShortDescription = "Removes an Edge Firewall"
+	DeleteLongDescription  = "Removes an Edge Firewall based on its ID"
+	DeleteOutputSuccess    = "Edge Firewall %d was successfully deleted\n"
+	DeleteHelpFlag         = "Displays more information about the delete edge-firewall command"
+)
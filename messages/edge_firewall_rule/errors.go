@@ -0,0 +1,17 @@
+package edgefirewallrule
+
+import "errors"
+
+var (
+	ErrorCreate                = errors.New("Failed to create the Edge Firewall rule: %w. Check your settings and try again. If the error persists, contact Azion support.")
+	ErrorUpdate                = errors.New("Failed to update the Edge Firewall rule: %w. Check your settings and try again. If the error persists, contact Azion support.")
+	ErrorGet                   = errors.New("Failed to describe the Edge Firewall rule: %w. Check your settings and try again. If the error persists, contact Azion support.")
+	ErrorList                  = errors.New("Failed to list the Edge Firewall rules: %w. Check your settings and try again. If the error persists, contact Azion support.")
+	ErrorDelete                = errors.New("Failed to delete the Edge Firewall rule: %w. Check your settings and try again. If the error persists, contact Azion support.")
+	ErrorConvertEdgeFirewallID = errors.New("The Edge Firewall ID you provided is invalid. The value must be an integer. Run the command 'azion list edge-firewall' to check your Edge Firewall ID and try again")
+	ErrorConvertRuleID         = errors.New("The rule ID you provided is invalid. The value must be an integer. Run the command 'azion list edge-firewall-rule' to check your rule ID and try again")
+	ErrorMissingEdgeFirewallID = errors.New("Required flag is missing. You must provide the --edge-firewall-id flag. Run the command 'azion list edge-firewall-rule --help' to display more information and try again")
+	ErrorNameEmpty             = errors.New("The rule's name is empty. Add a name to the JSON file and try again")
+	ErrorCriteriaEmpty         = errors.New("The rule has no criteria. Add at least one criterion to the JSON file and try again")
+	ErrorBehaviorsEmpty        = errors.New("The rule has no behaviors. Add at least one behavior to the JSON file and try again")
+)
@@ -0,0 +1,44 @@
+package edgefirewallrule
+
+var (
+	Usage = "edge-firewall-rule"
+
+	// [ general ]
+	FlagEdgeFirewallID = "Unique identifier of the Edge Firewall"
+	FlagRuleID         = "Unique identifier of the Edge Firewall rule"
+	FileWritten        = "File successfully written to: %s\n"
+	AskEdgeFirewallID  = "Enter the Edge Firewall's ID:"
+	AskRuleID          = "Enter the Edge Firewall rule's ID:"
+	AskFile            = "Enter the path of the JSON file with the rule:"
+
+	// [ create ]
+	CreateShortDescription = "Creates a rule in the rules engine of an Edge Firewall"
+	CreateLongDescription  = "Creates a rule in the rules engine of an Edge Firewall from a JSON file with its name, criteria and behaviors"
+	CreateFlagFile         = "Path to a JSON file containing the rule that will be created; you can use - for reading from stdin"
+	CreateOutputSuccess    = "Created Edge Firewall rule with ID %d\n"
+	CreateHelpFlag         = "Displays more information about the create edge-firewall-rule command"
+
+	// [ update ]
+	UpdateShortDescription = "Modifies a rule in the rules engine of an Edge Firewall"
+	UpdateLongDescription  = "Modifies a rule in the rules engine of an Edge Firewall from a JSON file with the attributes that change"
+	UpdateFlagFile         = "Path to a JSON file containing the attributes of the rule that will be updated; you can use - for reading from stdin"
+	UpdateOutputSuccess    = "Updated Edge Firewall rule with ID %d\n"
+	UpdateHelpFlag         = "Displays more information about the update edge-firewall-rule command"
+
+	// [ describe ]
+	DescribeShortDescription = "Returns the Edge Firewall rule data"
+	DescribeLongDescription  = "Displays information about a rule of an Edge Firewall, including its criteria and behaviors"
+	DescribeFlagOut          = "Exports the output to the given <file_path/file_name.ext>"
+	DescribeHelpFlag         = "Displays more information about the describe edge-firewall-rule command"
+
+	// [ list ]
+	ListShortDescription = "Displays the rules of an Edge Firewall"
+	ListLongDescription  = "Displays the rules engine of an Edge Firewall in the order they are evaluated"
+	ListHelpFlag         = "Displays more information about the list edge-firewall-rule command"
+
+	// [ delete ]
+	DeleteShortDescription = "Removes a rule from an Edge Firewall"
+	DeleteLongDescription  = "Removes a rule from the rules engine of an Edge Firewall based on its ID"
+	DeleteOutputSuccess    = "Edge Firewall rule %d was successfully deleted\n"
+	DeleteHelpFlag         = "Displays more information about the delete edge-firewall-rule command"
+)
@@ -7,6 +7,25 @@ var (
 	ApiListFlagSort     = "Defines the order of the items on the list; options <asc|desc>"
 	ApiListFlagPage     = "Returns a page of the list according to its number"
 	ApiListFlagPageSize = "Defines how many items should be returned per page"
-	ApiListFlagFilter   = "Filters items by their name"
-	CliVersion          = "Azion CLI %s"
+
+	//Output flags used by the list and describe commands
+	FlagFormat    = "Changes the output format of the commands that print results; options <table|json|yaml|csv|template>. The metrics command also accepts sparkline, and logs http --summary accepts table, json and yaml"
+	FlagColumns   = "Comma-separated list of the columns to display; for example --columns id,name"
+	FlagNoHeaders = "Omits the header row of the table and csv formats"
+	FlagTemplate  = "Go template applied to each result when --format is template; for example --template '{{.id}} {{.name}}'"
+	FlagQuery     = "gjson path evaluated against the JSON result before printing; for example --query '#(active==false)#.id'"
+	FlagFilter    = "Keeps the items matching 'field=value', 'field!=value', 'field~regex' or 'field!~regex'; a value without an operator filters items by their name. Can be repeated"
+	CliVersion    = "Azion CLI %s"
+
+	//Exit codes listed by the help of the root command
+	ExitCodes = `0    ok            The command succeeded
+1    error         Any failure without a code of its own
+2    validation    Invalid or missing flags, arguments or values; fix them and try again
+3    auth          Missing, invalid or expired token, or no permission for the resource
+4    not_found     The resource doesn't exist
+5    conflict      The name is already in use, or the change conflicts with the resource
+6    rate_limited  Too many requests; retry later
+7    network       The API couldn't be reached or didn't answer in time; retry later
+8    server        Internal failure of the API; retry later
+130  aborted       The command was interrupted`
 )
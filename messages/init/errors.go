@@ -22,4 +22,5 @@ var (
 	ErrorDeps                          = errors.New("Failed to install project dependencies")
 	ErrorWorkingDir                    = errors.New("Failed to change current working directory")
 	ErrorModeNotFound                  = errors.New("No mode was found for the selected template. For more information, run the command again using the '--debug' flag. If the problem persists, contact Azion’s support")
+	ErrorNonInteractive                = errors.New("The templates are chosen interactively, so the init command can't run without a terminal or with --no-interactive. Use the command 'azion link --preset <preset> --mode <mode>' in an existing project instead")
 )
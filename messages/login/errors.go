@@ -7,4 +7,5 @@ var (
 	ErrorInvalidLogin       = errors.New("Invalid login method")
 	ErrorTokenCreateInvalid = errors.New("Invalid token detected. The generated token appears to be corrupted or expired. Please check your authentication credentials and generate a new token to proceed.")
 	ErrorServerClosed       = errors.New("Error while serving server for browser login")
+	ErrorBrowserTimeout     = errors.New("The browser login wasn't completed within %s. Run the command again, or use the --no-browser flag to log in with a copied token")
 )
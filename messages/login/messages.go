@@ -8,15 +8,19 @@ const (
 	Success          = "successfully logged in"
 
 	// flags
-	FlagUsername = "Your email address"
-	FlagPassword = "Your password"
-	FlagHelp     = "Displays more information about the login command"
+	FlagUsername  = "Your email address"
+	FlagPassword  = "Your password"
+	FlagHelp      = "Displays more information about the login command"
+	FlagNoBrowser = "Logs in with a token copied from a browser on any device, instead of opening the browser on this machine"
 
 	// Ask
 	AskUsername = "Enter your email address:"
 	AskPassword = "Enter your password:"
+	AskCode     = "Paste the token:"
 
 	//browser
-	VisitMsg   = "Please visit https://sso.azion.com/login?next=cli in case it did not open automatically\n"
-	BrowserMsg = "You may now close this page and return to your terminal"
+	VisitMsg          = "Please visit %s in case it did not open automatically\n"
+	CodeVisitMsg      = "Open %s in a browser on any device and log in. The browser is then sent to a localhost address, which may fail to load; copy the value of its c parameter and paste it below\n"
+	BrowserMsg        = "You may now close this page and return to your terminal"
+	BrowserInvalidMsg = "This login link is invalid or expired. Run 'azion login' again"
 )
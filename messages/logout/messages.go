@@ -4,9 +4,19 @@ const (
 	// general
 	Usage            = "logout"
 	ShortDescription = "Logs out of your Azion account"
-	LongDescription  = "Logs out of your Azion account and remove the Personal Token saved locally"
+	LongDescription  = "Logs out of your Azion account, removing the Personal Token from this machine. Use --revoke to also revoke it through the Azion API, so that no copy of it can be used, and --all-profiles to log out of every profile. To revoke the tokens of a machine you no longer have, such as a lost laptop, run 'azion personal-token revoke --machine <hostname>' from another one"
 	Success          = "successfully logged out"
 
 	// flags
-	FlagHelp = "Displays more information about the logout command"
+	FlagHelp        = "Displays more information about the logout command"
+	FlagAllProfiles = "Logs out of every profile instead of only the one in use"
+	FlagRevoke      = "Revokes the Personal Tokens through the Azion API before removing them from this machine"
+
+	// report
+	Revoked           = "Revoked personal token %s of profile %s\n"
+	AlreadyInvalid    = "Personal token %s of profile %s had already expired or been revoked\n"
+	NotRevokedUnknown = "The personal token of profile %s wasn't revoked, as its ID is unknown. Revoke it on Azion Console, in Account Menu > Personal Tokens\n"
+	Removed           = "Removed the personal token of profile %s from the %s credential helper\n"
+	NotLoggedIn       = "Profile %s isn't logged in\n"
+	ProfileFailure    = "the personal token of profile %s wasn't revoked and was kept on this machine, run the logout without --revoke to remove it anyway (%s)"
 )
@@ -3,5 +3,9 @@ package cells
 import "errors"
 
 var (
-	ErrorRequest = errors.New("Error while requesting graphql api")
+	ErrorRequest           = errors.New("Error while requesting graphql api")
+	ErrorMultipleFunctions = errors.New("Use only one of the flags --function-id, --function-name or --project")
+	ErrorProjectNoFunction = errors.New("The project in the current directory has no Edge Function yet. Run 'azion deploy' first or inform the function with --function-id or --function-name")
+	ErrorFunctionNotFound  = errors.New("No Edge Function named '%s' was found. Run 'azion list edge-function' to see all your functions")
+	ErrorListFunctions     = errors.New("Failed to list your Edge Functions: %w")
 )
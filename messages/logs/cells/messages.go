@@ -10,4 +10,7 @@ var (
 	FlagTail         = "Displays logs continuously"
 	LimitFlag        = "Defines how many logs will be shown per request"
 	NewLogs          = "Waiting for the next event..."
+	FlagProject      = "Displays the logs of the Edge Function of the project in the current directory, read from azion/azion.json"
+	FlagFunctionName = "Name of the Edge Function you wish to see the logs for"
+	FlagLevel        = "Displays only the logs of the given levels; for example --level ERROR,WARN"
 )
@@ -3,5 +3,9 @@ package http
 import "errors"
 
 var (
-	ErrorRequest = errors.New("Error while requesting graphql api")
+	ErrorRequest       = errors.New("Error while requesting graphql api")
+	ErrorInvalidSince  = errors.New("The value given to --since is invalid. Use a duration such as '5m' or '1h' and try again")
+	ErrorInvalidTop    = errors.New("The value given to --top must be a positive integer")
+	ErrorSummaryTail   = errors.New("The flags --summary and --tail can't be used together")
+	ErrorSummaryFormat = errors.New("The value given to --format is invalid for --summary. Use one of 'table', 'json' or 'yaml' and try again")
 )
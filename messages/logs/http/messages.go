@@ -9,4 +9,18 @@ var (
 	FlagTail         = "Displays logs continuously"
 	LimitFlag        = "Defines how many logs will be shown per request"
 	NewLogs          = "Waiting for the next event..."
+	FlagSummary      = "Summarizes the events of the time window locally instead of printing each one"
+	FlagSince        = "Time window summarized by --summary, counting back from now; for example 5m or 1h"
+	FlagTop          = "Number of entries shown in each ranking of --summary"
+
+	SummaryWindow      = "Window: "
+	SummaryEvents      = "Events: "
+	SummaryRequestTime = "Request Time (p50/p95/p99): "
+	SummaryStatus      = "STATUS"
+	SummaryURIs        = "TOP URIS"
+	SummaryUserAgents  = "TOP USER AGENTS"
+	SummaryRegions     = "TOP REGIONS"
+	SummaryCount       = "EVENTS"
+
+	WarningSummaryTruncated = "The summary covers only the first %d events of the window. Use a shorter --since to summarize all of them"
 )
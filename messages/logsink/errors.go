@@ -0,0 +1,15 @@
+package logsink
+
+import "errors"
+
+var (
+	ErrorOpenFile    = errors.New("Failed to open the output file %s: %w")
+	ErrorRotateFile  = errors.New("Failed to rotate the output file %s: %w")
+	ErrorWriteFile   = errors.New("Failed to write events to the output file: %w")
+	ErrorDialUDP     = errors.New("Failed to reach the UDP address %s: %w")
+	ErrorWriteUDP    = errors.New("Failed to forward events to the UDP address: %w")
+	ErrorForwardHTTP = errors.New("Failed to forward events to %s after %d attempts: %w")
+	ErrorStatusHTTP  = errors.New("the endpoint answered with status %d")
+	ErrorInvalidURL  = errors.New("The value given to --forward-http must be an http or https URL")
+	ErrorInvalidSize = errors.New("The value given to --max-file-size must be a positive number of megabytes")
+)
@@ -0,0 +1,8 @@
+package logsink
+
+var (
+	FlagOutputFile  = "Also appends every event as a JSON line to the given file, rotating it by size"
+	FlagMaxFileSize = "Size, in megabytes, at which the file given to --output-file is rotated"
+	FlagForwardUDP  = "Also forwards every event as a JSON datagram to the given <host:port>"
+	FlagForwardHTTP = "Also forwards events in batches, as a JSON array, to the given URL through HTTP POST"
+)
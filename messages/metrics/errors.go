@@ -0,0 +1,10 @@
+package metrics
+
+import "errors"
+
+var (
+	ErrorRequest       = errors.New("Error while requesting graphql api")
+	ErrorInvalidSince  = errors.New("The value given to --since is invalid. Use a duration such as '15m', '1h' or '24h' and try again")
+	ErrorInvalidFormat = errors.New("The value given to --format is invalid. Use one of 'table', 'json', 'yaml' or 'sparkline' and try again")
+	ErrorInvalidTop    = errors.New("The value given to --top must be a positive integer")
+)
@@ -0,0 +1,23 @@
+package metrics
+
+var (
+	Usage             = "metrics"
+	ShortDescription  = "Displays aggregated traffic metrics"
+	LongDescription   = "Displays aggregated traffic metrics for an Edge Application or domain over a time window: requests by status class, bandwidth, cache hit ratio, top URIs and countries, and request time percentiles"
+	FlagHelp          = "Displays more information about the metrics command"
+	FlagApplicationID = "ID of the Edge Application to aggregate metrics for; if not informed, the metrics of all applications are aggregated"
+	FlagDomain        = "Domain (host) to aggregate metrics for"
+	FlagSince         = "Time window to aggregate, counting back from now; for example 15m, 1h or 24h"
+	FlagTop           = "Number of entries shown in the top URIs and top countries rankings"
+
+	TitleWindow       = "Window: "
+	TitleRequests     = "Requests: "
+	TitleBandwidth    = "Bandwidth: "
+	TitleCacheHit     = "Cache Hit Ratio: "
+	TitleRequestTime  = "Request Time (p50/p95/p99): "
+	TitleStatus       = "Status %s: "
+	TitleTopURIs      = "Top URIs"
+	TitleTopCountries = "Top Countries"
+	SparkRequests     = "requests  "
+	SparkBandwidth    = "bandwidth "
+)
@@ -0,0 +1,18 @@
+package networklist
+
+import "errors"
+
+var (
+	ErrorCreate        = errors.New("Failed to create the Network List: %w. Check your settings and try again. If the error persists, contact Azion support.")
+	ErrorUpdate        = errors.New("Failed to update the Network List: %w. Check your settings and try again. If the error persists, contact Azion support.")
+	ErrorGet           = errors.New("Failed to describe the Network List: %w. Check your settings and try again. If the error persists, contact Azion support.")
+	ErrorList          = errors.New("Failed to list your Network Lists: %w. Check your settings and try again. If the error persists, contact Azion support.")
+	ErrorDelete        = errors.New("Failed to delete the Network List: %w. Check your settings and try again. If the error persists, contact Azion support.")
+	ErrorConvertID     = errors.New("The Network List ID you provided is invalid. The value must be an integer. Run the command 'azion list network-list' to check your Network List ID and try again")
+	ErrorListType      = errors.New("Invalid --list-type flag provided: %q. The types are ip_cidr, asn and countries")
+	ErrorInvalidItems  = errors.New("The Network List has %d invalid items, such as %s. Fix or remove them, or use the --skip-invalid flag to upload only the valid ones")
+	ErrorItemsFlags    = errors.New("The --items and --from-file flags replace the items of the Network List, and can't be used together or with --add and --remove")
+	ErrorNoItems       = errors.New("The Network List has no valid items. Provide them with the --items or --from-file flags and try again")
+	ErrorNoUpdateFlags = errors.New("No values to update. Provide at least one flag with a new value, or the --file flag. Run the command 'azion update network-list --help' to display more information and try again")
+	ErrorReadItems     = errors.New("Failed to read the Network List items from %s: %w")
+)
@@ -0,0 +1,52 @@
+package networklist
+
+var (
+	Usage = "network-list"
+
+	// [ general ]
+	FlagNetworkListID = "Unique identifier of the Network List"
+	FlagName          = "The Network List's name"
+	FlagListType      = "The type of the Network List's items: <ip_cidr|asn|countries>"
+	FlagItems         = "Items of the Network List, replacing the current ones; for example: 192.0.2.0/24,198.51.100.7"
+	FlagFromFile      = "Path to a text file with the items of the Network List, one or more per line, replacing the current ones; text after # or ; is ignored. You can use - for reading from stdin"
+	FlagSkipInvalid   = "Uploads the valid items of the list, warning about the invalid ones instead of failing"
+	FlagNoAggregate   = "Keeps IP/CIDR items as given instead of merging them into the fewest CIDR blocks"
+	FileWritten       = "File successfully written to: %s\n"
+	AskNetworkListID  = "Enter the Network List's ID:"
+	AskName           = "Enter the Network List's name:"
+	ItemsSummary      = "Read %d items: %d duplicated, %d merged into CIDR blocks, %d invalid. Uploading %d items\n"
+	WarningInvalid    = "Skipping invalid item %s"
+
+	// [ create ]
+	CreateShortDescription = "Creates a new Network List"
+	CreateLongDescription  = "Creates a Network List of IP/CIDR blocks, ASNs or countries, which Edge Firewall rules can reference. Large lists can be imported from text files, such as threat feeds, and are validated, deduplicated and aggregated before they're uploaded"
+	CreateFlagFile         = "Path to a JSON file containing the attributes of the Network List that will be created; you can use - for reading from stdin"
+	CreateOutputSuccess    = "Created Network List with ID %d\n"
+	CreateHelpFlag         = "Displays more information about the create network-list command"
+
+	// [ update ]
+	UpdateShortDescription = "Modifies a Network List"
+	UpdateLongDescription  = "Modifies a Network List based on its ID, replacing its items or adding and removing some of them"
+	UpdateFlagFile         = "Path to a JSON file containing the attributes of the Network List that will be updated; you can use - for reading from stdin"
+	UpdateFlagAdd          = "Items to add to the Network List, keeping the current ones"
+	UpdateFlagRemove       = "Items to remove from the Network List. Removing an IP/CIDR block splits the blocks that contain it"
+	UpdateOutputSuccess    = "Updated Network List with ID %d\n"
+	UpdateHelpFlag         = "Displays more information about the update network-list command"
+
+	// [ describe ]
+	DescribeShortDescription = "Returns the Network List data"
+	DescribeLongDescription  = "Displays information about the Network List via a given ID to show its attributes in detail"
+	DescribeFlagOut          = "Exports the output to the given <file_path/file_name.ext>"
+	DescribeHelpFlag         = "Displays more information about the describe network-list command"
+
+	// [ list ]
+	ListShortDescription = "Displays your Network Lists"
+	ListLongDescription  = "Displays all Network Lists of your account"
+	ListHelpFlag         = "Displays more information about the list network-list command"
+
+	// [ delete ]
+	DeleteShortDescription = "Removes a Network List"
+	DeleteLongDescription  = "Removes a Network List based on its ID"
+	DeleteOutputSuccess    = "Network List %d was successfully deleted\n"
+	DeleteHelpFlag         = "Displays more information about the delete network-list command"
+)
@@ -0,0 +1,12 @@
+package personaltoken
+
+import "errors"
+
+var (
+	ErrorNotLoggedIn = errors.New("There is no personal token to rotate. Run 'azion login' or use the --token flag to save one and try again")
+	ErrorRotate      = errors.New("Failed to create the new personal token: %w. Check your settings and try again. If the error persists, contact Azion support.")
+	ErrorInvalidKey  = errors.New("The new personal token was created but couldn't be validated, so the saved token was kept. Delete the token %s with 'azion delete personal-token' and try again")
+	ErrorDeleteOld   = errors.New("The new personal token was saved, but deleting the replaced token %s failed: %w. Run 'azion delete personal-token' to delete it")
+	ErrorListTokens  = errors.New("Failed to list the personal tokens: %w. Check your settings and try again. If the error persists, contact Azion support.")
+	ErrorRevoke      = errors.New("Failed to revoke %d of the personal tokens issued to %s: %s. Run the command again to retry")
+)
@@ -0,0 +1,38 @@
+package personaltoken
+
+var (
+	Usage            = "personal-token"
+	ShortDescription = "Manages the lifecycle of the personal token the CLI uses"
+	LongDescription  = "Manages the personal token saved in your settings, which authorizes the CLI commands, such as replacing it before it expires, and revokes the tokens issued to another machine"
+	FlagHelp         = "Displays more information about the personal-token command"
+
+	// [ rotate ]
+	RotateUsage            = "rotate"
+	RotateShortDescription = "Replaces the saved personal token with a new one"
+	RotateLongDescription  = "Creates a personal token to replace the one saved in your settings, and saves it so that the next commands use it. When the token in use comes from AZIONCLI_TOKEN or --token, the saved settings are left alone and the new token is displayed instead. The replaced token keeps working until it expires, unless it's deleted with --delete-old"
+	RotateFlagName         = "The new personal token's name. By default, it's the name of the token it replaces"
+	RotateFlagExpiration   = "When the new personal token expires, as an interval such as \"30d\", \"2w\", \"3m\" or \"1y\", or as a date such as \"2025-02-12\""
+	RotateFlagDescription  = "The new personal token's description"
+	RotateFlagDeleteOld    = "Deletes the replaced personal token once the new one is saved"
+	RotateFlagPrintKey     = "Also displays the new personal token, so that it can be stored elsewhere, such as in a CI secret"
+	RotateHelpFlag         = "Displays more information about the personal-token rotate command"
+	RotateOutputSuccess    = "Personal token rotated. The new token expires on %s and was saved in %s\n"
+	RotateOutputNotSaved   = "Personal token rotated. The new token expires on %s. It wasn't saved, as the replaced token came from AZIONCLI_TOKEN or --token, so update it where it's set:\n"
+	RotateDeletedOld       = "Deleted the replaced personal token %s\n"
+	RotateKeyOutput        = "%s\n"
+	RotateWarningUnknown   = "The replaced personal token wasn't deleted: its ID is only known for the saved token, when 'azion login' or 'azion personal-token rotate' created it. Run 'azion list personal-token' and 'azion delete personal-token' to delete it"
+
+	// [ revoke ]
+	RevokeUsage            = "revoke"
+	RevokeShortDescription = "Revokes the personal tokens issued to a machine"
+	RevokeLongDescription  = "Revokes, through the Azion API, every personal token that 'azion login' and 'azion personal-token rotate' issued to the given machine, such as a lost or decommissioned laptop, so that no copy of them can be used. Those commands name the tokens after the machine's hostname, as in \"dev@example.com (azion-cli@laptop)\". Tokens created before they did so, or on Azion Console, carry no machine and aren't found, and only the tokens of the account in use are searched"
+	RevokeFlagMachine      = "The hostname of the machine whose personal tokens are revoked"
+	RevokeHelpFlag         = "Displays more information about the personal-token revoke command"
+	RevokeAskMachine       = "Enter the hostname of the machine whose personal tokens will be revoked:"
+	RevokeNoTokens         = "No personal token issued to %s was found\n"
+	RevokeFound            = "Personal tokens issued to %s:\n"
+	RevokeFoundItem        = "  %s  %s\n"
+	RevokeAsk              = "Revoke these %d personal tokens?"
+	RevokeCanceled         = "No personal token was revoked\n"
+	RevokeOutputSuccess    = "Revoked personal token %s\n"
+)
@@ -0,0 +1,13 @@
+package printer
+
+import "errors"
+
+var (
+	ErrorInvalidFormat   = errors.New("The value given to --format is invalid. Use one of 'table', 'json', 'yaml', 'csv' or 'template' and try again")
+	ErrorMissingTemplate = errors.New("The flag --template is required when --format is 'template'")
+	ErrorInvalidTemplate = errors.New("The given template is invalid: %w")
+	ErrorUnknownColumn   = errors.New("Unknown column '%s'. The available columns are: %s")
+	ErrorInvalidFilter   = errors.New("The filter '%s' is invalid. Use 'field=value', 'field!=value', 'field~regex' or 'field!~regex' and try again")
+	ErrorInvalidRegex    = errors.New("The regular expression '%s' is invalid: %w")
+	ErrorQueryFormat     = errors.New("The flag --query can't be used with --format csv or template, nor with --columns. Use --format json, yaml or table and try again")
+)
@@ -0,0 +1,9 @@
+package profile
+
+import "errors"
+
+var (
+	ErrorExists      = errors.New("The profile %s already exists. Choose another name and try again")
+	ErrorNotFound    = errors.New("The profile %s doesn't exist. Run the command 'azion profile list' to check your profiles and try again")
+	ErrorInvalidName = errors.New("Invalid profile name provided: %q. Names may only have letters, numbers, - and _, and default is reserved")
+)
@@ -0,0 +1,41 @@
+package profile
+
+var (
+	Usage            = "profile"
+	ShortDescription = "Manages the profiles of your accounts and environments"
+	LongDescription  = "Manages named profiles, each with its own personal token, API URLs and defaults, to work with several Azion accounts and environments. The profile in use is chosen with 'azion profile use', or for a single command with the --profile flag or the AZIONCLI_PROFILE environment variable"
+	FlagHelp         = "Displays more information about the profile command"
+	AskName          = "Enter the profile's name:"
+
+	// [ add ]
+	AddUsage            = "add <name>"
+	AddShortDescription = "Adds a profile"
+	AddLongDescription  = "Adds a profile with its own API URLs and defaults. Log in to it with 'azion login --profile <name>'"
+	AddFlagApiURL       = "URL of the Azion API the profile uses. By default, it's the production API"
+	AddFlagStorageURL   = "URL of the Azion storage API the profile uses. By default, it's the production API"
+	AddFlagDefault      = "Default value of a setting for the profile, as key=value. It can be given many times"
+	AddFlagUse          = "Uses the profile from now on"
+	AddHelpFlag         = "Displays more information about the profile add command"
+	AddOutputSuccess    = "Added profile %s. Run 'azion login --profile %s' to log in to it\n"
+
+	// [ list ]
+	ListShortDescription = "Displays your profiles in a list"
+	ListLongDescription  = "Displays your profiles in a list, marking the one in use"
+	ListHelpFlag         = "Displays more information about the profile list command"
+
+	// [ use ]
+	UseUsage            = "use <name>"
+	UseShortDescription = "Sets the profile the commands use"
+	UseLongDescription  = "Sets the profile whose credentials and settings the commands use from now on. Use the default profile to go back to the credentials kept outside of the profiles"
+	UseHelpFlag         = "Displays more information about the profile use command"
+	UseOutputSuccess    = "Using profile %s\n"
+
+	// [ remove ]
+	RemoveUsage            = "remove <name>"
+	RemoveShortDescription = "Removes a profile"
+	RemoveLongDescription  = "Removes a profile and erases its personal token from the credential helper. The token itself is kept in your account; run 'azion logout --profile <name>' first to delete it"
+	RemoveHelpFlag         = "Displays more information about the profile remove command"
+	RemoveAsk              = "Are you sure you want to remove the profile %s? (y/N)"
+	RemoveOutputSuccess    = "Removed profile %s\n"
+	RemoveCanceled         = "Removal canceled\n"
+)
@@ -0,0 +1,14 @@
+package purge
+
+import "errors"
+
+var (
+	ErrorBatches      = errors.New("%d of %d purge requests failed. Check the report above and purge the items of the failed requests again")
+	ErrorModes        = errors.New("Only one of the --urls, --wildcard and --cache-key flags can be used at a time, and --type must match it. Run the command 'azion purge --help' to display more information and try again")
+	ErrorMissingItems = errors.New("No items to purge. Provide them with the --urls, --wildcard, --cache-key or --from-file flags. Run the command 'azion purge --help' to display more information and try again")
+	ErrorType         = errors.New("Invalid --type flag provided: %q. The types are url, wildcard and cache-key")
+	ErrorLayer        = errors.New("Invalid --layer flag provided: %q. The layers are edge and tiered")
+	ErrorLayerMode    = errors.New("The tiered cache layer can only be purged with the --cache-key flag")
+	ErrorWildcard     = errors.New("The Wildcard expression %q has no *. Use the --urls flag to purge single URLs")
+	ErrorReadItems    = errors.New("Failed to read the items to purge from %s: %w")
+)
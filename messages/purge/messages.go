@@ -0,0 +1,22 @@
+package purge
+
+var (
+	Usage            = "purge"
+	ShortDescription = "Removes content from the cache of Azion's edge"
+	LongDescription  = "Removes URLs, Wildcard expressions or Cache Keys from the cache without a new deploy. Large lists are split into as many requests as the API allows, and the result of each of them is reported"
+	FlagHelp         = "Displays more information about the purge command"
+	FlagURLs         = "URLs to purge, without the protocol; for example: www.example.com/image.png"
+	FlagWildcard     = "Wildcard expressions to purge, such as www.example.com/images/*. Each expression is purged on its own request"
+	FlagCacheKey     = "Cache Keys to purge; for example: www.example.com/@@cookie_name=cookie_value"
+	FlagLayer        = "Cache layer the Cache Keys are purged from: <edge|tiered>"
+	FlagFromFile     = "Path to a text file with the items to purge, one per line; lines starting with # are ignored. You can use - for reading from stdin"
+	FlagType         = "Type of the items read with --from-file, when no other purge flag is given: <url|wildcard|cache-key>"
+
+	BatchSuccess  = "Batch %d/%d: purged %d %s\n"
+	BatchFailure  = "Batch %d/%d failed: %s\n"
+	OutputSuccess = "Purged %d %s in %d requests"
+
+	TypeURLs      = "URLs"
+	TypeWildcards = "Wildcard expressions"
+	TypeCacheKeys = "Cache Keys"
+)
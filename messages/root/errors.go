@@ -8,4 +8,10 @@ var (
 	ErrorUnmarshalUserInfo    = errors.New("Failed to unmarshal current user information.")
 	ErrorReadFileSettingsToml = errors.New("Provide the correct path of the configuration file. Make sure the file is in .toml format, access the document for more information https://www.azion.com/en/documentation/devtools/cli/globals/#config")
 	ErrorPrefix               = errors.New("A configuration path is expected for your location, not a flag")
+	ErrorCredentialHelper     = errors.New("Failed to %s the personal token with the %s credential helper: %w. Check the helper and try again")
+	ErrorHTTPSetting          = errors.New("Invalid %s setting, given by the %s: %s. Change it and try again")
+	ErrorHTTPClient           = errors.New("Failed to configure the HTTP client: %w. Check the proxy and ca_bundle settings with the command 'azion config list --show-origin' and try again")
+	ErrorWriteHAR             = errors.New("Failed to write the HAR file %s: %s. Check the path and try again")
+	ErrorProfileNotFound      = errors.New("The profile %s doesn't exist. Run the command 'azion profile list' to check your profiles and try again")
+	ErrorErrorFormat          = errors.New("Invalid error format %s. Use text or json and try again")
 )
@@ -11,8 +11,23 @@ var (
 	RootTokenFlag   = "Saves a given Personal Token locally to authorize CLI commands"
 	RootConfigFlag  = "Sets the Azion configuration folder for the current command only, without changing persistent settings."
 	RootYesFlag     = "Answers all yes/no interactions automatically with yes"
+	RootProfileFlag = "Uses the credentials and settings of the given profile for the current command only. It can also be set with the AZIONCLI_PROFILE environment variable"
 	TokenSavedIn    = "Token saved in %s\n"
 	TokenUsedIn     = "This token will be used by default with all commands"
+	TokenExpired    = "Your personal token expired on %s. Run 'azion login' to log in again"
+	TokenExpiresIn  = "Your personal token expires %s, on %s. Run 'azion personal-token rotate' to replace it"
+
+	// http tracing
+	RootDebugHTTPFlag     = "Displays each HTTP request and response the command makes, with method, URL, status, latency and headers. Tokens are redacted"
+	RootDebugHTTPBodyFlag = "Adds the bodies of the requests and responses to the --debug-http output, with tokens, passwords and keys redacted"
+	RootHARFlag           = "Saves the HTTP requests and responses the command makes to the given HAR file, which can be attached to support tickets. Tokens, passwords and keys are redacted"
+	HARSaved              = "HTTP requests saved in %s\n"
+
+	// non-interactive mode
+	RootNoInteractiveFlag = "Never prompts for input, so that commands missing a required value fail naming the flags to pass. It's the default when the input isn't a terminal, and can also be set with the AZIONCLI_NO_INTERACTIVE environment variable"
+
+	// error output
+	RootErrorFormatFlag = "Changes the format of the errors written to the standard error; options <text|json>. The json format has the fields code, message, http_status and request_id"
 
 	// update messages
 	NewVersion        = "There is a new version of Azion CLI available\n"
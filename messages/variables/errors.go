@@ -3,13 +3,20 @@ package variables
 import "errors"
 
 var (
-	ErrorGetItem                         = errors.New("Failed to describe the variable: %s. Check your settings and try again. If the error persists, contact Azion support.")
+	ErrorGetItem                         = errors.New("Failed to describe the variable: %w. Check your settings and try again. If the error persists, contact Azion support.")
 	ErrorMissingArguments                = errors.New("A required flag is missing. You must supply the --variable-id flag as an argument. Run 'azion <command> <subcommand> --help' command to display more information and try again")
-	ErrorFailToDeleteVariable            = errors.New("Failed to delete the variable: %s. Check your settings and try again. If the error persists, contact Azion support")
+	ErrorFailToDeleteVariable            = errors.New("Failed to delete the variable: %w. Check your settings and try again. If the error persists, contact Azion support")
 	ErrorMissingVariableIdArgumentDelete = errors.New("A required flag is missing. You must provide the --variable_id flag as an argument. Run the command 'azion variables <subcommand> --help' to display more information and try again")
 	ErrorMissingVariableIdArgument       = errors.New("Required lags are missing. You must provide the --variable-id, --key, --value, and --secret flags as arguments, or the --file flag informing the path to import the file. Run the command 'azion variables <subcommand> --help' to display more information and try again")
 	ErrorMissingFieldUpdateVariables     = errors.New("Required flags are missing. You must provide the --key, --value, and --secret flags as arguments, or the --file flag informing the path to import the file. Run the command 'azion variables <subcommand> --help' to display more information and try again")
 	ErrorSecretFlag                      = errors.New("Invalid --secret flag provided. The value must be 'true' or 'false'. Run the command 'azion variables <subcommand> --help' to display more information and try again")
-	ErrorUpdateVariable                  = errors.New("Failed to update the variable: %s. Check your settings and try again. If the error persists, contact Azion support")
-	ErrorCreateItem                      = errors.New("Failed to create the variable: %s. Check your settings and try again. If the error persists, contact Azion support.")
+	ErrorUpdateVariable                  = errors.New("Failed to update the variable: %w. Check your settings and try again. If the error persists, contact Azion support")
+	ErrorCreateItem                      = errors.New("Failed to create the variable: %w. Check your settings and try again. If the error persists, contact Azion support.")
+	ErrorListVariables                   = errors.New("Failed to list the variables: %w. Check your settings and try again. If the error persists, contact Azion support.")
+	ErrorReadEnvFile                     = errors.New("Failed to read the .env file %s: %w. Check the file and try again")
+	ErrorSecretPattern                   = errors.New("Invalid secret pattern provided: %s. Check the pattern and try again")
+	ErrorSyncEmptyFrom                   = errors.New("The path given to --from is empty. Provide the path of the .env file and try again")
+	ErrorSyncDeleteEmpty                 = errors.New("The .env file %s has no variables, so --delete would remove all the variables of the account. Check the file and try again")
+	ErrorSync                            = errors.New("%d of %d changes failed. Fix them and run the sync again; the variables that were synced are up to date")
+	ErrorWriteEnvFile                    = errors.New("Failed to write the .env file: %w")
 )
@@ -55,4 +55,32 @@ var (
 	AskValue      = "Enter the Variable's value:"
 	AskSecret     = "Enter the Variable's secret:"
 	AskVariableID = "Enter the Variable's variable ID:"
+
+	// [ sync ]
+	SyncUsage            = "sync"
+	SyncShortDescription = "Makes your variables match a .env file"
+	SyncLongDescription  = "Compares the variables of a .env file with the ones of your account, then creates the missing ones and updates the ones whose value changed. Variables that aren't in the file are kept unless the --delete flag is given. Keys matching a secret pattern are created as secrets"
+	SyncFlagFrom         = "Path to the .env file with the variables"
+	SyncFlagDelete       = "Deletes the variables of your account that aren't in the file"
+	SyncFlagDryRun       = "Displays the changes that would be made, without making them"
+	SyncFlagSecret       = "Patterns of the keys that are created as secrets, such as *_TOKEN. Patterns are case-insensitive"
+	SyncHelpFlag         = "Displays more information about the variables sync command"
+	SyncCreate           = "+ %s\n"
+	SyncCreateSecret     = "+ %s (secret)\n"
+	SyncUpdate           = "~ %s\n"
+	SyncUpdateSecret     = "~ %s (secret)\n"
+	SyncDelete           = "- %s\n"
+	SyncFailed           = "%s failed: %s\n"
+	SyncKept             = "%d variables of your account aren't in the file and were kept. Use the --delete flag to delete them"
+	SyncDryRun           = "%d variables would be created, %d updated and %d deleted. %d are up to date\n"
+	SyncOutputSuccess    = "Synced variables: %d created, %d updated and %d deleted. %d were up to date\n"
+	AskFrom              = "Enter the path to the .env file:"
+
+	// [ export ]
+	ExportUsage            = "export"
+	ExportShortDescription = "Writes your variables as a .env file"
+	ExportLongDescription  = "Writes the variables of your account as a .env file. Secrets are left out, since their values can't be read back"
+	ExportFlagTo           = "Path of the .env file that will be written. By default, it's written to the standard output"
+	ExportHelpFlag         = "Displays more information about the variables export command"
+	ExportSkippedSecrets   = "Skipping %d secrets, whose values can't be exported: %s"
 )
@@ -0,0 +1,17 @@
+package wafallowedrule
+
+import "errors"
+
+var (
+	ErrorCreate               = errors.New("Failed to create the WAF allowed rule: %w. Check your settings and try again. If the error persists, contact Azion support.")
+	ErrorUpdate               = errors.New("Failed to update the WAF allowed rule: %w. Check your settings and try again. If the error persists, contact Azion support.")
+	ErrorGet                  = errors.New("Failed to describe the WAF allowed rule: %w. Check your settings and try again. If the error persists, contact Azion support.")
+	ErrorList                 = errors.New("Failed to list the WAF allowed rules: %w. Check your settings and try again. If the error persists, contact Azion support.")
+	ErrorDelete               = errors.New("Failed to delete the WAF allowed rule: %w. Check your settings and try again. If the error persists, contact Azion support.")
+	ErrorConvertWafRuleSetID  = errors.New("The WAF Rule Set ID you provided is invalid. The value must be an integer. Run the command 'azion list waf-rule-set' to check your WAF Rule Set ID and try again")
+	ErrorConvertAllowedRuleID = errors.New("The allowed rule ID you provided is invalid. The value must be an integer. Run the command 'azion list waf-allowed-rule' to check your allowed rule ID and try again")
+	ErrorMissingWafRuleSetID  = errors.New("Required flag is missing. You must provide the --waf-rule-set-id flag. Run the command 'azion list waf-allowed-rule --help' to display more information and try again")
+	ErrorMissingCreateFlags   = errors.New("Required flags are missing. You must provide the --rule-id and --name flags, or the --file flag. Run the command 'azion create waf-allowed-rule --help' to display more information and try again")
+	ErrorBoolFlag             = errors.New("Invalid --%s flag provided. The flag must have 'true' or 'false' values. Run the command 'azion <command> waf-allowed-rule --help' to display more information and try again")
+	ErrorNoUpdateFlags        = errors.New("No values to update. Provide at least one flag with a new value, or the --file flag. Run the command 'azion update waf-allowed-rule --help' to display more information and try again")
+)
@@ -0,0 +1,49 @@
+package wafallowedrule
+
+var (
+	Usage = "waf-allowed-rule"
+
+	// [ general ]
+	FlagWafRuleSetID  = "Unique identifier of the WAF Rule Set"
+	FlagAllowedRuleID = "Unique identifier of the allowed rule"
+	FlagRuleID        = "ID of the WAF rule that the matching requests are exempted from"
+	FlagName          = "The allowed rule's name"
+	FlagReason        = "Why the requests are exempted from the WAF rule"
+	FlagPath          = "Path of the requests the exception applies to"
+	FlagUseRegex      = "Whether --path is a regular expression or not: <true|false>"
+	FlagActive        = "Whether the allowed rule is active or not: <true|false>"
+	FileWritten       = "File successfully written to: %s\n"
+	AskWafRuleSetID   = "Enter the WAF Rule Set's ID:"
+	AskAllowedRuleID  = "Enter the allowed rule's ID:"
+
+	// [ create ]
+	CreateShortDescription = "Creates an exception to a rule of a WAF Rule Set"
+	CreateLongDescription  = "Creates an allowed rule, an exception that keeps a WAF rule from checking the matching requests. Match zones are only given through the --file flag"
+	CreateFlagFile         = "Path to a JSON file containing the attributes of the allowed rule that will be created; you can use - for reading from stdin"
+	CreateOutputSuccess    = "Created WAF allowed rule with ID %d\n"
+	CreateHelpFlag         = "Displays more information about the create waf-allowed-rule command"
+
+	// [ update ]
+	UpdateShortDescription = "Modifies an exception to a rule of a WAF Rule Set"
+	UpdateLongDescription  = "Modifies an allowed rule of a WAF Rule Set based on its ID. Match zones are only given through the --file flag"
+	UpdateFlagFile         = "Path to a JSON file containing the attributes of the allowed rule that will be updated; you can use - for reading from stdin"
+	UpdateOutputSuccess    = "Updated WAF allowed rule with ID %d\n"
+	UpdateHelpFlag         = "Displays more information about the update waf-allowed-rule command"
+
+	// [ describe ]
+	DescribeShortDescription = "Returns the data of an exception to a WAF rule"
+	DescribeLongDescription  = "Displays information about an allowed rule of a WAF Rule Set, including its match zones"
+	DescribeFlagOut          = "Exports the output to the given <file_path/file_name.ext>"
+	DescribeHelpFlag         = "Displays more information about the describe waf-allowed-rule command"
+
+	// [ list ]
+	ListShortDescription = "Displays the exceptions of a WAF Rule Set"
+	ListLongDescription  = "Displays the allowed rules of a WAF Rule Set"
+	ListHelpFlag         = "Displays more information about the list waf-allowed-rule command"
+
+	// [ delete ]
+	DeleteShortDescription = "Removes an exception from a WAF Rule Set"
+	DeleteLongDescription  = "Removes an allowed rule from a WAF Rule Set based on its ID"
+	DeleteOutputSuccess    = "WAF allowed rule %d was successfully deleted\n"
+	DeleteHelpFlag         = "Displays more information about the delete waf-allowed-rule command"
+)
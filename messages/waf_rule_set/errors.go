@@ -0,0 +1,16 @@
+package wafruleset
+
+import "errors"
+
+var (
+	ErrorCreate        = errors.New("Failed to create the WAF Rule Set: %w. Check your settings and try again. If the error persists, contact Azion support.")
+	ErrorUpdate        = errors.New("Failed to update the WAF Rule Set: %w. Check your settings and try again. If the error persists, contact Azion support.")
+	ErrorGet           = errors.New("Failed to describe the WAF Rule Set: %w. Check your settings and try again. If the error persists, contact Azion support.")
+	ErrorList          = errors.New("Failed to list your WAF Rule Sets: %w. Check your settings and try again. If the error persists, contact Azion support.")
+	ErrorDelete        = errors.New("Failed to delete the WAF Rule Set: %w. Check your settings and try again. If the error persists, contact Azion support.")
+	ErrorConvertID     = errors.New("The WAF Rule Set ID you provided is invalid. The value must be an integer. Run the command 'azion list waf-rule-set' to check your WAF Rule Set ID and try again")
+	ErrorActiveFlag    = errors.New("Invalid --active flag provided. The flag must have 'true' or 'false' values. Run the command 'azion <command> waf-rule-set --help' to display more information and try again")
+	ErrorThreatFamily  = errors.New("Invalid --threat flag provided: %q is not a threat family. The families are: %s")
+	ErrorSensitivity   = errors.New("Invalid --threat flag provided: %q is not a sensitivity. Use off, lowest, low, medium, high or highest")
+	ErrorNoUpdateFlags = errors.New("No values to update. Provide at least one flag with a new value, or the --file flag. Run the command 'azion update waf-rule-set --help' to display more information and try again")
+)
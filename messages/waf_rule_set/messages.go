@@ -0,0 +1,47 @@
+package wafruleset
+
+var (
+	Usage = "waf-rule-set"
+
+	// [ general ]
+	FlagWafRuleSetID    = "Unique identifier of the WAF Rule Set"
+	FlagName            = "The WAF Rule Set's name"
+	FlagMode            = "How the WAF Rule Set handles threats: <learning|counting|blocking>"
+	FlagActive          = "Whether the WAF Rule Set is active or not: <true|false>"
+	FlagThreat          = "Sensitivity of a threat family, as <family>=<off|lowest|low|medium|high|highest>; repeat it for more families. Families: %s"
+	FlagBypassAddresses = "IP addresses and CIDRs the WAF Rule Set doesn't check; for example: 192.0.2.10,198.51.100.0/24"
+	FileWritten         = "File successfully written to: %s\n"
+	AskWafRuleSetID     = "Enter the WAF Rule Set's ID:"
+	AskName             = "Enter the WAF Rule Set's name:"
+
+	// [ create ]
+	CreateShortDescription = "Creates a new WAF Rule Set"
+	CreateLongDescription  = "Creates a WAF Rule Set based on given attributes. Threat families that aren't given with --threat are checked with medium sensitivity"
+	CreateFlagFile         = "Path to a JSON file containing the attributes of the WAF Rule Set that will be created; you can use - for reading from stdin"
+	CreateOutputSuccess    = "Created WAF Rule Set with ID %d\n"
+	CreateHelpFlag         = "Displays more information about the create waf-rule-set command"
+
+	// [ update ]
+	UpdateShortDescription = "Modifies a WAF Rule Set"
+	UpdateLongDescription  = "Modifies a WAF Rule Set based on its ID. Only the threat families given with --threat change"
+	UpdateFlagFile         = "Path to a JSON file containing the attributes of the WAF Rule Set that will be updated; you can use - for reading from stdin"
+	UpdateOutputSuccess    = "Updated WAF Rule Set with ID %d\n"
+	UpdateHelpFlag         = "Displays more information about the update waf-rule-set command"
+
+	// [ describe ]
+	DescribeShortDescription = "Returns the WAF Rule Set data"
+	DescribeLongDescription  = "Displays information about the WAF Rule Set via a given ID, including the sensitivity of each threat family"
+	DescribeFlagOut          = "Exports the output to the given <file_path/file_name.ext>"
+	DescribeHelpFlag         = "Displays more information about the describe waf-rule-set command"
+
+	// [ list ]
+	ListShortDescription = "Displays your WAF Rule Sets"
+	ListLongDescription  = "Displays all WAF Rule Sets of your account"
+	ListHelpFlag         = "Displays more information about the list waf-rule-set command"
+
+	// [ delete ]
+	DeleteShortDescription = "Removes a WAF Rule Set"
+	DeleteLongDescription  = "Removes a WAF Rule Set based on its ID"
+	DeleteOutputSuccess    = "WAF Rule Set %d was successfully deleted\n"
+	DeleteHelpFlag         = "Displays more information about the delete waf-rule-set command"
+)
@@ -3,6 +3,10 @@ package whoami
 var (
 	Usage            = "whoami"
 	ShortDescription = "Displays user currently logged in"
-	LongDescription  = "Displays email of the user currently logged in"
+	LongDescription  = "Displays email of the user currently logged in and the profile in use"
 	HelpFlag         = "Displays more information about the 'whoami' command"
+	ProfileOutput    = "Profile: %s\n"
+	FlagExpiry       = "Displays when the token expires, looking it up among the personal tokens of the account when the CLI doesn't know it"
+	ExpiryOutput     = "Expires: %s\n"
+	ExpiryUnknown    = "Expires: unknown\n"
 )
@@ -0,0 +1,110 @@
+package datastreaming
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/aziontech/azion-cli/pkg/contracts"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/utils"
+)
+
+func dataStreamingPath(id int64) string {
+	return fmt.Sprintf("/data_streaming/streamings/%d", id)
+}
+
+func (c *Client) Create(ctx context.Context, req *Request) (*DataStreaming, error) {
+	logger.Debug("Create Data Streaming")
+
+	// the SDK request models only hold standard endpoints, or the others as a string, so the
+	// endpoint the user wrote is sent through the rest client
+	resp := &dataStreamingResponse{}
+	if err := c.rest.Do(ctx, http.MethodPost, "/data_streaming/streamings", req, resp); err != nil {
+		return nil, err
+	}
+	return &resp.Results, nil
+}
+
+// Get reads the Data Streaming from the body of the response, which the SDK leaves to be read
+// again, since the SDK model only keeps the attributes of kafka endpoints and fails to decode
+// some of the others
+func (c *Client) Get(ctx context.Context, id int64) (*DataStreaming, error) {
+	logger.Debug("Get Data Streaming")
+
+	_, httpResp, err := c.apiClient.DataStreamingAPI.ListDataStreamingById(ctx, int32(id)).Execute()
+	if err != nil && (httpResp == nil || httpResp.StatusCode >= http.StatusMultipleChoices) {
+		if httpResp != nil {
+			logger.Debug("Error while describing a data streaming", zap.Error(err))
+			err := utils.LogAndRewindBody(httpResp)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return nil, utils.ErrorPerStatusCode(httpResp, err)
+	}
+
+	resp := &dataStreamingResponse{}
+	if err := json.NewDecoder(httpResp.Body).Decode(resp); err != nil {
+		return nil, err
+	}
+	if resp.Results.Id == 0 {
+		resp.Results.Id = id
+	}
+	return &resp.Results, nil
+}
+
+func (c *Client) List(ctx context.Context, opts *contracts.ListOptions) (*ListResponse, error) {
+	logger.Debug("List Data Streamings")
+
+	// the SDK list request takes no page, so the pages are requested through the rest client
+	path := fmt.Sprintf("/data_streaming/streamings?page=%d&page_size=%d", opts.Page, opts.PageSize)
+	if opts.OrderBy != "" {
+		path += "&order_by=" + opts.OrderBy
+	}
+	if opts.Sort != "" {
+		path += "&sort=" + opts.Sort
+	}
+
+	resp := &ListResponse{}
+	if err := c.rest.Do(ctx, http.MethodGet, path, nil, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Update changes only the attributes set in the request
+func (c *Client) Update(ctx context.Context, id int64, req *Request) (*DataStreaming, error) {
+	logger.Debug("Update Data Streaming")
+
+	// as on creation, the SDK request models can't hold every type of endpoint
+	resp := &dataStreamingResponse{}
+	if err := c.rest.Do(ctx, http.MethodPatch, dataStreamingPath(id), req, resp); err != nil {
+		return nil, err
+	}
+	if resp.Results.Id == 0 {
+		resp.Results.Id = id
+	}
+	return &resp.Results, nil
+}
+
+func (c *Client) Delete(ctx context.Context, id int64) error {
+	logger.Debug("Delete Data Streaming")
+
+	httpResp, err := c.apiClient.DataStreamingAPI.DeleteDataStreamingById(ctx, int32(id)).Execute()
+	if err != nil {
+		if httpResp != nil {
+			logger.Debug("Error while deleting a data streaming", zap.Error(err))
+			err := utils.LogAndRewindBody(httpResp)
+			if err != nil {
+				return err
+			}
+		}
+		return utils.ErrorPerStatusCode(httpResp, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package datastreaming
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/utils"
+	sdk "github.com/aziontech/azionapi-go-sdk/data_streaming"
+)
+
+// ListDomains returns the domains of the account that can stream their events. When streamingID
+// isn't 0, they tell whether that Data Streaming sends their events, and selected keeps only those
+func (c *Client) ListDomains(ctx context.Context, streamingID int64, selected bool) ([]sdk.DataStreamingsDomainResult, error) {
+	logger.Debug("List Data Streaming Domains")
+
+	request := c.apiClient.DataStreamingDomainAPI.ListDataStreaming(ctx)
+	if streamingID != 0 {
+		request = request.StreamingId(streamingID)
+		if selected {
+			request = request.Selected(true)
+		}
+	}
+
+	resp, httpResp, err := request.Execute()
+	if err != nil {
+		if httpResp != nil {
+			logger.Debug("Error while listing data streaming domains", zap.Error(err))
+			err := utils.LogAndRewindBody(httpResp)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return nil, utils.ErrorPerStatusCode(httpResp, err)
+	}
+
+	return resp.Results, nil
+}
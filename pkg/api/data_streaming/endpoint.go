@@ -0,0 +1,78 @@
+package datastreaming
+
+import (
+	"fmt"
+	"sort"
+)
+
+// EndpointStandard is the type of the endpoints that receive the events through HTTP POST requests
+const EndpointStandard = "standard"
+
+// endpointFields are the attributes each type of endpoint requires, besides endpoint_type
+var endpointFields = map[string][]string{
+	EndpointStandard:       {"url"},
+	"kafka":                {"bootstrap_servers", "kafka_topic"},
+	"s3":                   {"host_url", "bucket_name", "region", "access_key", "secret_key"},
+	"big_query":            {"project_id", "dataset_id", "table_id", "service_account_key"},
+	"elasticsearch":        {"url", "api_key"},
+	"aws_kinesis_firehose": {"stream_name", "region", "access_key", "secret_key"},
+	"datadog":              {"url", "api_key"},
+	"qradar":               {"url"},
+	"azure_monitor":        {"log_type", "shared_key", "workspace_id"},
+	"azure_blob_storage":   {"storage_account", "container_name", "blob_sas_token"},
+	"splunk":               {"url", "api_key"},
+}
+
+// secretFields are the endpoint attributes that hold credentials
+var secretFields = []string{"secret_key", "api_key", "shared_key", "blob_sas_token", "service_account_key"}
+
+// Masked is how the credentials of an endpoint are shown
+const Masked = "********"
+
+// EndpointTypes returns the types of endpoint a Data Streaming sends its events to
+func EndpointTypes() []string {
+	types := make([]string, 0, len(endpointFields))
+	for t := range endpointFields {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// Type is the endpoint_type of the endpoint
+func (e Endpoint) Type() string {
+	t, _ := e["endpoint_type"].(string)
+	return t
+}
+
+// ValidType reports whether the endpoint has one of the EndpointTypes
+func (e Endpoint) ValidType() bool {
+	_, ok := endpointFields[e.Type()]
+	return ok
+}
+
+// Missing returns the attributes the endpoint's type requires but the endpoint doesn't have
+func (e Endpoint) Missing() []string {
+	var missing []string
+	for _, field := range endpointFields[e.Type()] {
+		value, ok := e[field]
+		if !ok || value == nil || fmt.Sprint(value) == "" {
+			missing = append(missing, field)
+		}
+	}
+	return missing
+}
+
+// Masked returns a copy of the endpoint with its credentials hidden
+func (e Endpoint) Masked() Endpoint {
+	masked := make(Endpoint, len(e))
+	for key, value := range e {
+		masked[key] = value
+	}
+	for _, field := range secretFields {
+		if value, ok := masked[field]; ok && value != nil && fmt.Sprint(value) != "" {
+			masked[field] = Masked
+		}
+	}
+	return masked
+}
@@ -0,0 +1,99 @@
+package datastreaming
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// DataSources are where the events a Data Streaming sends come from
+var DataSources = []string{"http", "waf", "cells_console", "rtm_activity"}
+
+// ValidDataSource reports whether Data Streamings send the events of source
+func ValidDataSource(source string) bool {
+	for _, s := range DataSources {
+		if s == source {
+			return true
+		}
+	}
+	return false
+}
+
+// Request is a Data Streaming as it is sent to the API. On updates, only the attributes given change
+type Request struct {
+	Name               *string  `json:"name,omitempty"`
+	TemplateId         *int64   `json:"template_id,omitempty"`
+	DataSource         *string  `json:"data_source,omitempty"`
+	Active             *bool    `json:"active,omitempty"`
+	Endpoint           Endpoint `json:"endpoint,omitempty"`
+	DomainsIds         []int64  `json:"domains_ids,omitempty"`
+	AllDomains         *bool    `json:"all_domains,omitempty"`
+	SamplingPercentage *int64   `json:"sampling_percentage,omitempty"`
+}
+
+type DataStreaming struct {
+	Id                 int64    `json:"id"`
+	Name               string   `json:"name"`
+	TemplateId         int64    `json:"template_id"`
+	TemplateName       string   `json:"template_name,omitempty"`
+	DataSource         string   `json:"data_source"`
+	Active             bool     `json:"active"`
+	AllDomains         bool     `json:"all_domains"`
+	SamplingPercentage *int64   `json:"sampling_percentage,omitempty"`
+	Endpoint           Endpoint `json:"endpoint"`
+	Domains            []Domain `json:"domains,omitempty"`
+}
+
+type Domain struct {
+	DomainId int64  `json:"domain_id"`
+	Name     string `json:"name"`
+}
+
+type dataStreamingResponse struct {
+	Results DataStreaming `json:"results"`
+}
+
+type ListResponse struct {
+	Count      int64           `json:"count"`
+	TotalPages int64           `json:"total_pages"`
+	Results    []DataStreaming `json:"results"`
+}
+
+// Endpoint is where a Data Streaming sends its events. Its attributes depend on its endpoint_type,
+// so it's kept as the JSON the user wrote
+type Endpoint map[string]interface{}
+
+// UnmarshalJSON reads the endpoint as an object. The API returns it inside a list on writes,
+// and some of its versions as a JSON string, so both are read as well
+func (e *Endpoint) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	switch {
+	case bytes.HasPrefix(data, []byte("[")):
+		var endpoints []map[string]interface{}
+		if err := json.Unmarshal(data, &endpoints); err != nil {
+			return err
+		}
+		*e = Endpoint{}
+		for _, endpoint := range endpoints {
+			for key, value := range endpoint {
+				(*e)[key] = value
+			}
+		}
+		return nil
+	case bytes.HasPrefix(data, []byte(`"`)):
+		var text string
+		if err := json.Unmarshal(data, &text); err != nil {
+			return err
+		}
+		if text == "" {
+			return nil
+		}
+		return e.UnmarshalJSON([]byte(text))
+	}
+
+	var endpoint map[string]interface{}
+	if err := json.Unmarshal(data, &endpoint); err != nil {
+		return err
+	}
+	*e = endpoint
+	return nil
+}
@@ -0,0 +1,51 @@
+package datastreaming
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/utils"
+	sdk "github.com/aziontech/azionapi-go-sdk/data_streaming"
+)
+
+func (c *Client) ListTemplates(ctx context.Context) ([]sdk.Template, error) {
+	logger.Debug("List Data Streaming Templates")
+
+	resp, httpResp, err := c.apiClient.DataStreamingTemplatesAPI.ListDataStreamingTemplates(ctx).Execute()
+	if err != nil {
+		if httpResp != nil {
+			logger.Debug("Error while listing data streaming templates", zap.Error(err))
+			err := utils.LogAndRewindBody(httpResp)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return nil, utils.ErrorPerStatusCode(httpResp, err)
+	}
+
+	return resp.Results, nil
+}
+
+func (c *Client) GetTemplate(ctx context.Context, id int64) (*sdk.Template, error) {
+	logger.Debug("Get Data Streaming Template")
+
+	resp, httpResp, err := c.apiClient.DataStreamingTemplatesAPI.GetDataStramingTemplateById(ctx, int32(id)).Execute()
+	if err != nil {
+		if httpResp != nil {
+			logger.Debug("Error while describing a data streaming template", zap.Error(err))
+			err := utils.LogAndRewindBody(httpResp)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return nil, utils.ErrorPerStatusCode(httpResp, err)
+	}
+
+	template := resp.GetResults()
+	if template.Id == nil {
+		template.SetId(int32(id))
+	}
+	return &template, nil
+}
@@ -0,0 +1,30 @@
+package digitalcertificate
+
+import (
+	"net/http"
+
+	"github.com/aziontech/azion-cli/pkg/api/rest"
+	"github.com/aziontech/azion-cli/pkg/cmd/version"
+	sdk "github.com/aziontech/azionapi-go-sdk/digital_certificates"
+)
+
+type Client struct {
+	apiClient *sdk.APIClient
+	rest      *rest.Client
+}
+
+func NewClient(c *http.Client, url string, token string) *Client {
+	conf := sdk.NewConfiguration()
+	conf.HTTPClient = c
+	conf.AddDefaultHeader("Authorization", "token "+token)
+	conf.AddDefaultHeader("Accept", "application/json;version=3")
+	conf.UserAgent = "Azion_CLI/" + version.BinVersion
+	conf.Servers = sdk.ServerConfigurations{
+		{URL: url},
+	}
+
+	return &Client{
+		apiClient: sdk.NewAPIClient(conf),
+		rest:      rest.NewClient(c, url, token),
+	}
+}
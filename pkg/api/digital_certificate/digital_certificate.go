@@ -0,0 +1,118 @@
+package digitalcertificate
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"go.uber.org/zap"
+
+	"github.com/aziontech/azion-cli/pkg/contracts"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/utils"
+	sdk "github.com/aziontech/azionapi-go-sdk/digital_certificates"
+)
+
+func (c *Client) Create(ctx context.Context, req *CreateRequest) (Response, error) {
+	logger.Debug("Create Digital Certificate")
+
+	request := c.apiClient.CreateDigitalCertificateApi.CreateCertificate(ctx).CreateCertificateRequest(req.CreateCertificateRequest)
+	resp, httpResp, err := request.Execute()
+	if err != nil {
+		if httpResp != nil {
+			logger.Debug("Error while creating a digital certificate", zap.Error(err))
+			err := utils.LogAndRewindBody(httpResp)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return nil, utils.ErrorPerStatusCode(httpResp, err)
+	}
+
+	return resp.Results, nil
+}
+
+func (c *Client) Get(ctx context.Context, id int64) (Response, error) {
+	logger.Debug("Get Digital Certificate")
+
+	resp, httpResp, err := c.apiClient.RetrieveDigitalCertificateByIDApi.GetCertificate(ctx, id).Execute()
+	if err != nil {
+		if httpResp != nil {
+			logger.Debug("Error while describing a digital certificate", zap.Error(err))
+			err := utils.LogAndRewindBody(httpResp)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return nil, utils.ErrorPerStatusCode(httpResp, err)
+	}
+
+	return resp.Results, nil
+}
+
+func (c *Client) Update(ctx context.Context, req *UpdateRequest) (Response, error) {
+	logger.Debug("Update Digital Certificate")
+
+	request := c.apiClient.UpdateDigitalCertificateApi.UpdateDigitalCertificate(ctx, req.Id).
+		UpdateDigitalCertificateRequest(req.UpdateDigitalCertificateRequest)
+	resp, httpResp, err := request.Execute()
+	if err != nil {
+		if httpResp != nil {
+			logger.Debug("Error while updating a digital certificate", zap.Error(err))
+			err := utils.LogAndRewindBody(httpResp)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return nil, utils.ErrorPerStatusCode(httpResp, err)
+	}
+
+	return resp.Results, nil
+}
+
+// List reads every page of digital certificates. The SDK's list request takes no page, so the
+// pages are requested through the rest client
+func (c *Client) List(ctx context.Context, opts *contracts.ListOptions) ([]sdk.ResultsInner, error) {
+	logger.Debug("List Digital Certificates")
+
+	query := url.Values{}
+	query.Set("page_size", "100")
+	if opts.OrderBy != "" {
+		query.Set("order_by", opts.OrderBy)
+	}
+	if opts.Sort != "" {
+		query.Set("sort", opts.Sort)
+	}
+
+	var certificates []sdk.ResultsInner
+	for page := 1; ; page++ {
+		query.Set("page", strconv.Itoa(page))
+		resp := &sdk.DC200List{}
+		if err := c.rest.Do(ctx, http.MethodGet, "/digital_certificates?"+query.Encode(), nil, resp); err != nil {
+			return nil, err
+		}
+		certificates = append(certificates, resp.Results...)
+		if page >= int(resp.GetTotalPages()) {
+			return certificates, nil
+		}
+	}
+}
+
+func (c *Client) Delete(ctx context.Context, id int32) error {
+	logger.Debug("Delete Digital Certificate")
+
+	httpResp, err := c.apiClient.DeleteDigitalCertificateApi.RemoveDigitalCertificates(ctx, id).Execute()
+	if err != nil {
+		if httpResp != nil {
+			logger.Debug("Error while deleting a digital certificate", zap.Error(err))
+			err := utils.LogAndRewindBody(httpResp)
+			if err != nil {
+				return err
+			}
+		}
+		return utils.ErrorPerStatusCode(httpResp, err)
+	}
+
+	return nil
+}
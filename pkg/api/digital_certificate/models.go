@@ -0,0 +1,25 @@
+package digitalcertificate
+
+import (
+	sdk "github.com/aziontech/azionapi-go-sdk/digital_certificates"
+)
+
+type CreateRequest struct {
+	sdk.CreateCertificateRequest
+}
+
+type UpdateRequest struct {
+	sdk.UpdateDigitalCertificateRequest
+	Id int32
+}
+
+type Response interface {
+	GetId() int32
+	GetName() string
+	GetSubjectName() []string
+	GetIssuer() string
+	GetValidity() string
+	GetStatus() string
+	GetCertificateType() string
+	GetManaged() bool
+}
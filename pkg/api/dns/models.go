@@ -0,0 +1,52 @@
+package dns
+
+import (
+	"bytes"
+	"encoding/json"
+
+	sdk "github.com/aziontech/azionapi-go-sdk/idns"
+)
+
+// The policies of the records, which answer with all of their values or with one picked by weight
+const (
+	PolicySimple   = "simple"
+	PolicyWeighted = "weighted"
+)
+
+// Types are the record types Edge DNS serves
+var Types = []string{"A", "AAAA", "ANAME", "CAA", "CNAME", "DS", "MX", "NS", "PTR", "SRV", "TXT"}
+
+// ValidType reports whether Edge DNS serves records of recordType
+func ValidType(recordType string) bool {
+	for _, t := range Types {
+		if t == recordType {
+			return true
+		}
+	}
+	return false
+}
+
+type zoneResponse struct {
+	Results zoneResult `json:"results"`
+}
+
+// zoneResult is the zone created or updated. The SDK expects a list of zones, while the API
+// returns the zone itself, so both are read
+type zoneResult struct {
+	sdk.Zone
+}
+
+func (z *zoneResult) UnmarshalJSON(data []byte) error {
+	if !bytes.HasPrefix(bytes.TrimSpace(data), []byte("[")) {
+		return json.Unmarshal(data, &z.Zone)
+	}
+
+	var zones []sdk.Zone
+	if err := json.Unmarshal(data, &zones); err != nil {
+		return err
+	}
+	if len(zones) > 0 {
+		z.Zone = zones[0]
+	}
+	return nil
+}
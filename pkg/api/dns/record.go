@@ -0,0 +1,122 @@
+package dns
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/aziontech/azion-cli/pkg/contracts"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/utils"
+	sdk "github.com/aziontech/azionapi-go-sdk/idns"
+)
+
+func (c *Client) CreateRecord(ctx context.Context, zoneID int64, req *sdk.RecordPostOrPut) (*sdk.RecordPostOrPut, error) {
+	logger.Debug("Create Edge DNS Record")
+
+	resp, httpResp, err := c.apiClient.RecordsAPI.PostZoneRecord(ctx, int32(zoneID)).RecordPostOrPut(*req).Execute()
+	if err != nil {
+		if httpResp != nil {
+			logger.Debug("Error while creating an edge dns record", zap.Error(err))
+			err := utils.LogAndRewindBody(httpResp)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return nil, utils.ErrorPerStatusCode(httpResp, err)
+	}
+
+	record := resp.GetResults()
+	return &record, nil
+}
+
+// ListRecords returns a page of the records of a zone
+func (c *Client) ListRecords(ctx context.Context, zoneID int64, opts *contracts.ListOptions) (*sdk.GetRecordsResponse, error) {
+	logger.Debug("List Edge DNS Records")
+
+	resp, httpResp, err := c.apiClient.RecordsAPI.GetZoneRecords(ctx, int32(zoneID)).Page(opts.Page).PageSize(opts.PageSize).Execute()
+	if err != nil {
+		if httpResp != nil {
+			logger.Debug("Error while listing edge dns records", zap.Error(err))
+			err := utils.LogAndRewindBody(httpResp)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return nil, utils.ErrorPerStatusCode(httpResp, err)
+	}
+
+	return resp, nil
+}
+
+// AllRecords returns every record of a zone, going through all of its pages
+func (c *Client) AllRecords(ctx context.Context, zoneID int64) ([]sdk.RecordGet, error) {
+	var records []sdk.RecordGet
+	opts := &contracts.ListOptions{Page: 1, PageSize: 100}
+	for {
+		resp, err := c.ListRecords(ctx, zoneID, opts)
+		if err != nil {
+			return nil, err
+		}
+		results := resp.GetResults()
+		records = append(records, results.Records...)
+		if opts.Page >= int64(resp.GetTotalPages()) {
+			return records, nil
+		}
+		opts.Page++
+	}
+}
+
+// GetRecord looks the record up among the ones of its zone, since the API has no way of reading
+// a single record
+func (c *Client) GetRecord(ctx context.Context, zoneID, recordID int64) (*sdk.RecordGet, error) {
+	logger.Debug("Get Edge DNS Record")
+
+	records, err := c.AllRecords(ctx, zoneID)
+	if err != nil {
+		return nil, err
+	}
+	for _, record := range records {
+		if int64(record.GetRecordId()) == recordID {
+			return &record, nil
+		}
+	}
+	return nil, utils.ErrorNotFound404
+}
+
+func (c *Client) UpdateRecord(ctx context.Context, zoneID, recordID int64, req *sdk.RecordPostOrPut) (*sdk.RecordPostOrPut, error) {
+	logger.Debug("Update Edge DNS Record")
+
+	resp, httpResp, err := c.apiClient.RecordsAPI.PutZoneRecord(ctx, int32(zoneID), int32(recordID)).RecordPostOrPut(*req).Execute()
+	if err != nil {
+		if httpResp != nil {
+			logger.Debug("Error while updating an edge dns record", zap.Error(err))
+			err := utils.LogAndRewindBody(httpResp)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return nil, utils.ErrorPerStatusCode(httpResp, err)
+	}
+
+	record := resp.GetResults()
+	return &record, nil
+}
+
+func (c *Client) DeleteRecord(ctx context.Context, zoneID, recordID int64) error {
+	logger.Debug("Delete Edge DNS Record")
+
+	_, httpResp, err := c.apiClient.RecordsAPI.DeleteZoneRecord(ctx, int32(zoneID), int32(recordID)).Execute()
+	if err != nil {
+		if httpResp != nil {
+			logger.Debug("Error while deleting an edge dns record", zap.Error(err))
+			err := utils.LogAndRewindBody(httpResp)
+			if err != nil {
+				return err
+			}
+		}
+		return utils.ErrorPerStatusCode(httpResp, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,141 @@
+package dns
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/aziontech/azion-cli/pkg/contracts"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/utils"
+	sdk "github.com/aziontech/azionapi-go-sdk/idns"
+)
+
+func (c *Client) CreateZone(ctx context.Context, req *sdk.Zone) (*sdk.Zone, error) {
+	logger.Debug("Create Edge DNS Zone")
+
+	_, httpResp, err := c.apiClient.ZonesAPI.PostZone(ctx).Zone(*req).Execute()
+	return written(httpResp, err, "Error while creating an edge dns zone")
+}
+
+func (c *Client) GetZone(ctx context.Context, id int64) (*sdk.Zone, error) {
+	logger.Debug("Get Edge DNS Zone")
+
+	resp, httpResp, err := c.apiClient.ZonesAPI.GetZone(ctx, int32(id)).Execute()
+	if err != nil {
+		if httpResp != nil {
+			logger.Debug("Error while describing an edge dns zone", zap.Error(err))
+			err := utils.LogAndRewindBody(httpResp)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return nil, utils.ErrorPerStatusCode(httpResp, err)
+	}
+
+	zone := resp.GetResults()
+	return &zone, nil
+}
+
+func (c *Client) ListZones(ctx context.Context, opts *contracts.ListOptions) (*sdk.GetZonesResponse, error) {
+	logger.Debug("List Edge DNS Zones")
+
+	request := c.apiClient.ZonesAPI.GetZones(ctx).Page(opts.Page).PageSize(opts.PageSize)
+	if opts.OrderBy != "" {
+		request = request.OrderBy(opts.OrderBy)
+	}
+	if opts.Sort != "" {
+		request = request.Sort(opts.Sort)
+	}
+
+	resp, httpResp, err := request.Execute()
+	if err != nil {
+		if httpResp != nil {
+			logger.Debug("Error while listing edge dns zones", zap.Error(err))
+			err := utils.LogAndRewindBody(httpResp)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return nil, utils.ErrorPerStatusCode(httpResp, err)
+	}
+
+	return resp, nil
+}
+
+// FindZone returns the zone of domain, or nil when the account has none
+func (c *Client) FindZone(ctx context.Context, domain string) (*sdk.Zone, error) {
+	opts := &contracts.ListOptions{Page: 1, PageSize: 100}
+	for {
+		resp, err := c.ListZones(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, zone := range resp.Results {
+			if zone.GetDomain() == domain {
+				return &zone, nil
+			}
+		}
+		if opts.Page >= int64(resp.GetTotalPages()) {
+			return nil, nil
+		}
+		opts.Page++
+	}
+}
+
+// UpdateZone replaces the zone with the request, which must have all of its attributes
+func (c *Client) UpdateZone(ctx context.Context, id int64, req *sdk.Zone) (*sdk.Zone, error) {
+	logger.Debug("Update Edge DNS Zone")
+
+	_, httpResp, err := c.apiClient.ZonesAPI.PutZone(ctx, int32(id)).Zone(*req).Execute()
+	zone, err := written(httpResp, err, "Error while updating an edge dns zone")
+	if err != nil {
+		return nil, err
+	}
+	if zone.Id == nil {
+		zone.SetId(int32(id))
+	}
+	return zone, nil
+}
+
+func (c *Client) DeleteZone(ctx context.Context, id int64) error {
+	logger.Debug("Delete Edge DNS Zone")
+
+	_, httpResp, err := c.apiClient.ZonesAPI.DeleteZone(ctx, int32(id)).Execute()
+	if err != nil {
+		if httpResp != nil {
+			logger.Debug("Error while deleting an edge dns zone", zap.Error(err))
+			err := utils.LogAndRewindBody(httpResp)
+			if err != nil {
+				return err
+			}
+		}
+		return utils.ErrorPerStatusCode(httpResp, err)
+	}
+
+	return nil
+}
+
+// written reads the zone the API sent back on creation or update. The SDK expects a list of
+// zones, while the API returns the zone itself, so the SDK fails to decode those responses; as
+// it leaves the body of the response to be read again, the zone is always decoded from it
+func written(httpResp *http.Response, err error, debug string) (*sdk.Zone, error) {
+	if err != nil && (httpResp == nil || httpResp.StatusCode >= http.StatusMultipleChoices) {
+		if httpResp != nil {
+			logger.Debug(debug, zap.Error(err))
+			err := utils.LogAndRewindBody(httpResp)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return nil, utils.ErrorPerStatusCode(httpResp, err)
+	}
+
+	resp := &zoneResponse{}
+	if err := json.NewDecoder(httpResp.Body).Decode(resp); err != nil {
+		return nil, err
+	}
+	return &resp.Results.Zone, nil
+}
@@ -0,0 +1,113 @@
+package edgefirewall
+
+import (
+	"context"
+	"strconv"
+
+	"go.uber.org/zap"
+
+	"github.com/aziontech/azion-cli/pkg/contracts"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/utils"
+	sdk "github.com/aziontech/azionapi-go-sdk/edgefirewall"
+)
+
+func (c *Client) Create(ctx context.Context, req *CreateRequest) (Response, error) {
+	logger.Debug("Create Edge Firewall")
+
+	resp, httpResp, err := c.apiClient.DefaultAPI.EdgeFirewallPost(ctx).CreateEdgeFirewallRequest(req.CreateEdgeFirewallRequest).Execute()
+	if err != nil {
+		if httpResp != nil {
+			logger.Debug("Error while creating an edge firewall", zap.Error(err))
+			err := utils.LogAndRewindBody(httpResp)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return nil, utils.ErrorPerStatusCode(httpResp, err)
+	}
+
+	return resp.Results, nil
+}
+
+func (c *Client) Get(ctx context.Context, id int64) (Response, error) {
+	logger.Debug("Get Edge Firewall")
+
+	resp, httpResp, err := c.apiClient.DefaultAPI.EdgeFirewallUuidGet(ctx, strconv.FormatInt(id, 10)).Execute()
+	if err != nil {
+		if httpResp != nil {
+			logger.Debug("Error while describing an edge firewall", zap.Error(err))
+			err := utils.LogAndRewindBody(httpResp)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return nil, utils.ErrorPerStatusCode(httpResp, err)
+	}
+
+	return resp.Results, nil
+}
+
+func (c *Client) List(ctx context.Context, opts *contracts.ListOptions) (*sdk.ListEdgeFirewallResponse, error) {
+	logger.Debug("List Edge Firewalls")
+
+	request := c.apiClient.DefaultAPI.EdgeFirewallGet(ctx).Page(opts.Page).PageSize(opts.PageSize)
+	if opts.OrderBy != "" {
+		request = request.OrderBy(opts.OrderBy)
+	}
+	if opts.Sort != "" {
+		request = request.Sort(opts.Sort)
+	}
+
+	resp, httpResp, err := request.Execute()
+	if err != nil {
+		if httpResp != nil {
+			logger.Debug("Error while listing edge firewalls", zap.Error(err))
+			err := utils.LogAndRewindBody(httpResp)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return nil, utils.ErrorPerStatusCode(httpResp, err)
+	}
+
+	return resp, nil
+}
+
+func (c *Client) Update(ctx context.Context, req *UpdateRequest) (Response, error) {
+	logger.Debug("Update Edge Firewall")
+
+	request := c.apiClient.DefaultAPI.EdgeFirewallUuidPatch(ctx, strconv.FormatInt(req.Id, 10)).
+		UpdateEdgeFirewallRequest(req.UpdateEdgeFirewallRequest)
+	resp, httpResp, err := request.Execute()
+	if err != nil {
+		if httpResp != nil {
+			logger.Debug("Error while updating an edge firewall", zap.Error(err))
+			err := utils.LogAndRewindBody(httpResp)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return nil, utils.ErrorPerStatusCode(httpResp, err)
+	}
+
+	return resp.Results, nil
+}
+
+func (c *Client) Delete(ctx context.Context, id int64) error {
+	logger.Debug("Delete Edge Firewall")
+
+	httpResp, err := c.apiClient.DefaultAPI.EdgeFirewallUuidDelete(ctx, strconv.FormatInt(id, 10)).Execute()
+	if err != nil {
+		if httpResp != nil {
+			logger.Debug("Error while deleting an edge firewall", zap.Error(err))
+			err := utils.LogAndRewindBody(httpResp)
+			if err != nil {
+				return err
+			}
+		}
+		return utils.ErrorPerStatusCode(httpResp, err)
+	}
+
+	return nil
+}
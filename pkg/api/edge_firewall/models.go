@@ -0,0 +1,61 @@
+package edgefirewall
+
+import (
+	"encoding/json"
+
+	sdk "github.com/aziontech/azionapi-go-sdk/edgefirewall"
+)
+
+type CreateRequest struct {
+	sdk.CreateEdgeFirewallRequest
+}
+
+type UpdateRequest struct {
+	sdk.UpdateEdgeFirewallRequest
+	Id int64
+}
+
+type Response interface {
+	GetId() int64
+	GetName() string
+	GetIsActive() bool
+	GetDomains() []int64
+	GetEdgeFunctionsEnabled() bool
+	GetNetworkProtectionEnabled() bool
+	GetWafEnabled() bool
+	GetDebugRules() bool
+	GetLastEditor() string
+	GetLastModified() string
+}
+
+// RuleSetRequest is a rule of the Edge Firewall rules engine as it is sent to the API.
+// The criteria and behaviors are kept as written in the file given by the user
+type RuleSetRequest struct {
+	Name        *string         `json:"name,omitempty"`
+	Description *string         `json:"description,omitempty"`
+	IsActive    *bool           `json:"is_active,omitempty"`
+	Criteria    json.RawMessage `json:"criteria,omitempty"`
+	Behaviors   json.RawMessage `json:"behaviors,omitempty"`
+}
+
+type RuleSet struct {
+	Id           int64           `json:"id"`
+	Name         string          `json:"name"`
+	Description  string          `json:"description"`
+	IsActive     bool            `json:"is_active"`
+	Order        int64           `json:"order"`
+	Criteria     json.RawMessage `json:"criteria"`
+	Behaviors    json.RawMessage `json:"behaviors"`
+	LastEditor   string          `json:"last_editor"`
+	LastModified string          `json:"last_modified"`
+}
+
+type RuleSetListResponse struct {
+	Count      int64     `json:"count"`
+	TotalPages int64     `json:"total_pages"`
+	Results    []RuleSet `json:"results"`
+}
+
+type ruleSetResponse struct {
+	Results RuleSet `json:"results"`
+}
@@ -0,0 +1,115 @@
+package edgefirewall
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/aziontech/azion-cli/pkg/contracts"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/utils"
+)
+
+// The SDK models only decode part of the criteria and behaviors the API accepts: the criteria
+// operators are limited to the ones of the SSL verification status, and behaviors such as
+// run_function match more than one of the SDK's behavior types. Rules are read through the SDK
+// and decoded from the body of its responses, which it leaves to be read again
+
+func rulesPath(edgeFirewallID int64) string {
+	return fmt.Sprintf("/edge_firewall/%d/rules_engine", edgeFirewallID)
+}
+
+func rulePath(edgeFirewallID, ruleID int64) string {
+	return fmt.Sprintf("/edge_firewall/%d/rules_engine/%d", edgeFirewallID, ruleID)
+}
+
+func (c *Client) CreateRule(ctx context.Context, edgeFirewallID int64, req *RuleSetRequest) (*RuleSet, error) {
+	logger.Debug("Create Edge Firewall Rule")
+
+	// the SDK request model can't hold the criteria and behaviors, so the rule the user wrote is
+	// sent through the rest client
+	resp := &ruleSetResponse{}
+	if err := c.rest.Do(ctx, http.MethodPost, rulesPath(edgeFirewallID), req, resp); err != nil {
+		return nil, err
+	}
+	return &resp.Results, nil
+}
+
+func (c *Client) GetRule(ctx context.Context, edgeFirewallID, ruleID int64) (*RuleSet, error) {
+	logger.Debug("Get Edge Firewall Rule")
+
+	_, httpResp, err := c.apiClient.DefaultAPI.EdgeFirewallEdgeFirewallIdRulesEngineRuleSetIdGet(ctx, edgeFirewallID, ruleID).Execute()
+	resp := &ruleSetResponse{}
+	if err := decode(httpResp, err, "Error while describing an edge firewall rule", resp); err != nil {
+		return nil, err
+	}
+	return &resp.Results, nil
+}
+
+func (c *Client) ListRules(ctx context.Context, edgeFirewallID int64, opts *contracts.ListOptions) (*RuleSetListResponse, error) {
+	logger.Debug("List Edge Firewall Rules")
+
+	request := c.apiClient.DefaultAPI.EdgeFirewallEdgeFirewallIdRulesEngineGet(ctx, edgeFirewallID).Page(opts.Page).PageSize(opts.PageSize)
+	if opts.OrderBy != "" {
+		request = request.OrderBy(opts.OrderBy)
+	}
+	if opts.Sort != "" {
+		request = request.Sort(opts.Sort)
+	}
+
+	_, httpResp, err := request.Execute()
+	resp := &RuleSetListResponse{}
+	if err := decode(httpResp, err, "Error while listing edge firewall rules", resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *Client) UpdateRule(ctx context.Context, edgeFirewallID, ruleID int64, req *RuleSetRequest) (*RuleSet, error) {
+	logger.Debug("Update Edge Firewall Rule")
+
+	// as on creation, the SDK request model can't hold the criteria and behaviors
+	resp := &ruleSetResponse{}
+	if err := c.rest.Do(ctx, http.MethodPatch, rulePath(edgeFirewallID, ruleID), req, resp); err != nil {
+		return nil, err
+	}
+	return &resp.Results, nil
+}
+
+func (c *Client) DeleteRule(ctx context.Context, edgeFirewallID, ruleID int64) error {
+	logger.Debug("Delete Edge Firewall Rule")
+
+	httpResp, err := c.apiClient.DefaultAPI.EdgeFirewallEdgeFirewallIdRulesEngineRuleSetIdDelete(ctx, edgeFirewallID, ruleID).Execute()
+	if err != nil {
+		if httpResp != nil {
+			logger.Debug("Error while deleting an edge firewall rule", zap.Error(err))
+			err := utils.LogAndRewindBody(httpResp)
+			if err != nil {
+				return err
+			}
+		}
+		return utils.ErrorPerStatusCode(httpResp, err)
+	}
+
+	return nil
+}
+
+// decode reads the response of the SDK into out from its body, as the SDK models fail to decode
+// the rules or leave part of them out. Only the errors of the API are returned as such
+func decode(httpResp *http.Response, err error, debug string, out interface{}) error {
+	if err != nil && (httpResp == nil || httpResp.StatusCode >= http.StatusMultipleChoices) {
+		if httpResp != nil {
+			logger.Debug(debug, zap.Error(err))
+			err := utils.LogAndRewindBody(httpResp)
+			if err != nil {
+				return err
+			}
+		}
+		return utils.ErrorPerStatusCode(httpResp, err)
+	}
+
+	return json.NewDecoder(httpResp.Body).Decode(out)
+}
@@ -0,0 +1,21 @@
+package edgeservices
+
+import (
+	"net/http"
+
+	"github.com/aziontech/azion-cli/pkg/cmd/version"
+	sdk "github.com/aziontech/azionapi-go-sdk/edgeservices"
+)
+
+// NewClient returns the SDK client of the Edge Services API; the commands call its DefaultApi directly
+func NewClient(c *http.Client, url string, token string) *sdk.APIClient {
+	conf := sdk.NewConfiguration()
+	conf.HTTPClient = c
+	conf.AddDefaultHeader("Authorization", "token "+token)
+	conf.UserAgent = "Azion_CLI/" + version.BinVersion
+	conf.Servers = sdk.ServerConfigurations{
+		{URL: url},
+	}
+
+	return sdk.NewAPIClient(conf)
+}
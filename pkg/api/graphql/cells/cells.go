@@ -51,7 +51,7 @@ query ConsoleLog {
 `
 
 func CellsConsoleLogs(f *cmdutil.Factory, functionId string, currentTime time.Time, limitFlag string) (CellsConsoleEventsResponse, error) {
-	graphqlClient := graphql.NewClient("https://api.azionapi.net/events/graphql")
+	graphqlClient := graphql.NewClient("https://api.azionapi.net/events/graphql", graphql.WithHTTPClient(f.HttpClient))
 
 	formattedTime := currentTime.Format("2006-01-02T15:04:05")
 
@@ -52,8 +52,32 @@ query HttpEventsLogs {
   }
 `
 
+const windowQuery string = `
+query HttpEventsWindow {
+	httpEvents(
+	  limit: %d
+	  offset: %d
+	  filter: {
+	   tsRange: {begin: "%s", end: "%s"}
+	  }
+	  orderBy: [ts_ASC]
+	)
+	{
+	  host
+	  httpUserAgent
+	  geolocRegionName
+	  requestUri
+	  status
+	  ts
+	  upstreamBytesSent
+	  requestTime
+	  requestMethod
+	}
+  }
+`
+
 func HttpEvents(f *cmdutil.Factory, currentTime time.Time, limitFlag string) (HTTPEventsResponse, error) {
-	graphqlClient := graphql.NewClient("https://api.azionapi.net/events/graphql")
+	graphqlClient := graphql.NewClient("https://api.azionapi.net/events/graphql", graphql.WithHTTPClient(f.HttpClient))
 
 	formattedTime := currentTime.Format("2006-01-02T15:04:05")
 
@@ -77,3 +101,21 @@ func HttpEvents(f *cmdutil.Factory, currentTime time.Time, limitFlag string) (HT
 
 	return response, nil
 }
+
+// HttpEventsWindow returns a page of the events between begin and end, skipping the first offset ones
+func HttpEventsWindow(f *cmdutil.Factory, begin, end time.Time, limit, offset int) (HTTPEventsResponse, error) {
+	graphqlClient := graphql.NewClient("https://api.azionapi.net/events/graphql", graphql.WithHTTPClient(f.HttpClient))
+
+	formattedQuery := fmt.Sprintf(windowQuery, limit, offset, begin.Format("2006-01-02T15:04:05"), end.Format("2006-01-02T15:04:05"))
+
+	graphqlRequest := graphql.NewRequest(formattedQuery)
+	graphqlRequest.Header.Set("Authorization", "Token "+f.Config.GetString("token"))
+
+	var response HTTPEventsResponse
+	if err := graphqlClient.Run(context.Background(), graphqlRequest, &response); err != nil {
+		logger.Debug("", zap.Any("Error", err.Error()))
+		return HTTPEventsResponse{}, msg.ErrorRequest
+	}
+
+	return response, nil
+}
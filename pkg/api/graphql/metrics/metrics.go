@@ -0,0 +1,162 @@
+package metrics
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	msg "github.com/aziontech/azion-cli/messages/metrics"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/machinebox/graphql"
+	"go.uber.org/zap"
+)
+
+const URL = "https://api.azionapi.net/metrics/graphql"
+
+// Row is a single aggregated row of the httpMetrics dataset; only the fields
+// used in the groupBy of the given query are filled
+type Row struct {
+	Ts                  time.Time   `json:"ts"`
+	Status              int         `json:"status"`
+	RequestURI          string      `json:"requestUri"`
+	GeolocCountryName   string      `json:"geolocCountryName"`
+	UpstreamCacheStatus string      `json:"upstreamCacheStatus"`
+	RequestTime         json.Number `json:"requestTime"`
+	Count               int64       `json:"count"`
+	Sum                 float64     `json:"sum"`
+}
+
+type HTTPMetricsResponse struct {
+	ByStatus      []Row `json:"byStatus"`
+	ByTime        []Row `json:"byTime"`
+	Bandwidth     []Row `json:"bandwidth"`
+	ByCache       []Row `json:"byCache"`
+	TopURIs       []Row `json:"topUris"`
+	TopCountries  []Row `json:"topCountries"`
+	ByRequestTime []Row `json:"byRequestTime"`
+}
+
+type Filter struct {
+	Begin         time.Time
+	End           time.Time
+	ApplicationID int64
+	Domain        string
+	Top           int
+}
+
+const query string = `
+query HttpMetrics {
+	byStatus: httpMetrics(
+	  limit: 1000
+	  filter: { %[1]s }
+	  aggregate: { count: rows }
+	  groupBy: [status]
+	  orderBy: [count_DESC]
+	) {
+	  status
+	  count
+	}
+	byTime: httpMetrics(
+	  limit: 10000
+	  filter: { %[1]s }
+	  aggregate: { count: rows }
+	  groupBy: [ts]
+	  orderBy: [ts_ASC]
+	) {
+	  ts
+	  count
+	}
+	bandwidth: httpMetrics(
+	  limit: 10000
+	  filter: { %[1]s }
+	  aggregate: { sum: bytesSent }
+	  groupBy: [ts]
+	  orderBy: [ts_ASC]
+	) {
+	  ts
+	  sum
+	}
+	byCache: httpMetrics(
+	  limit: 100
+	  filter: { %[1]s }
+	  aggregate: { count: rows }
+	  groupBy: [upstreamCacheStatus]
+	  orderBy: [count_DESC]
+	) {
+	  upstreamCacheStatus
+	  count
+	}
+	topUris: httpMetrics(
+	  limit: %[2]d
+	  filter: { %[1]s }
+	  aggregate: { count: rows }
+	  groupBy: [requestUri]
+	  orderBy: [count_DESC]
+	) {
+	  requestUri
+	  count
+	}
+	topCountries: httpMetrics(
+	  limit: %[2]d
+	  filter: { %[1]s }
+	  aggregate: { count: rows }
+	  groupBy: [geolocCountryName]
+	  orderBy: [count_DESC]
+	) {
+	  geolocCountryName
+	  count
+	}
+	byRequestTime: httpMetrics(
+	  limit: 10000
+	  filter: { %[1]s }
+	  aggregate: { count: rows }
+	  groupBy: [requestTime]
+	  orderBy: [requestTime_ASC]
+	) {
+	  requestTime
+	  count
+	}
+  }
+`
+
+// buildFilter writes the filter into the query. The domain is quoted, so that its quotes and
+// braces can't change the query
+func buildFilter(filter Filter) string {
+	formatted := fmt.Sprintf(`tsRange: { begin: "%s", end: "%s" }`,
+		filter.Begin.Format("2006-01-02T15:04:05"), filter.End.Format("2006-01-02T15:04:05"))
+
+	if filter.ApplicationID != 0 {
+		formatted += fmt.Sprintf(` configurationId: "%d"`, filter.ApplicationID)
+	}
+
+	if filter.Domain != "" {
+		formatted += " host: " + strconv.Quote(filter.Domain)
+	}
+
+	return formatted
+}
+
+func HttpMetrics(f *cmdutil.Factory, filter Filter) (HTTPMetricsResponse, error) {
+	graphqlClient := graphql.NewClient(URL, graphql.WithHTTPClient(f.HttpClient))
+
+	//prepare query
+	formattedQuery := fmt.Sprintf(query, buildFilter(filter), filter.Top)
+
+	graphqlRequest := graphql.NewRequest(formattedQuery)
+
+	tokenvalue := f.Config.GetString("token")
+	token := "Token " + tokenvalue
+
+	graphqlRequest.Header.Set("Authorization", token)
+
+	var response HTTPMetricsResponse
+	if err := graphqlClient.Run(context.Background(), graphqlRequest, &response); err != nil {
+		logger.Debug("", zap.Any("Error", err.Error()))
+		return HTTPMetricsResponse{}, msg.ErrorRequest
+	}
+
+	return response, nil
+}
@@ -0,0 +1,55 @@
+package networklist
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/aziontech/azionapi-go-sdk/networklist"
+)
+
+// Request is a Network List as it is sent to the API, which replaces every attribute on updates
+type Request struct {
+	sdk.CreateNetworkListsRequest
+}
+
+type NetworkList struct {
+	Id           int64  `json:"id"`
+	Name         string `json:"name"`
+	ListType     string `json:"list_type"`
+	ItemsValues  Items  `json:"items_values"`
+	LastEditor   string `json:"last_editor"`
+	LastModified string `json:"last_modified"`
+}
+
+type networkListResponse struct {
+	Results NetworkList `json:"results"`
+}
+
+// Items are the values of a Network List. The API returns the ASNs of asn lists as numbers
+// and every other item as a string, so both are read as strings
+type Items []string
+
+func (i *Items) UnmarshalJSON(data []byte) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var values []interface{}
+	if err := decoder.Decode(&values); err != nil {
+		return err
+	}
+
+	items := make(Items, 0, len(values))
+	for _, value := range values {
+		switch v := value.(type) {
+		case string:
+			items = append(items, v)
+		case json.Number:
+			items = append(items, v.String())
+		default:
+			return fmt.Errorf("unexpected network list item %v", v)
+		}
+	}
+	*i = items
+	return nil
+}
@@ -0,0 +1,119 @@
+package networklist
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"go.uber.org/zap"
+
+	"github.com/aziontech/azion-cli/pkg/contracts"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/utils"
+	sdk "github.com/aziontech/azionapi-go-sdk/networklist"
+)
+
+func (c *Client) Create(ctx context.Context, req *Request) (*NetworkList, error) {
+	logger.Debug("Create Network List")
+
+	_, httpResp, err := c.apiClient.DefaultAPI.NetworkListsPost(ctx).CreateNetworkListsRequest(req.CreateNetworkListsRequest).Execute()
+	return result(httpResp, err, "Error while creating a network list")
+}
+
+func (c *Client) Get(ctx context.Context, id int64) (*NetworkList, error) {
+	logger.Debug("Get Network List")
+
+	_, httpResp, err := c.apiClient.DefaultAPI.NetworkListsUuidGet(ctx, strconv.FormatInt(id, 10)).Execute()
+	list, err := result(httpResp, err, "Error while describing a network list")
+	if err != nil {
+		return nil, err
+	}
+	// the API leaves the ID out of the Network List it describes
+	if list.Id == 0 {
+		list.Id = id
+	}
+	return list, nil
+}
+
+func (c *Client) List(ctx context.Context, opts *contracts.ListOptions) (*sdk.ListNetworkListsResponse, error) {
+	logger.Debug("List Network Lists")
+
+	request := c.apiClient.DefaultAPI.NetworkListsGet(ctx).Page(int32(opts.Page)).PageSize(int32(opts.PageSize))
+	if opts.OrderBy != "" {
+		request = request.OrderBy(opts.OrderBy)
+	}
+	if opts.Sort != "" {
+		request = request.Sort(opts.Sort)
+	}
+
+	resp, httpResp, err := request.Execute()
+	if err != nil {
+		if httpResp != nil {
+			logger.Debug("Error while listing network lists", zap.Error(err))
+			err := utils.LogAndRewindBody(httpResp)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return nil, utils.ErrorPerStatusCode(httpResp, err)
+	}
+
+	return resp, nil
+}
+
+// Update replaces the Network List with the request, which must have all of its attributes
+func (c *Client) Update(ctx context.Context, id int64, req *Request) (*NetworkList, error) {
+	logger.Debug("Update Network List")
+
+	_, httpResp, err := c.apiClient.DefaultAPI.NetworkListsUuidPut(ctx, strconv.FormatInt(id, 10)).CreateNetworkListsRequest(req.CreateNetworkListsRequest).Execute()
+	list, err := result(httpResp, err, "Error while updating a network list")
+	if err != nil {
+		return nil, err
+	}
+	if list.Id == 0 {
+		list.Id = id
+	}
+	return list, nil
+}
+
+func (c *Client) Delete(ctx context.Context, id int64) error {
+	logger.Debug("Delete Network List")
+
+	httpResp, err := c.apiClient.DefaultAPI.NetworkListsUuidDelete(ctx, strconv.FormatInt(id, 10)).Execute()
+	if err != nil {
+		if httpResp != nil {
+			logger.Debug("Error while deleting a network list", zap.Error(err))
+			err := utils.LogAndRewindBody(httpResp)
+			if err != nil {
+				return err
+			}
+		}
+		return utils.ErrorPerStatusCode(httpResp, err)
+	}
+
+	return nil
+}
+
+// result reads the Network List the API sent back. The SDK models expect the items to be
+// strings, while the API returns the ASNs of asn lists as numbers, so the SDK fails to decode
+// those responses; as it leaves the body of the response to be read again, the Network List is
+// always decoded from it
+func result(httpResp *http.Response, err error, debug string) (*NetworkList, error) {
+	if err != nil && (httpResp == nil || httpResp.StatusCode >= http.StatusMultipleChoices) {
+		if httpResp != nil {
+			logger.Debug(debug, zap.Error(err))
+			err := utils.LogAndRewindBody(httpResp)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return nil, utils.ErrorPerStatusCode(httpResp, err)
+	}
+
+	resp := &networkListResponse{}
+	if err := json.NewDecoder(httpResp.Body).Decode(resp); err != nil {
+		return nil, err
+	}
+	return &resp.Results, nil
+}
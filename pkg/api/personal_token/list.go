@@ -21,3 +21,18 @@ func (c *Client) List(ctx context.Context) ([]sdk.PersonalTokenResponseGet, erro
 
 	return resp.Results, nil
 }
+
+// Find returns the personal token with the given uuid, or nil when the account has none
+func (c *Client) Find(ctx context.Context, uuid string) (*sdk.PersonalTokenResponseGet, error) {
+	tokens, err := c.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, t := range tokens {
+		if t.GetUuid() == uuid {
+			return &t, nil
+		}
+	}
+	return nil, nil
+}
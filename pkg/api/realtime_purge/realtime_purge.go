@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"time"
 
 	"github.com/aziontech/azion-cli/pkg/cmd/version"
 	"github.com/aziontech/azion-cli/pkg/logger"
@@ -26,7 +25,6 @@ func NewClient(c *http.Client, url string, token string) *Client {
 	conf.Servers = sdk.ServerConfigurations{
 		{URL: url},
 	}
-	conf.HTTPClient.Timeout = 30 * time.Second
 
 	return &Client{
 		apiClient: sdk.NewAPIClient(conf),
@@ -55,3 +53,77 @@ func (c *Client) Purge(ctx context.Context, urlToPurge []string) error {
 
 	return nil
 }
+
+// Cache layers the Cache Keys are purged from
+const (
+	LayerEdge   = "edge_caching"
+	LayerTiered = "l2_caching"
+)
+
+func (c *Client) PurgeURLs(ctx context.Context, urls []string) error {
+	logger.Debug("Purge URLs")
+
+	request := c.apiClient.RealTimePurgeApi.PurgeUrl(ctx).PurgeUrlRequest(sdk.PurgeUrlRequest{
+		Urls:   urls,
+		Method: "delete",
+	})
+	httpResp, err := request.Execute()
+	if err != nil {
+		if httpResp != nil {
+			logger.Debug("Error while purging urls", zap.Error(err))
+			err := utils.LogAndRewindBody(httpResp)
+			if err != nil {
+				return err
+			}
+		}
+		return utils.ErrorPerStatusCode(httpResp, err)
+	}
+
+	return nil
+}
+
+// PurgeWildcard purges the objects that match expression, as the API takes a single expression per request
+func (c *Client) PurgeWildcard(ctx context.Context, expression string) error {
+	logger.Debug("Purge Wildcard")
+
+	request := c.apiClient.RealTimePurgeApi.PurgeWildcard(ctx).PurgeWildcardRequest(sdk.PurgeWildcardRequest{
+		Urls:   []string{expression},
+		Method: "delete",
+	})
+	httpResp, err := request.Execute()
+	if err != nil {
+		if httpResp != nil {
+			logger.Debug("Error while purging a wildcard expression", zap.Error(err))
+			err := utils.LogAndRewindBody(httpResp)
+			if err != nil {
+				return err
+			}
+		}
+		return utils.ErrorPerStatusCode(httpResp, err)
+	}
+
+	return nil
+}
+
+func (c *Client) PurgeCacheKeys(ctx context.Context, keys []string, layer string) error {
+	logger.Debug("Purge Cache Keys")
+
+	request := c.apiClient.RealTimePurgeApi.PurgeCacheKey(ctx).PurgeCacheKeyRequest(sdk.PurgeCacheKeyRequest{
+		Urls:   keys,
+		Method: "delete",
+		Layer:  layer,
+	})
+	httpResp, err := request.Execute()
+	if err != nil {
+		if httpResp != nil {
+			logger.Debug("Error while purging cache keys", zap.Error(err))
+			err := utils.LogAndRewindBody(httpResp)
+			if err != nil {
+				return err
+			}
+		}
+		return utils.ErrorPerStatusCode(httpResp, err)
+	}
+
+	return nil
+}
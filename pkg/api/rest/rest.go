@@ -0,0 +1,71 @@
+// Package rest sends the API requests the SDK has no endpoint for, or whose payloads the SDK
+// models cannot represent, keeping them as the JSON the user wrote. Everything else goes through
+// the SDK clients
+package rest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/aziontech/azion-cli/pkg/cmd/version"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/utils"
+)
+
+type Client struct {
+	httpClient *http.Client
+	url        string
+	token      string
+}
+
+func NewClient(c *http.Client, url string, token string) *Client {
+	return &Client{httpClient: c, url: url, token: token}
+}
+
+// Do sends body as JSON to the path and decodes the response into out, when given.
+// API errors are translated the same way as the ones returned by the SDK clients
+func (c *Client) Do(ctx context.Context, method, path string, body, out interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, utils.Concat(c.url, path), reader)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "token "+c.token)
+	req.Header.Set("Accept", "application/json;version=3")
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Azion_CLI/"+version.BinVersion)
+
+	httpResp, err := c.httpClient.Do(req)
+	if err != nil {
+		return utils.ErrorPerStatusCode(nil, err)
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode >= http.StatusBadRequest {
+		logger.Debug("Error while requesting "+method+" "+path, zap.Int("status", httpResp.StatusCode))
+		err := utils.LogAndRewindBody(httpResp)
+		if err != nil {
+			return err
+		}
+		return utils.ErrorPerStatusCode(httpResp, fmt.Errorf("%s %s: %s", method, path, httpResp.Status))
+	}
+
+	if out == nil || httpResp.StatusCode == http.StatusNoContent {
+		return nil
+	}
+	return json.NewDecoder(httpResp.Body).Decode(out)
+}
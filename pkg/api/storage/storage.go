@@ -7,6 +7,7 @@ import (
 
 	"github.com/aziontech/azion-cli/pkg/cmd/version"
 	"github.com/aziontech/azion-cli/pkg/contracts"
+	"github.com/aziontech/azion-cli/pkg/httpclient"
 	"github.com/aziontech/azion-cli/pkg/logger"
 	"go.uber.org/zap"
 
@@ -15,19 +16,15 @@ import (
 	sdk "github.com/aziontech/azionapi-go-sdk/storage"
 )
 
+// Client uploads the objects of a bucket. Only its requests take as long as the upload timeout
+// allows; the other calls to the storage API go through ClientStorage and the API timeout
 type Client struct {
 	apiClient *sdk.APIClient
 }
 
 func NewClient(c *http.Client, url string, token string) *Client {
-	conf := sdk.NewConfiguration()
-	conf.AddDefaultHeader("Authorization", "Token "+token)
-	conf.UserAgent = "Azion_CLI/" + version.BinVersion
-	conf.Servers = sdk.ServerConfigurations{
-		{URL: url},
-	}
 	return &Client{
-		apiClient: sdk.NewAPIClient(conf),
+		apiClient: newAPIClient(httpclient.For(c, httpclient.Upload), url, token),
 	}
 }
 
@@ -36,15 +33,20 @@ type ClientStorage struct {
 }
 
 func NewClientStorage(c *http.Client, url string, token string) *ClientStorage {
-	conf := storage.NewConfiguration()
+	return &ClientStorage{
+		apiClient: newAPIClient(c, url, token),
+	}
+}
+
+func newAPIClient(c *http.Client, url string, token string) *sdk.APIClient {
+	conf := sdk.NewConfiguration()
+	conf.HTTPClient = c
 	conf.AddDefaultHeader("Authorization", "Token "+token)
 	conf.UserAgent = "Azion_CLI/" + version.BinVersion
-	conf.Servers = storage.ServerConfigurations{
+	conf.Servers = sdk.ServerConfigurations{
 		{URL: url},
 	}
-	return &ClientStorage{
-		apiClient: storage.NewAPIClient(conf),
-	}
+	return sdk.NewAPIClient(conf)
 }
 
 func (c *ClientStorage) CreateBucket(ctx context.Context, name string) error {
@@ -0,0 +1,83 @@
+package waf
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/aziontech/azion-cli/pkg/contracts"
+	"github.com/aziontech/azion-cli/pkg/logger"
+)
+
+// The waf SDK has no allowed rules endpoints, so every request below goes through the rest client
+
+func allowedRulesPath(wafID int64) string {
+	return fmt.Sprintf("/waf/rulesets/%d/allowed_rules", wafID)
+}
+
+func allowedRulePath(wafID, allowedRuleID int64) string {
+	return fmt.Sprintf("/waf/rulesets/%d/allowed_rules/%d", wafID, allowedRuleID)
+}
+
+func (c *Client) CreateAllowedRule(ctx context.Context, wafID int64, req *AllowedRuleRequest) (*AllowedRule, error) {
+	logger.Debug("Create WAF Allowed Rule")
+
+	// no SDK endpoint creates allowed rules
+	resp := &allowedRuleResponse{}
+	if err := c.rest.Do(ctx, http.MethodPost, allowedRulesPath(wafID), req, resp); err != nil {
+		return nil, err
+	}
+	return &resp.Results, nil
+}
+
+func (c *Client) GetAllowedRule(ctx context.Context, wafID, allowedRuleID int64) (*AllowedRule, error) {
+	logger.Debug("Get WAF Allowed Rule")
+
+	// no SDK endpoint reads an allowed rule
+	resp := &allowedRuleResponse{}
+	if err := c.rest.Do(ctx, http.MethodGet, allowedRulePath(wafID, allowedRuleID), nil, resp); err != nil {
+		return nil, err
+	}
+	return &resp.Results, nil
+}
+
+func (c *Client) ListAllowedRules(ctx context.Context, wafID int64, opts *contracts.ListOptions) (*AllowedRuleListResponse, error) {
+	logger.Debug("List WAF Allowed Rules")
+
+	query := url.Values{}
+	query.Set("page", strconv.FormatInt(opts.Page, 10))
+	query.Set("page_size", strconv.FormatInt(opts.PageSize, 10))
+	if opts.OrderBy != "" {
+		query.Set("order_by", opts.OrderBy)
+	}
+	if opts.Sort != "" {
+		query.Set("sort", opts.Sort)
+	}
+
+	// no SDK endpoint lists allowed rules
+	resp := &AllowedRuleListResponse{}
+	if err := c.rest.Do(ctx, http.MethodGet, allowedRulesPath(wafID)+"?"+query.Encode(), nil, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *Client) UpdateAllowedRule(ctx context.Context, wafID, allowedRuleID int64, req *AllowedRuleRequest) (*AllowedRule, error) {
+	logger.Debug("Update WAF Allowed Rule")
+
+	// no SDK endpoint updates allowed rules
+	resp := &allowedRuleResponse{}
+	if err := c.rest.Do(ctx, http.MethodPatch, allowedRulePath(wafID, allowedRuleID), req, resp); err != nil {
+		return nil, err
+	}
+	return &resp.Results, nil
+}
+
+func (c *Client) DeleteAllowedRule(ctx context.Context, wafID, allowedRuleID int64) error {
+	logger.Debug("Delete WAF Allowed Rule")
+
+	// no SDK endpoint deletes allowed rules
+	return c.rest.Do(ctx, http.MethodDelete, allowedRulePath(wafID, allowedRuleID), nil, nil)
+}
@@ -0,0 +1,59 @@
+package waf
+
+import (
+	"encoding/json"
+
+	sdk "github.com/aziontech/azionapi-go-sdk/waf"
+)
+
+type CreateRequest struct {
+	sdk.CreateNewWAFRulesetRequest
+	// Threats holds the sensitivity of each threat family given in the flags; see SetThreats
+	Threats map[string]string `json:"-"`
+}
+
+type UpdateRequest struct {
+	sdk.SingleWAF
+	Id      int64
+	Threats map[string]string `json:"-"`
+}
+
+type singleResponse struct {
+	Results sdk.SingleWAF `json:"results"`
+}
+
+// AllowedRule is an exception to a WAF rule: requests matching its zones are not checked by the rule
+type AllowedRule struct {
+	Id           int64           `json:"id"`
+	RuleId       int64           `json:"rule_id"`
+	Name         string          `json:"name"`
+	Reason       string          `json:"reason"`
+	Path         *string         `json:"path"`
+	MatchZones   json.RawMessage `json:"match_zones"`
+	UseRegex     bool            `json:"use_regex"`
+	IsActive     bool            `json:"is_active"`
+	LastEditor   string          `json:"last_editor"`
+	LastModified string          `json:"last_modified"`
+}
+
+// AllowedRuleRequest is an allowed rule as it is sent to the API; the match zones are kept
+// as written in the file given by the user
+type AllowedRuleRequest struct {
+	RuleId     *int64          `json:"rule_id,omitempty"`
+	Name       *string         `json:"name,omitempty"`
+	Reason     *string         `json:"reason,omitempty"`
+	Path       *string         `json:"path,omitempty"`
+	MatchZones json.RawMessage `json:"match_zones,omitempty"`
+	UseRegex   *bool           `json:"use_regex,omitempty"`
+	IsActive   *bool           `json:"is_active,omitempty"`
+}
+
+type AllowedRuleListResponse struct {
+	Count      int64         `json:"count"`
+	TotalPages int64         `json:"total_pages"`
+	Results    []AllowedRule `json:"results"`
+}
+
+type allowedRuleResponse struct {
+	Results AllowedRule `json:"results"`
+}
@@ -0,0 +1,84 @@
+package waf
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	msg "github.com/aziontech/azion-cli/messages/waf_rule_set"
+	sdk "github.com/aziontech/azionapi-go-sdk/waf"
+)
+
+// ThreatFamilies are the attacks a WAF Rule Set checks, each with its own sensitivity
+var ThreatFamilies = []string{
+	"sql_injection",
+	"remote_file_inclusion",
+	"directory_traversal",
+	"cross_site_scripting",
+	"evading_tricks",
+	"file_upload",
+	"unwanted_access",
+	"identified_attack",
+}
+
+// ThreatOff disables the checks of a threat family
+const ThreatOff = "off"
+
+var sensitivities = map[string]bool{ThreatOff: true, "lowest": true, "low": true, "medium": true, "high": true, "highest": true}
+
+// ValidateThreats checks that every key is a threat family and every value is off or a sensitivity
+func ValidateThreats(threats map[string]string) error {
+	for family, sensitivity := range threats {
+		if !isFamily(family) {
+			return fmt.Errorf(msg.ErrorThreatFamily.Error(), family, strings.Join(ThreatFamilies, ", "))
+		}
+		if !sensitivities[sensitivity] {
+			return fmt.Errorf(msg.ErrorSensitivity.Error(), sensitivity)
+		}
+	}
+	return nil
+}
+
+func isFamily(name string) bool {
+	for _, family := range ThreatFamilies {
+		if family == name {
+			return true
+		}
+	}
+	return false
+}
+
+// withThreats enables the threat families of the request, with their sensitivity, or disables
+// them as given. The other families are kept as they are in the request
+func withThreats(request interface{}, threats map[string]string) error {
+	if len(threats) == 0 {
+		return nil
+	}
+
+	payload, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+
+	body := map[string]interface{}{}
+	if err := json.Unmarshal(payload, &body); err != nil {
+		return err
+	}
+
+	for family, sensitivity := range threats {
+		body[family] = sensitivity != ThreatOff
+		switch key := family + "_sensitivity"; {
+		case sensitivity != ThreatOff:
+			body[key] = sensitivity
+		case body[key] == "":
+			// the API requires a sensitivity even for the families that are off
+			body[key] = string(sdk.MEDIUM)
+		}
+	}
+
+	payload, err = json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(payload, request)
+}
@@ -0,0 +1,137 @@
+package waf
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"go.uber.org/zap"
+
+	"github.com/aziontech/azion-cli/pkg/contracts"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/utils"
+	sdk "github.com/aziontech/azionapi-go-sdk/waf"
+)
+
+func (c *Client) Create(ctx context.Context, req *CreateRequest) (*sdk.SingleWAF, error) {
+	logger.Debug("Create WAF Rule Set")
+
+	if err := withThreats(&req.CreateNewWAFRulesetRequest, req.Threats); err != nil {
+		return nil, err
+	}
+
+	resp, httpResp, err := c.apiClient.WAFAPI.CreateNewWAFRuleset(ctx).CreateNewWAFRulesetRequest(req.CreateNewWAFRulesetRequest).Execute()
+	if err != nil {
+		if httpResp != nil {
+			logger.Debug("Error while creating a waf rule set", zap.Error(err))
+			err := utils.LogAndRewindBody(httpResp)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return nil, utils.ErrorPerStatusCode(httpResp, err)
+	}
+
+	return results(httpResp, resp)
+}
+
+func (c *Client) Get(ctx context.Context, id int64) (*sdk.SingleWAF, error) {
+	logger.Debug("Get WAF Rule Set")
+
+	resp, httpResp, err := c.apiClient.WAFAPI.GetWAFRuleset(ctx, id).Execute()
+	if err != nil {
+		if httpResp != nil {
+			logger.Debug("Error while describing a waf rule set", zap.Error(err))
+			err := utils.LogAndRewindBody(httpResp)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return nil, utils.ErrorPerStatusCode(httpResp, err)
+	}
+
+	return resp.Results, nil
+}
+
+func (c *Client) List(ctx context.Context, opts *contracts.ListOptions) (*sdk.WAFList200, error) {
+	logger.Debug("List WAF Rule Sets")
+
+	request := c.apiClient.WAFAPI.ListAllWAFRulesets(ctx).Page(opts.Page).PageSize(opts.PageSize)
+	if opts.OrderBy != "" {
+		request = request.OrderBy(opts.OrderBy)
+	}
+	if opts.Sort != "" {
+		request = request.Sort(opts.Sort)
+	}
+
+	resp, httpResp, err := request.Execute()
+	if err != nil {
+		if httpResp != nil {
+			logger.Debug("Error while listing waf rule sets", zap.Error(err))
+			err := utils.LogAndRewindBody(httpResp)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return nil, utils.ErrorPerStatusCode(httpResp, err)
+	}
+
+	return resp, nil
+}
+
+func (c *Client) Update(ctx context.Context, req *UpdateRequest) (*sdk.SingleWAF, error) {
+	logger.Debug("Update WAF Rule Set")
+
+	if err := withThreats(&req.SingleWAF, req.Threats); err != nil {
+		return nil, err
+	}
+
+	request := c.apiClient.WAFAPI.UpdateWAFRuleset(ctx, strconv.FormatInt(req.Id, 10)).SingleWAF(req.SingleWAF)
+	resp, httpResp, err := request.Execute()
+	if err != nil {
+		if httpResp != nil {
+			logger.Debug("Error while updating a waf rule set", zap.Error(err))
+			err := utils.LogAndRewindBody(httpResp)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return nil, utils.ErrorPerStatusCode(httpResp, err)
+	}
+
+	return results(httpResp, resp)
+}
+
+func (c *Client) Delete(ctx context.Context, id int64) error {
+	logger.Debug("Delete WAF Rule Set")
+
+	httpResp, err := c.apiClient.WAFAPI.DeleteWAFRuleset(ctx, strconv.FormatInt(id, 10)).Execute()
+	if err != nil {
+		if httpResp != nil {
+			logger.Debug("Error while deleting a waf rule set", zap.Error(err))
+			err := utils.LogAndRewindBody(httpResp)
+			if err != nil {
+				return err
+			}
+		}
+		return utils.ErrorPerStatusCode(httpResp, err)
+	}
+
+	return nil
+}
+
+// results returns the rule set the API sent back on creation or update. The API wraps it in
+// "results", which the SDK doesn't expect for these two operations; as the SDK leaves the body of
+// the response to be read again, the rule set is decoded from it when the SDK found no ID
+func results(httpResp *http.Response, resp *sdk.SingleWAF) (*sdk.SingleWAF, error) {
+	if resp != nil && resp.HasId() {
+		return resp, nil
+	}
+
+	wrapped := &singleResponse{}
+	if err := json.NewDecoder(httpResp.Body).Decode(wrapped); err != nil {
+		return nil, err
+	}
+	return &wrapped.Results, nil
+}
@@ -0,0 +1,217 @@
+package certificate
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	msg "github.com/aziontech/azion-cli/messages/digital_certificate"
+)
+
+// DateLayout is how certificate dates are shown to the user
+const DateLayout = "2006-01-02 15:04:05 MST"
+
+// Bundle is a certificate with its chain and private key, as they are uploaded to Azion
+type Bundle struct {
+	// Certificate is the PEM encoded certificate followed by its chain
+	Certificate string
+	PrivateKey  string
+
+	Leaf  *x509.Certificate
+	Chain []*x509.Certificate
+	key   crypto.PrivateKey
+}
+
+// Load reads the certificate, chain and private key files. The chain is optional
+func Load(certPath, chainPath, keyPath string) (*Bundle, error) {
+	cert, err := readFile(certPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if chainPath != "" {
+		chain, err := readFile(chainPath)
+		if err != nil {
+			return nil, err
+		}
+		if !bytes.HasSuffix(cert, []byte("\n")) {
+			cert = append(cert, '\n')
+		}
+		cert = append(cert, chain...)
+	}
+
+	key, err := readFile(keyPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return Parse(cert, key, certPath, keyPath)
+}
+
+func readFile(path string) ([]byte, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf(msg.ErrorReadFile.Error(), path, err)
+	}
+	return content, nil
+}
+
+// Parse decodes a PEM certificate, optionally followed by its chain, and its private key.
+// The sources name where the PEM blocks came from in the error messages
+func Parse(certPEM, keyPEM []byte, certSource, keySource string) (*Bundle, error) {
+	bundle := &Bundle{
+		Certificate: string(certPEM),
+		PrivateKey:  string(keyPEM),
+	}
+
+	rest := certPEM
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf(msg.ErrorParseCertificate.Error(), certSource, err)
+		}
+
+		if bundle.Leaf == nil {
+			bundle.Leaf = cert
+		} else {
+			bundle.Chain = append(bundle.Chain, cert)
+		}
+	}
+
+	if bundle.Leaf == nil {
+		return nil, fmt.Errorf(msg.ErrorNoCertificate.Error(), certSource)
+	}
+
+	key, err := parsePrivateKey(keyPEM, keySource)
+	if err != nil {
+		return nil, err
+	}
+	bundle.key = key
+
+	return bundle, nil
+}
+
+func parsePrivateKey(keyPEM []byte, source string) (crypto.PrivateKey, error) {
+	rest := keyPEM
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			return nil, fmt.Errorf(msg.ErrorNoPrivateKey.Error(), source)
+		}
+		if !strings.HasSuffix(block.Type, "PRIVATE KEY") {
+			continue
+		}
+
+		if key, err := x509.ParsePKCS8PrivateKey(block.Bytes); err == nil {
+			return key, nil
+		}
+		if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+			return key, nil
+		}
+		key, err := x509.ParseECPrivateKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf(msg.ErrorParsePrivateKey.Error(), source, err)
+		}
+		return key, nil
+	}
+}
+
+// Validate checks that the certificate is in effect at the given time, that the chain is in
+// effect and starts with the certificate's issuer, that the private key matches the certificate
+// and that every hostname is covered by the certificate. All the problems found are returned
+func (b *Bundle) Validate(now time.Time, hostnames []string) error {
+	var problems []error
+
+	if now.Before(b.Leaf.NotBefore) {
+		problems = append(problems, fmt.Errorf(msg.ErrorCertificateNotYet.Error(), b.Leaf.NotBefore.UTC().Format(DateLayout)))
+	}
+	if now.After(b.Leaf.NotAfter) {
+		problems = append(problems, fmt.Errorf(msg.ErrorCertificateExpired.Error(), b.Leaf.NotAfter.UTC().Format(DateLayout)))
+	}
+
+	for _, cert := range b.Chain {
+		if now.After(cert.NotAfter) {
+			problems = append(problems, fmt.Errorf(msg.ErrorChainExpired.Error(), cert.Subject.CommonName, cert.NotAfter.UTC().Format(DateLayout)))
+		}
+	}
+
+	if len(b.Chain) > 0 && b.Leaf.CheckSignatureFrom(b.Chain[0]) != nil {
+		problems = append(problems, fmt.Errorf(msg.ErrorChainOrder.Error(), b.Chain[0].Subject.CommonName))
+	}
+
+	if !b.keyMatches() {
+		problems = append(problems, msg.ErrorKeyMismatch)
+	}
+
+	var uncovered []string
+	for _, hostname := range hostnames {
+		if b.Leaf.VerifyHostname(hostname) != nil {
+			uncovered = append(uncovered, hostname)
+		}
+	}
+	if len(uncovered) > 0 {
+		problems = append(problems, fmt.Errorf(msg.ErrorHostnamesNotCovered.Error(), strings.Join(b.Names(), ", "), strings.Join(uncovered, ", ")))
+	}
+
+	return errors.Join(problems...)
+}
+
+func (b *Bundle) keyMatches() bool {
+	signer, ok := b.key.(crypto.Signer)
+	if !ok {
+		return false
+	}
+
+	public, ok := signer.Public().(interface{ Equal(crypto.PublicKey) bool })
+	return ok && public.Equal(b.Leaf.PublicKey)
+}
+
+// Names returns the hostnames the certificate was issued for
+func (b *Bundle) Names() []string {
+	if len(b.Leaf.DNSNames) > 0 {
+		return b.Leaf.DNSNames
+	}
+	return []string{b.Leaf.Subject.CommonName}
+}
+
+// ExpiresWithin reports whether the certificate expires in less than the given duration from now
+func (b *Bundle) ExpiresWithin(now time.Time, d time.Duration) bool {
+	return b.Leaf.NotAfter.Sub(now) < d
+}
+
+// validityLayout is how the API returns the validity of the uploaded certificates
+const validityLayout = "2006-01-02 15:04:05-07:00"
+
+// Expiry describes an expiry date returned by the API along with how far it is from now
+func Expiry(validity string, now time.Time) string {
+	expires, err := time.Parse(validityLayout, validity)
+	if err != nil {
+		return validity
+	}
+
+	days := int(expires.Sub(now).Hours() / 24)
+	switch {
+	case expires.Before(now):
+		return fmt.Sprintf("%s (expired)", validity)
+	case days == 1:
+		return fmt.Sprintf("%s (in 1 day)", validity)
+	default:
+		return fmt.Sprintf("%s (in %d days)", validity, days)
+	}
+}
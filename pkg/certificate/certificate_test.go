@@ -0,0 +1,137 @@
+package certificate
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var now = time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+type issued struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func issue(t *testing.T, name string, dnsNames []string, notAfter time.Time, parent *issued) *issued {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: name},
+		DNSNames:              dnsNames,
+		NotBefore:             now.AddDate(-1, 0, 0),
+		NotAfter:              notAfter,
+		IsCA:                  parent == nil,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+	}
+
+	parentCert, parentKey := template, key
+	if parent != nil {
+		parentCert, parentKey = parent.cert, parent.key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parentCert, &key.PublicKey, parentKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return &issued{cert: cert, key: key, pem: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})}
+}
+
+func keyPEM(t *testing.T, key *ecdsa.PrivateKey) []byte {
+	t.Helper()
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+}
+
+func TestLoad(t *testing.T) {
+	ca := issue(t, "Test CA", nil, now.AddDate(5, 0, 0), nil)
+	leaf := issue(t, "example.com", []string{"example.com", "*.example.com"}, now.AddDate(0, 3, 0), ca)
+
+	dir := t.TempDir()
+	certPath := filepath.Join(dir, "cert.pem")
+	chainPath := filepath.Join(dir, "chain.pem")
+	keyPath := filepath.Join(dir, "key.pem")
+	require.NoError(t, os.WriteFile(certPath, leaf.pem, 0600))
+	require.NoError(t, os.WriteFile(chainPath, ca.pem, 0600))
+	require.NoError(t, os.WriteFile(keyPath, keyPEM(t, leaf.key), 0600))
+
+	bundle, err := Load(certPath, chainPath, keyPath)
+	require.NoError(t, err)
+	assert.Equal(t, string(leaf.pem)+string(ca.pem), bundle.Certificate)
+	assert.Len(t, bundle.Chain, 1)
+	assert.Equal(t, []string{"example.com", "*.example.com"}, bundle.Names())
+	require.NoError(t, bundle.Validate(now, []string{"example.com", "www.example.com"}))
+
+	_, err = Load(filepath.Join(dir, "missing.pem"), "", keyPath)
+	require.Error(t, err)
+
+	_, err = Load(keyPath, "", keyPath)
+	require.EqualError(t, err, "no PEM encoded certificate was found in "+keyPath)
+}
+
+func TestValidate(t *testing.T) {
+	ca := issue(t, "Test CA", nil, now.AddDate(5, 0, 0), nil)
+	other := issue(t, "Other CA", nil, now.AddDate(5, 0, 0), nil)
+	leaf := issue(t, "example.com", []string{"example.com"}, now.AddDate(0, 3, 0), ca)
+	expired := issue(t, "example.com", []string{"example.com"}, now.AddDate(0, -1, 0), ca)
+
+	t.Run("expired certificate", func(t *testing.T) {
+		bundle, err := Parse(expired.pem, keyPEM(t, expired.key), "cert.pem", "key.pem")
+		require.NoError(t, err)
+		require.EqualError(t, bundle.Validate(now, nil), "the certificate expired on 2024-05-01 00:00:00 UTC")
+	})
+
+	t.Run("key of another certificate", func(t *testing.T) {
+		bundle, err := Parse(leaf.pem, keyPEM(t, ca.key), "cert.pem", "key.pem")
+		require.NoError(t, err)
+		require.EqualError(t, bundle.Validate(now, nil), "the private key does not match the certificate's public key")
+	})
+
+	t.Run("chain of another issuer", func(t *testing.T) {
+		bundle, err := Parse(append(leaf.pem, other.pem...), keyPEM(t, leaf.key), "cert.pem", "key.pem")
+		require.NoError(t, err)
+		require.EqualError(t, bundle.Validate(now, nil),
+			"the certificate was not issued by the first chain certificate 'Other CA'; the chain must start with the issuer of the certificate")
+	})
+
+	t.Run("hostnames not covered", func(t *testing.T) {
+		bundle, err := Parse(leaf.pem, keyPEM(t, leaf.key), "cert.pem", "key.pem")
+		require.NoError(t, err)
+		require.EqualError(t, bundle.Validate(now, []string{"example.com", "www.example.com", "example.org"}),
+			"the certificate's subject alternative names (example.com) do not cover www.example.com, example.org")
+	})
+
+	t.Run("every problem is reported", func(t *testing.T) {
+		bundle, err := Parse(expired.pem, keyPEM(t, leaf.key), "cert.pem", "key.pem")
+		require.NoError(t, err)
+		require.EqualError(t, bundle.Validate(now, []string{"example.org"}),
+			"the certificate expired on 2024-05-01 00:00:00 UTC\n"+
+				"the private key does not match the certificate's public key\n"+
+				"the certificate's subject alternative names (example.com) do not cover example.org")
+	})
+
+	t.Run("expires soon", func(t *testing.T) {
+		bundle, err := Parse(leaf.pem, keyPEM(t, leaf.key), "cert.pem", "key.pem")
+		require.NoError(t, err)
+		assert.False(t, bundle.ExpiresWithin(now, 30*24*time.Hour))
+		assert.True(t, bundle.ExpiresWithin(now.AddDate(0, 2, 15), 30*24*time.Hour))
+	})
+}
@@ -0,0 +1,43 @@
+package certificate
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	msg "github.com/aziontech/azion-cli/messages/digital_certificate"
+	api "github.com/aziontech/azion-cli/pkg/api/domain"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/logger"
+)
+
+// expiryWarning is how close to its expiry date a certificate must be for the upload to warn about it
+const expiryWarning = 30 * 24 * time.Hour
+
+// Check validates the bundle against the CNAMEs of the given Domains before it is uploaded,
+// warning when the certificate is about to expire
+func Check(ctx context.Context, f *cmdutil.Factory, bundle *Bundle, domainIDs []int64) error {
+	var hostnames []string
+	if len(domainIDs) > 0 {
+		client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
+		for _, id := range domainIDs {
+			domain, err := client.Get(ctx, strconv.FormatInt(id, 10))
+			if err != nil {
+				return fmt.Errorf(msg.ErrorGetDomain.Error(), id, err)
+			}
+			hostnames = append(hostnames, domain.GetCnames()...)
+		}
+	}
+
+	now := time.Now()
+	if err := bundle.Validate(now, hostnames); err != nil {
+		return fmt.Errorf(msg.ErrorLocalValidation.Error(), err)
+	}
+
+	if bundle.ExpiresWithin(now, expiryWarning) {
+		days := int(bundle.Leaf.NotAfter.Sub(now).Hours() / 24)
+		logger.LogWarning(f.IOStreams.Err, fmt.Sprintf(msg.WarningExpiresSoon, days, bundle.Leaf.NotAfter.UTC().Format(DateLayout)))
+	}
+	return nil
+}
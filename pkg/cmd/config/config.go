@@ -0,0 +1,36 @@
+package config
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	msg "github.com/aziontech/azion-cli/messages/config"
+	"github.com/aziontech/azion-cli/pkg/cmd/config/get"
+	"github.com/aziontech/azion-cli/pkg/cmd/config/list"
+	"github.com/aziontech/azion-cli/pkg/cmd/config/set"
+	"github.com/aziontech/azion-cli/pkg/cmd/config/unset"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   msg.Usage,
+		Short: msg.ShortDescription,
+		Long:  msg.LongDescription,
+		Example: heredoc.Doc(`
+		$ azion config list --show-origin
+		$ azion config get api_url
+		$ azion config set api_url https://stage-api.azion.net --project
+		$ azion config unset api_url --project
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+	}
+
+	cmd.AddCommand(get.NewCmd(f))
+	cmd.AddCommand(set.NewCmd(f))
+	cmd.AddCommand(unset.NewCmd(f))
+	cmd.AddCommand(list.NewCmd(f))
+	cmd.Flags().BoolP("help", "h", false, msg.FlagHelp)
+	return cmd
+}
@@ -0,0 +1,58 @@
+package get
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+
+	msg "github.com/aziontech/azion-cli/messages/config"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/config"
+	"github.com/aziontech/azion-cli/pkg/token"
+)
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	var showOrigin bool
+
+	cmd := &cobra.Command{
+		Use:           msg.GetUsage,
+		Short:         msg.GetShortDescription,
+		Long:          msg.GetLongDescription,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Args:          cobra.ExactArgs(1),
+		Example: heredoc.Doc(`
+		$ azion config get api_url
+		$ azion config get token --show-origin
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := args[0]
+			if _, ok := config.FindKey(name); !ok {
+				return fmt.Errorf(msg.ErrorUnknownKey.Error(), name, config.KeyNames())
+			}
+
+			settings, err := token.ReadSettings()
+			if err != nil {
+				return err
+			}
+			project, err := config.ReadProject()
+			if err != nil {
+				return fmt.Errorf(msg.ErrorReadProject.Error(), config.ProjectFile, err)
+			}
+
+			value := settings.Resolve(name, cmd.Flags(), project)
+			display := config.Display(name, value.Value)
+			if showOrigin {
+				fmt.Fprintf(f.IOStreams.Out, msg.GetOutputOrigin, display, value.Origin)
+				return nil
+			}
+			fmt.Fprintf(f.IOStreams.Out, msg.GetOutput, display)
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&showOrigin, "show-origin", false, msg.FlagShowOrigin)
+	cmd.Flags().BoolP("help", "h", false, msg.GetHelpFlag)
+	return cmd
+}
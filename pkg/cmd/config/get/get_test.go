@@ -0,0 +1,115 @@
+package get
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/aziontech/azion-cli/pkg/config"
+	"github.com/aziontech/azion-cli/pkg/httpmock"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/testutils"
+)
+
+// useSettings copies the fixture to a temporary settings file and runs the test in an empty
+// project directory
+func useSettings(t *testing.T) string {
+	content, err := os.ReadFile("./fixtures/settings.toml")
+	require.NoError(t, err)
+	settingsPath := filepath.Join(t.TempDir(), "settings.toml")
+	require.NoError(t, os.WriteFile(settingsPath, content, 0600))
+	require.NoError(t, config.SetPath(settingsPath))
+
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(t.TempDir()))
+	t.Cleanup(func() {
+		_ = os.Chdir(wd)
+		_ = config.SetPath(filepath.Join(config.DEFAULT_DIR, config.DEFAULT_SETTINGS))
+	})
+	return settingsPath
+}
+
+func TestGet(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+	t.Setenv("AZIONCLI_API_URL", "")
+
+	t.Run("value of the profile in use", func(t *testing.T) {
+		settingsPath := useSettings(t)
+
+		f, stdout, _ := testutils.NewFactory(&httpmock.Registry{})
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"api_url", "--show-origin"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		assert.Equal(t, "https://stage-api.azion.net\t(profile staging in "+settingsPath+")\n", stdout.String())
+	})
+
+	t.Run("project takes precedence over the profile", func(t *testing.T) {
+		useSettings(t)
+		require.NoError(t, config.WriteProject(map[string]string{"timeout": "90s"}))
+
+		f, stdout, _ := testutils.NewFactory(&httpmock.Registry{})
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"timeout", "--show-origin"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		assert.Equal(t, "90s\t(project file azion/config)\n", stdout.String())
+	})
+
+	t.Run("projects can't change the API URL", func(t *testing.T) {
+		settingsPath := useSettings(t)
+		require.NoError(t, config.WriteProject(map[string]string{"api_url": "https://attacker.example.com"}))
+
+		f, stdout, _ := testutils.NewFactory(&httpmock.Registry{})
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"api_url", "--show-origin"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		assert.Equal(t, "https://stage-api.azion.net\t(profile staging in "+settingsPath+")\n", stdout.String())
+	})
+
+	t.Run("environment takes precedence over the project", func(t *testing.T) {
+		useSettings(t)
+		require.NoError(t, config.WriteProject(map[string]string{"timeout": "90s"}))
+		t.Setenv("AZIONCLI_TIMEOUT", "2m")
+
+		f, stdout, _ := testutils.NewFactory(&httpmock.Registry{})
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"timeout"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		assert.Equal(t, "2m\n", stdout.String())
+	})
+
+	t.Run("mask the token", func(t *testing.T) {
+		useSettings(t)
+
+		f, stdout, _ := testutils.NewFactory(&httpmock.Registry{})
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"token"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		assert.Equal(t, "********5678\n", stdout.String())
+	})
+
+	t.Run("unknown key", func(t *testing.T) {
+		useSettings(t)
+
+		f, _, _ := testutils.NewFactory(&httpmock.Registry{})
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"api-url"})
+
+		_, err := cmd.ExecuteC()
+		require.EqualError(t, err, "Invalid setting provided: api-url. The settings are api_url, storage_url, token, credential_helper, timeout, upload_timeout, max_retries, retry_max_wait, proxy, ca_bundle")
+	})
+}
@@ -0,0 +1,66 @@
+package list
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+
+	msg "github.com/aziontech/azion-cli/messages/config"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/config"
+	"github.com/aziontech/azion-cli/pkg/printer"
+	"github.com/aziontech/azion-cli/pkg/token"
+)
+
+var columns = []printer.Column{
+	{Header: "KEY", Path: "key"},
+	{Header: "VALUE", Path: "value"},
+	{Header: "ORIGIN", Path: "origin", Details: true},
+}
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	output := &printer.Options{}
+	var showOrigin bool
+
+	cmd := &cobra.Command{
+		Use:           "list",
+		Short:         msg.ListShortDescription,
+		Long:          msg.ListLongDescription,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Example: heredoc.Doc(`
+		$ azion config list
+		$ azion config list --show-origin
+		$ azion config list --format json
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := output.Validate(); err != nil {
+				return err
+			}
+
+			settings, err := token.ReadSettings()
+			if err != nil {
+				return err
+			}
+			project, err := config.ReadProject()
+			if err != nil {
+				return fmt.Errorf(msg.ErrorReadProject.Error(), config.ProjectFile, err)
+			}
+
+			var values []token.Value
+			for _, name := range settings.Keys() {
+				value := settings.Resolve(name, cmd.Flags(), project)
+				value.Value = config.Display(name, value.Value)
+				values = append(values, value)
+			}
+			return printer.PrintList(f.IOStreams.Out, output, columns, values, showOrigin)
+		},
+	}
+
+	cmd.Flags().BoolVar(&showOrigin, "show-origin", false, msg.FlagShowOrigin)
+	cmd.Flags().BoolP("help", "h", false, msg.ListHelpFlag)
+	cmdutil.AddOutputFlags(f, cmd, output)
+	cmdutil.AddFilterFlags(cmd, output)
+	return cmd
+}
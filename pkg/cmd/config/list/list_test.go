@@ -0,0 +1,77 @@
+package list
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/config"
+	"github.com/aziontech/azion-cli/pkg/httpmock"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/testutils"
+)
+
+// useSettings copies the fixture to a temporary settings file and runs the test in an empty
+// project directory
+func useSettings(t *testing.T) string {
+	content, err := os.ReadFile("./fixtures/settings.toml")
+	require.NoError(t, err)
+	settingsPath := filepath.Join(t.TempDir(), "settings.toml")
+	require.NoError(t, os.WriteFile(settingsPath, content, 0600))
+	require.NoError(t, config.SetPath(settingsPath))
+
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(t.TempDir()))
+	t.Cleanup(func() {
+		_ = os.Chdir(wd)
+		_ = config.SetPath(filepath.Join(config.DEFAULT_DIR, config.DEFAULT_SETTINGS))
+	})
+	return settingsPath
+}
+
+func TestList(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+	t.Setenv("AZIONCLI_API_URL", "")
+	t.Setenv("AZIONCLI_STORAGE_URL", "")
+	t.Setenv("AZIONCLI_TOKEN", "")
+
+	t.Run("list with origins", func(t *testing.T) {
+		useSettings(t)
+		require.NoError(t, config.WriteProject(map[string]string{"upload_timeout": "1h", "log_level": "debug"}))
+
+		f, stdout, _ := testutils.NewFactory(&httpmock.Registry{})
+		cmd := NewCmd(f)
+		cmdutil.AddFormatFlag(cmd, f)
+		cmd.SetArgs([]string{"--show-origin", "--format", "json"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		out := stdout.String()
+		assert.Contains(t, out, `"value": "https://stage-api.azion.net"`)
+		assert.Contains(t, out, `"origin": "project file azion/config"`)
+		assert.NotContains(t, out, `"key": "log_level"`)
+		assert.Contains(t, out, `"value": "********5678"`)
+		assert.NotContains(t, out, "stagingtoken")
+	})
+
+	t.Run("list without origins", func(t *testing.T) {
+		useSettings(t)
+
+		f, stdout, _ := testutils.NewFactory(&httpmock.Registry{})
+		cmd := NewCmd(f)
+		cmdutil.AddFormatFlag(cmd, f)
+		cmd.SetArgs([]string{})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		assert.Contains(t, stdout.String(), "KEY")
+		assert.NotContains(t, stdout.String(), "ORIGIN")
+		assert.Contains(t, stdout.String(), "credential_helper")
+	})
+}
@@ -0,0 +1,105 @@
+package set
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+
+	msg "github.com/aziontech/azion-cli/messages/config"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/config"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/token"
+)
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	var project bool
+
+	cmd := &cobra.Command{
+		Use:           msg.SetUsage,
+		Short:         msg.SetShortDescription,
+		Long:          msg.SetLongDescription,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Args:          cobra.ExactArgs(2),
+		Example: heredoc.Doc(`
+		$ azion config set api_url https://stage-api.azion.net
+		$ azion config set timeout 90s --project
+		$ azion config set credential_helper pass
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name, value := args[0], args[1]
+			where, err := Save(name, value, project)
+			if err != nil {
+				return err
+			}
+
+			logger.LogSuccess(f.IOStreams.Out, fmt.Sprintf(msg.SetOutputSuccess, name, config.Display(name, value), where))
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&project, "project", false, msg.FlagProject)
+	cmd.Flags().BoolP("help", "h", false, msg.SetHelpFlag)
+	return cmd
+}
+
+// Save changes the setting for the project or the profile in use, returning where it was saved.
+// An empty value removes the setting
+func Save(name, value string, project bool) (string, error) {
+	key, ok := config.FindKey(name)
+	if !ok {
+		return "", fmt.Errorf(msg.ErrorUnknownKey.Error(), name, config.KeyNames())
+	}
+	if key.ReadOnly {
+		return "", fmt.Errorf(msg.ErrorReadOnlyKey.Error(), name, key.ReadOnlyHint)
+	}
+
+	if project {
+		// settings projects can't set may still be removed from the files that have them
+		if !key.Project && value != "" {
+			return "", fmt.Errorf(msg.ErrorProjectKey.Error(), name)
+		}
+		values, err := config.ReadProject()
+		if err != nil {
+			return "", fmt.Errorf(msg.ErrorReadProject.Error(), config.ProjectFile, err)
+		}
+		if value == "" {
+			delete(values, name)
+		} else {
+			values[name] = value
+		}
+		if err := config.WriteProject(values); err != nil {
+			return "", fmt.Errorf(msg.ErrorWriteProject.Error(), config.ProjectFile, err)
+		}
+		return fmt.Sprintf(msg.OriginProject, config.ProjectFile), nil
+	}
+
+	settings, err := token.ReadSettings()
+	if err != nil {
+		return "", err
+	}
+	// credential_helper is the only global setting
+	if key.Global {
+		if err := settings.SetCredentialHelper(value); err != nil {
+			return "", err
+		}
+	} else {
+		settings.SetValue(name, value)
+	}
+	if err := token.WriteSettings(settings); err != nil {
+		return "", err
+	}
+
+	dir, err := config.Dir()
+	if err != nil {
+		return "", err
+	}
+	path := filepath.Join(dir.Dir, dir.Settings)
+	if key.Global {
+		return fmt.Sprintf(msg.OriginSettings, path), nil
+	}
+	return fmt.Sprintf(msg.OriginProfile, settings.ActiveProfile(), path), nil
+}
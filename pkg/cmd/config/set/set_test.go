@@ -0,0 +1,133 @@
+package set
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/aziontech/azion-cli/pkg/config"
+	"github.com/aziontech/azion-cli/pkg/httpmock"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/testutils"
+	"github.com/aziontech/azion-cli/pkg/token"
+)
+
+// useSettings copies the fixture to a temporary settings file and runs the test in an empty
+// project directory
+func useSettings(t *testing.T) string {
+	content, err := os.ReadFile("./fixtures/settings.toml")
+	require.NoError(t, err)
+	settingsPath := filepath.Join(t.TempDir(), "settings.toml")
+	require.NoError(t, os.WriteFile(settingsPath, content, 0600))
+	require.NoError(t, config.SetPath(settingsPath))
+
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(t.TempDir()))
+	t.Cleanup(func() {
+		_ = os.Chdir(wd)
+		_ = config.SetPath(filepath.Join(config.DEFAULT_DIR, config.DEFAULT_SETTINGS))
+	})
+	return settingsPath
+}
+
+func TestSet(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+
+	t.Run("set for the profile in use", func(t *testing.T) {
+		settingsPath := useSettings(t)
+
+		f, stdout, _ := testutils.NewFactory(&httpmock.Registry{})
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"storage_url", "https://stage-storage.azion.net"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		assert.Equal(t, "🚀 Set storage_url to https://stage-storage.azion.net in profile staging in "+settingsPath+"\n\n", stdout.String())
+
+		settings, err := token.ReadSettings()
+		require.NoError(t, err)
+		staging, _ := settings.Profile("staging")
+		assert.Equal(t, "https://stage-storage.azion.net", staging.StorageURL)
+		assert.Equal(t, "stagingtoken5678", settings.Token)
+		assert.Equal(t, 2, settings.AuthorizeMetricsCollection)
+	})
+
+	t.Run("set for the project", func(t *testing.T) {
+		useSettings(t)
+
+		f, stdout, _ := testutils.NewFactory(&httpmock.Registry{})
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"timeout", "90s", "--project"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		assert.Equal(t, "🚀 Set timeout to 90s in project file azion/config\n\n", stdout.String())
+
+		values, err := config.ReadProject()
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"timeout": "90s"}, values)
+	})
+
+	t.Run("URL for a project", func(t *testing.T) {
+		useSettings(t)
+
+		f, _, _ := testutils.NewFactory(&httpmock.Registry{})
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"api_url", "https://project-api.azion.net", "--project"})
+
+		_, err := cmd.ExecuteC()
+		require.EqualError(t, err, "The api_url setting can't be set for a project. Run the command again without the --project flag")
+	})
+
+	t.Run("move the tokens to another credential helper", func(t *testing.T) {
+		useSettings(t)
+
+		bin := t.TempDir()
+		// the fake helper keeps what it's given in a file named after the action and the profile
+		script := "#!/bin/sh\ninput=$(cat)\nprofile=$(echo \"$input\" | head -1 | cut -d= -f2)\nprintf '%s\\n' \"$input\" > \"$STORE/$1-$profile\"\n"
+		require.NoError(t, os.WriteFile(filepath.Join(bin, "azion-credential-fake"), []byte(script), 0700))
+		t.Setenv("PATH", bin+string(os.PathListSeparator)+os.Getenv("PATH"))
+		store := t.TempDir()
+		t.Setenv("STORE", store)
+
+		f, _, _ := testutils.NewFactory(&httpmock.Registry{})
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"credential_helper", "fake"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+
+		stored, err := os.ReadFile(filepath.Join(store, "store-staging"))
+		require.NoError(t, err)
+		assert.Equal(t, "profile=staging\ntoken=stagingtoken5678\n", string(stored))
+		_, err = os.Stat(filepath.Join(store, "store-default"))
+		require.NoError(t, err)
+	})
+
+	t.Run("token can't be set", func(t *testing.T) {
+		useSettings(t)
+
+		f, _, _ := testutils.NewFactory(&httpmock.Registry{})
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"token", "newtoken"})
+
+		_, err := cmd.ExecuteC()
+		require.EqualError(t, err, "The token setting can't be changed with 'azion config'. Run 'azion login' to change it")
+	})
+
+	t.Run("global setting for a project", func(t *testing.T) {
+		useSettings(t)
+
+		f, _, _ := testutils.NewFactory(&httpmock.Registry{})
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"credential_helper", "pass", "--project"})
+
+		_, err := cmd.ExecuteC()
+		require.EqualError(t, err, "The credential_helper setting can't be set for a project. Run the command again without the --project flag")
+	})
+}
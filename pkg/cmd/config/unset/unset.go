@@ -0,0 +1,44 @@
+package unset
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+
+	msg "github.com/aziontech/azion-cli/messages/config"
+	"github.com/aziontech/azion-cli/pkg/cmd/config/set"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/logger"
+)
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	var project bool
+
+	cmd := &cobra.Command{
+		Use:           msg.UnsetUsage,
+		Short:         msg.UnsetShortDescription,
+		Long:          msg.UnsetLongDescription,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Args:          cobra.ExactArgs(1),
+		Example: heredoc.Doc(`
+		$ azion config unset api_url
+		$ azion config unset storage_url --project
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := args[0]
+			where, err := set.Save(name, "", project)
+			if err != nil {
+				return err
+			}
+
+			logger.LogSuccess(f.IOStreams.Out, fmt.Sprintf(msg.UnsetOutputSuccess, name, where))
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&project, "project", false, msg.FlagProject)
+	cmd.Flags().BoolP("help", "h", false, msg.UnsetHelpFlag)
+	return cmd
+}
@@ -0,0 +1,73 @@
+package unset
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/aziontech/azion-cli/pkg/config"
+	"github.com/aziontech/azion-cli/pkg/httpmock"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/testutils"
+	"github.com/aziontech/azion-cli/pkg/token"
+)
+
+// useSettings copies the fixture to a temporary settings file and runs the test in an empty
+// project directory
+func useSettings(t *testing.T) string {
+	content, err := os.ReadFile("./fixtures/settings.toml")
+	require.NoError(t, err)
+	settingsPath := filepath.Join(t.TempDir(), "settings.toml")
+	require.NoError(t, os.WriteFile(settingsPath, content, 0600))
+	require.NoError(t, config.SetPath(settingsPath))
+
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(t.TempDir()))
+	t.Cleanup(func() {
+		_ = os.Chdir(wd)
+		_ = config.SetPath(filepath.Join(config.DEFAULT_DIR, config.DEFAULT_SETTINGS))
+	})
+	return settingsPath
+}
+
+func TestUnset(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+
+	t.Run("unset for the profile in use", func(t *testing.T) {
+		useSettings(t)
+
+		f, _, _ := testutils.NewFactory(&httpmock.Registry{})
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"api_url"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+
+		settings, err := token.ReadSettings()
+		require.NoError(t, err)
+		staging, _ := settings.Profile("staging")
+		assert.Empty(t, staging.ApiURL)
+	})
+
+	t.Run("unset for the project", func(t *testing.T) {
+		useSettings(t)
+		require.NoError(t, config.WriteProject(map[string]string{"api_url": "https://project-api.azion.net", "storage_url": "https://project-storage.azion.net"}))
+
+		f, stdout, _ := testutils.NewFactory(&httpmock.Registry{})
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"api_url", "--project"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		assert.Equal(t, "🚀 Removed api_url from project file azion/config\n\n", stdout.String())
+
+		values, err := config.ReadProject()
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"storage_url": "https://project-storage.azion.net"}, values)
+	})
+}
@@ -66,7 +66,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 
 				if err != nil {
 					logger.Debug("Error while parsing answer", zap.Error(err))
-					return utils.ErrorParseResponse
+					return err
 				}
 
 				applicationID, err := strconv.Atoi(answers)
@@ -90,7 +90,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 					answers, err := utils.AskInput(msg.CreateAskInputName)
 					if err != nil {
 						logger.Debug("Error while parsing answer", zap.Error(err))
-						return utils.ErrorParseResponse
+						return err
 					}
 
 					fields.Name = answers
@@ -118,6 +118,8 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 
 	flags := cmd.Flags()
 	addFlags(flags, fields)
+	cmdutil.MarkPrompted(cmd, "application-id")
+	cmdutil.MarkPromptedUnless(cmd, "file", "name")
 	return cmd
 }
 
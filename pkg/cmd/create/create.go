@@ -4,13 +4,26 @@ import (
 	"github.com/MakeNowJust/heredoc"
 	msg "github.com/aziontech/azion-cli/messages/create"
 	cacheSetting "github.com/aziontech/azion-cli/pkg/cmd/create/cache_setting"
+	dataStreaming "github.com/aziontech/azion-cli/pkg/cmd/create/data_streaming"
+	deviceGroups "github.com/aziontech/azion-cli/pkg/cmd/create/device_groups"
+	digitalCertificate "github.com/aziontech/azion-cli/pkg/cmd/create/digital_certificate"
+	dnsRecord "github.com/aziontech/azion-cli/pkg/cmd/create/dns_record"
+	dnsZone "github.com/aziontech/azion-cli/pkg/cmd/create/dns_zone"
 	domain "github.com/aziontech/azion-cli/pkg/cmd/create/domain"
 	edgeApplications "github.com/aziontech/azion-cli/pkg/cmd/create/edge_applications"
+	edgeFirewall "github.com/aziontech/azion-cli/pkg/cmd/create/edge_firewall"
+	edgeFirewallRule "github.com/aziontech/azion-cli/pkg/cmd/create/edge_firewall_rule"
 	edgeFunction "github.com/aziontech/azion-cli/pkg/cmd/create/edge_function"
+	edgeFunctionsInstances "github.com/aziontech/azion-cli/pkg/cmd/create/edge_functions_instances"
+	edgeServices "github.com/aziontech/azion-cli/pkg/cmd/create/edge_services"
+	edgeServicesResources "github.com/aziontech/azion-cli/pkg/cmd/create/edge_services_resources"
+	networkList "github.com/aziontech/azion-cli/pkg/cmd/create/network_list"
 	origin "github.com/aziontech/azion-cli/pkg/cmd/create/origin"
 	token "github.com/aziontech/azion-cli/pkg/cmd/create/personal_token"
 	rulesEngine "github.com/aziontech/azion-cli/pkg/cmd/create/rules_engine"
 	"github.com/aziontech/azion-cli/pkg/cmd/create/variables"
+	wafAllowedRule "github.com/aziontech/azion-cli/pkg/cmd/create/waf_allowed_rule"
+	wafRuleSet "github.com/aziontech/azion-cli/pkg/cmd/create/waf_rule_set"
 	"github.com/aziontech/azion-cli/pkg/cmdutil"
 	"github.com/spf13/cobra"
 )
@@ -29,6 +42,17 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		$ azion create cache-setting -h
 		$ azion create edge-function -h
 		$ azion create variables -h
+		$ azion create device-group -h
+		$ azion create edge-function-instance -h
+		$ azion create edge-service -h
+		$ azion create edge-service-resource -h
+		$ azion create digital-certificate -h
+		$ azion create edge-firewall -h
+		$ azion create waf-rule-set -h
+		$ azion create network-list -h
+		$ azion create dns-zone -h
+		$ azion create dns-record -h
+		$ azion create data-streaming -h
         `),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return cmd.Help()
@@ -43,6 +67,19 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 	cmd.AddCommand(cacheSetting.NewCmd(f))
 	cmd.AddCommand(edgeFunction.NewCmd(f))
 	cmd.AddCommand(variables.NewCmd(f))
+	cmd.AddCommand(deviceGroups.NewCmd(f))
+	cmd.AddCommand(edgeFunctionsInstances.NewCmd(f))
+	cmd.AddCommand(edgeServices.NewCmd(f))
+	cmd.AddCommand(edgeServicesResources.NewCmd(f))
+	cmd.AddCommand(digitalCertificate.NewCmd(f))
+	cmd.AddCommand(edgeFirewall.NewCmd(f))
+	cmd.AddCommand(edgeFirewallRule.NewCmd(f))
+	cmd.AddCommand(wafRuleSet.NewCmd(f))
+	cmd.AddCommand(wafAllowedRule.NewCmd(f))
+	cmd.AddCommand(networkList.NewCmd(f))
+	cmd.AddCommand(dnsZone.NewCmd(f))
+	cmd.AddCommand(dnsRecord.NewCmd(f))
+	cmd.AddCommand(dataStreaming.NewCmd(f))
 
 	cmd.Flags().BoolP("help", "h", false, msg.FlagHelp)
 	return cmd
@@ -0,0 +1,192 @@
+package datastreaming
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"go.uber.org/zap"
+
+	msg "github.com/aziontech/azion-cli/messages/data_streaming"
+	api "github.com/aziontech/azion-cli/pkg/api/data_streaming"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/utils"
+	"github.com/spf13/cobra"
+)
+
+type Fields struct {
+	Name               string
+	TemplateID         int64
+	DataSource         string
+	Active             string
+	EndpointFile       string
+	EndpointURL        string
+	Domains            []int64
+	AllDomains         string
+	SamplingPercentage int64
+	Path               string
+}
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	fields := &Fields{}
+
+	cmd := &cobra.Command{
+		Use:           msg.Usage,
+		Short:         msg.CreateShortDescription,
+		Long:          msg.CreateLongDescription,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Example: heredoc.Doc(`
+		$ azion create data-streaming --name "siem" --template-id 2 --endpoint-url "https://siem.example.com/ingest" --all-domains true
+		$ azion create data-streaming --name "waf events" --template-id 4 --data-source waf --endpoint-file "./kafka.yaml" --domains 1234,5678
+		$ azion create data-streaming --name "sampled" --template-id 2 --endpoint-file "./s3.json" --all-domains true --sampling-percentage 10
+		$ azion create data-streaming --file "create.yaml"
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			request := api.Request{}
+
+			if cmd.Flags().Changed("file") {
+				err := utils.FlagFileUnmarshalJSON(fields.Path, &request)
+				if err != nil {
+					logger.Debug("Error while parsing <"+fields.Path+"> file", zap.Error(err))
+					return utils.ErrorUnmarshalReader
+				}
+			} else if err := createRequest(cmd, fields, &request); err != nil {
+				return err
+			}
+
+			if err := checkRequest(&request); err != nil {
+				return err
+			}
+
+			client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
+			response, err := client.Create(context.Background(), &request)
+			if err != nil {
+				return fmt.Errorf(msg.ErrorCreate.Error(), err)
+			}
+
+			logger.LogSuccess(f.IOStreams.Out, fmt.Sprintf(msg.CreateOutputSuccess, response.Id))
+			return nil
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&fields.Name, "name", "", msg.FlagName)
+	flags.Int64Var(&fields.TemplateID, "template-id", 0, msg.FlagTemplateID)
+	flags.StringVar(&fields.DataSource, "data-source", "http", msg.FlagDataSource)
+	flags.StringVar(&fields.Active, "active", "true", msg.FlagActive)
+	flags.StringVar(&fields.EndpointFile, "endpoint-file", "", msg.FlagEndpointFile)
+	flags.StringVar(&fields.EndpointURL, "endpoint-url", "", msg.FlagEndpointURL)
+	flags.Int64SliceVar(&fields.Domains, "domains", []int64{}, msg.FlagDomains)
+	flags.StringVar(&fields.AllDomains, "all-domains", "false", msg.FlagAllDomains)
+	flags.Int64Var(&fields.SamplingPercentage, "sampling-percentage", 100, msg.FlagSamplingPercentage)
+	flags.StringVar(&fields.Path, "file", "", msg.CreateFlagFile)
+	flags.BoolP("help", "h", false, msg.CreateHelpFlag)
+	cmdutil.MarkPromptedUnless(cmd, "file", "name", "template-id")
+	return cmd
+}
+
+func createRequest(cmd *cobra.Command, fields *Fields, request *api.Request) error {
+	if !cmd.Flags().Changed("name") {
+		answer, err := utils.AskInput(msg.AskName)
+		if err != nil {
+			return err
+		}
+		fields.Name = answer
+	}
+	request.Name = &fields.Name
+
+	if !cmd.Flags().Changed("template-id") {
+		answer, err := utils.AskInput(msg.AskTemplateID)
+		if err != nil {
+			return err
+		}
+
+		id, err := strconv.ParseInt(answer, 10, 64)
+		if err != nil {
+			logger.Debug("Error while converting answer to int64", zap.Error(err))
+			return msg.ErrorConvertTemplate
+		}
+		fields.TemplateID = id
+	}
+	request.TemplateId = &fields.TemplateID
+	request.DataSource = &fields.DataSource
+
+	active, err := strconv.ParseBool(fields.Active)
+	if err != nil {
+		return msg.ErrorActiveFlag
+	}
+	request.Active = &active
+
+	allDomains, err := strconv.ParseBool(fields.AllDomains)
+	if err != nil {
+		return msg.ErrorAllDomainsFlag
+	}
+	if allDomains && len(fields.Domains) > 0 {
+		return msg.ErrorDomainsFlags
+	}
+	request.AllDomains = &allDomains
+	request.DomainsIds = fields.Domains
+
+	// sampling is only sent when asked for, since accounts without the feature can't set it
+	if cmd.Flags().Changed("sampling-percentage") {
+		request.SamplingPercentage = &fields.SamplingPercentage
+	}
+
+	endpoint, err := readEndpoint(cmd, fields)
+	if err != nil {
+		return err
+	}
+	request.Endpoint = endpoint
+	return nil
+}
+
+// readEndpoint reads the endpoint from a file, or builds a standard one from its URL
+func readEndpoint(cmd *cobra.Command, fields *Fields) (api.Endpoint, error) {
+	if cmd.Flags().Changed("endpoint-file") && cmd.Flags().Changed("endpoint-url") {
+		return nil, msg.ErrorEndpointFlags
+	}
+
+	if cmd.Flags().Changed("endpoint-url") {
+		return api.Endpoint{"endpoint_type": api.EndpointStandard, "url": fields.EndpointURL}, nil
+	}
+
+	endpoint := api.Endpoint{}
+	if cmd.Flags().Changed("endpoint-file") {
+		if err := utils.FlagFileUnmarshalJSON(fields.EndpointFile, &endpoint); err != nil {
+			return nil, fmt.Errorf(msg.ErrorEndpointFile.Error(), fields.EndpointFile, err)
+		}
+	}
+	return endpoint, nil
+}
+
+// checkRequest validates the Data Streaming before it's sent, so that the API doesn't reject it
+// with a less helpful message
+func checkRequest(request *api.Request) error {
+	if request.DataSource != nil && !api.ValidDataSource(*request.DataSource) {
+		return fmt.Errorf(msg.ErrorDataSource.Error(), *request.DataSource)
+	}
+
+	if request.AllDomains != nil && *request.AllDomains && len(request.DomainsIds) > 0 {
+		return msg.ErrorDomainsFlags
+	}
+
+	if p := request.SamplingPercentage; p != nil && (*p < 0 || *p > 100) {
+		return fmt.Errorf(msg.ErrorSampling.Error(), *p)
+	}
+
+	endpoint := request.Endpoint
+	if len(endpoint) == 0 {
+		return msg.ErrorNoEndpoint
+	}
+	if !endpoint.ValidType() {
+		return fmt.Errorf(msg.ErrorEndpointType.Error(), endpoint.Type(), strings.Join(api.EndpointTypes(), ", "))
+	}
+	if missing := endpoint.Missing(); len(missing) > 0 {
+		return fmt.Errorf(msg.ErrorEndpointMissing.Error(), endpoint.Type(), strings.Join(missing, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,145 @@
+package datastreaming
+
+import (
+	"os"
+	"testing"
+
+	msg "github.com/aziontech/azion-cli/messages/data_streaming"
+	"github.com/aziontech/azion-cli/pkg/httpmock"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestCreate(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+
+	t.Run("create streaming to an http endpoint", func(t *testing.T) {
+		response, _ := os.ReadFile("./fixtures/response.json")
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("POST", "data_streaming/streamings"),
+			httpmock.RESTPayload(201, string(response), func(payload map[string]interface{}) {
+				assert.Equal(t, "siem", payload["name"])
+				assert.Equal(t, float64(2), payload["template_id"])
+				assert.Equal(t, "http", payload["data_source"])
+				assert.Equal(t, true, payload["all_domains"])
+				assert.Equal(t, map[string]interface{}{
+					"endpoint_type": "standard",
+					"url":           "https://siem.example.com/ingest",
+				}, payload["endpoint"])
+				assert.NotContains(t, payload, "sampling_percentage")
+				assert.NotContains(t, payload, "domains_ids")
+			}),
+		)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--name", "siem", "--template-id", "2", "--endpoint-url", "https://siem.example.com/ingest", "--all-domains", "true"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		assert.Equal(t, "🚀 Created Data Streaming with ID 1337\n\n", stdout.String())
+	})
+
+	t.Run("create from a yaml file", func(t *testing.T) {
+		response, _ := os.ReadFile("./fixtures/response.json")
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("POST", "data_streaming/streamings"),
+			httpmock.RESTPayload(201, string(response), func(payload map[string]interface{}) {
+				assert.Equal(t, "waf events", payload["name"])
+				assert.Equal(t, "waf", payload["data_source"])
+				assert.Equal(t, []interface{}{float64(1234), float64(5678)}, payload["domains_ids"])
+				endpoint := payload["endpoint"].(map[string]interface{})
+				assert.Equal(t, "kafka", endpoint["endpoint_type"])
+				assert.Equal(t, "azion-waf", endpoint["kafka_topic"])
+				assert.Equal(t, true, endpoint["use_tls"])
+			}),
+		)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--file", "./fixtures/create.yaml"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		assert.Equal(t, "🚀 Created Data Streaming with ID 1337\n\n", stdout.String())
+	})
+
+	t.Run("create with sampling", func(t *testing.T) {
+		response, _ := os.ReadFile("./fixtures/response.json")
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("POST", "data_streaming/streamings"),
+			httpmock.RESTPayload(201, string(response), func(payload map[string]interface{}) {
+				assert.Equal(t, float64(10), payload["sampling_percentage"])
+			}),
+		)
+
+		f, _, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--name", "sampled", "--template-id", "2", "--endpoint-url", "https://siem.example.com/ingest", "--sampling-percentage", "10"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+	})
+
+	t.Run("endpoint missing required attributes", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+
+		f, _, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--name", "s3", "--template-id", "2", "--endpoint-file", "./fixtures/s3.json"})
+
+		_, err := cmd.ExecuteC()
+		require.EqualError(t, err, "The s3 endpoint is missing the attributes secret_key. Add them and try again")
+	})
+
+	t.Run("unknown endpoint type", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+
+		f, _, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--name", "pigeon", "--template-id", "2", "--endpoint-file", "./fixtures/unknown.yml"})
+
+		_, err := cmd.ExecuteC()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `Invalid endpoint_type provided: "carrier_pigeon"`)
+	})
+
+	t.Run("no endpoint", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+
+		f, _, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--name", "siem", "--template-id", "2"})
+
+		_, err := cmd.ExecuteC()
+		require.ErrorIs(t, err, msg.ErrorNoEndpoint)
+	})
+
+	t.Run("domains with all domains", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+
+		f, _, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--name", "siem", "--template-id", "2", "--endpoint-url", "https://siem.example.com", "--domains", "1234", "--all-domains", "true"})
+
+		_, err := cmd.ExecuteC()
+		require.ErrorIs(t, err, msg.ErrorDomainsFlags)
+	})
+
+	t.Run("sampling out of range", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+
+		f, _, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--name", "siem", "--template-id", "2", "--endpoint-url", "https://siem.example.com", "--sampling-percentage", "150"})
+
+		_, err := cmd.ExecuteC()
+		require.EqualError(t, err, "Invalid sampling percentage provided: 150. The value must be from 0 to 100")
+	})
+}
@@ -1,4 +1,4 @@
-package create
+package devicegroups
 
 import (
 	"context"
@@ -33,10 +33,10 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		SilenceUsage:  true,
 		SilenceErrors: true,
 		Example: heredoc.Doc(`
-        $ azion device_groups create --application-id 1673635839 --name "asdf" --user-agent "httpbin.org"
-        $ azion device_groups create -a 1673635839 --name "asdf" --user-agent "httpbin.org"
-        $ azion device_groups create -a 1673635839 --in "create.json"
-        `),
+		$ azion create device-group --application-id 1673635839 --name "asdf" --user-agent "httpbin.org"
+		$ azion create device-group -a 1673635839 --name "asdf" --user-agent "httpbin.org"
+		$ azion create device-group -a 1673635839 --in "create.json"
+		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			request := api.CreateDeviceGroupsRequest{}
 			if cmd.Flags().Changed("in") {
@@ -81,6 +81,6 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 	flags.StringVar(&fields.Name, "name", "", msg.DeviceGroupsCreateFlagName)
 	flags.StringVar(&fields.UserAgent, "user-agent", "", msg.DeviceGroupsCreateFlagUserAgent)
 	flags.StringVar(&fields.Path, "in", "", msg.DeviceGroupsCreateFlagIn)
-	flags.BoolP("help", "h", false, msg.DeviceGroupsFlagHelp)
+	flags.BoolP("help", "h", false, msg.DeviceGroupsCreateHelpFlag)
 	return cmd
 }
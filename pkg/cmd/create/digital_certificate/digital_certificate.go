@@ -0,0 +1,114 @@
+package digitalcertificate
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"go.uber.org/zap"
+
+	msg "github.com/aziontech/azion-cli/messages/digital_certificate"
+	api "github.com/aziontech/azion-cli/pkg/api/digital_certificate"
+	"github.com/aziontech/azion-cli/pkg/certificate"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/utils"
+	"github.com/spf13/cobra"
+)
+
+type Fields struct {
+	Name           string
+	Certificate    string
+	Chain          string
+	PrivateKey     string
+	DomainIDs      []int64
+	SkipValidation bool
+	Path           string
+}
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	fields := &Fields{}
+
+	cmd := &cobra.Command{
+		Use:           msg.Usage,
+		Short:         msg.CreateShortDescription,
+		Long:          msg.CreateLongDescription,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Example: heredoc.Doc(`
+		$ azion create digital-certificate --name "example.com" --certificate cert.pem --private-key key.pem
+		$ azion create digital-certificate --name "example.com" --certificate cert.pem --chain chain.pem --private-key key.pem --domain-id 1678392838
+		$ azion create digital-certificate --file "create.json"
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+			request := api.CreateRequest{}
+
+			if cmd.Flags().Changed("file") {
+				err := utils.FlagFileUnmarshalJSON(fields.Path, &request)
+				if err != nil {
+					logger.Debug("Error while parsing <"+fields.Path+"> file", zap.Error(err))
+					return utils.ErrorUnmarshalReader
+				}
+
+				if !fields.SkipValidation && request.Certificate != "" && request.PrivateKey != "" {
+					bundle, err := certificate.Parse([]byte(request.Certificate), []byte(request.PrivateKey), fields.Path, fields.Path)
+					if err != nil {
+						return err
+					}
+					if err := certificate.Check(ctx, f, bundle, fields.DomainIDs); err != nil {
+						return err
+					}
+				}
+			} else {
+				if !cmd.Flags().Changed("certificate") || !cmd.Flags().Changed("private-key") {
+					return msg.ErrorMissingCertificateFlags
+				}
+
+				if !cmd.Flags().Changed("name") {
+					answer, err := utils.AskInput(msg.AskName)
+					if err != nil {
+						return err
+					}
+					fields.Name = answer
+				}
+
+				bundle, err := certificate.Load(fields.Certificate, fields.Chain, fields.PrivateKey)
+				if err != nil {
+					return err
+				}
+
+				if !fields.SkipValidation {
+					if err := certificate.Check(ctx, f, bundle, fields.DomainIDs); err != nil {
+						return err
+					}
+				}
+
+				request.SetName(fields.Name)
+				request.SetCertificate(bundle.Certificate)
+				request.SetPrivateKey(bundle.PrivateKey)
+			}
+
+			client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
+			response, err := client.Create(ctx, &request)
+			if err != nil {
+				return fmt.Errorf(msg.ErrorCreate.Error(), err)
+			}
+
+			logger.LogSuccess(f.IOStreams.Out, fmt.Sprintf(msg.CreateOutputSuccess, response.GetId()))
+			return nil
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&fields.Name, "name", "", msg.FlagName)
+	flags.StringVar(&fields.Certificate, "certificate", "", msg.FlagCertificate)
+	flags.StringVar(&fields.Chain, "chain", "", msg.FlagChain)
+	flags.StringVar(&fields.PrivateKey, "private-key", "", msg.FlagPrivateKey)
+	flags.Int64SliceVar(&fields.DomainIDs, "domain-id", []int64{}, msg.FlagDomainID)
+	flags.BoolVar(&fields.SkipValidation, "skip-validation", false, msg.FlagSkipValidation)
+	flags.StringVar(&fields.Path, "file", "", msg.CreateFlagFile)
+	flags.BoolP("help", "h", false, msg.CreateHelpFlag)
+	cmdutil.MarkPromptedUnless(cmd, "file", "name")
+	return cmd
+}
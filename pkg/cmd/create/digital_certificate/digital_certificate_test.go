@@ -0,0 +1,146 @@
+package digitalcertificate
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/aziontech/azion-cli/pkg/httpmock"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+// writeCertificate writes a self-signed certificate for example.com expiring at notAfter and its key
+func writeCertificate(t *testing.T, notAfter time.Time) (certPath, keyPath string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "example.com"},
+		DNSNames:     []string{"example.com", "*.example.com"},
+		NotBefore:    time.Now().AddDate(-1, 0, 0),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	certPath = filepath.Join(dir, "cert.pem")
+	keyPath = filepath.Join(dir, "key.pem")
+	require.NoError(t, os.WriteFile(certPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	require.NoError(t, os.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER}), 0600))
+	return certPath, keyPath
+}
+
+func TestCreate(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+
+	t.Run("create with certificate files", func(t *testing.T) {
+		certPath, keyPath := writeCertificate(t, time.Now().AddDate(1, 0, 0))
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("POST", "digital_certificates"),
+			httpmock.JSONFromFile("./fixtures/response.json"),
+		)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--name", "example.com", "--certificate", certPath, "--private-key", keyPath})
+
+		err := cmd.Execute()
+		require.NoError(t, err)
+		assert.Equal(t, "🚀 Created Digital Certificate with ID 1337\n\n", stdout.String())
+	})
+
+	t.Run("warn when the certificate expires soon", func(t *testing.T) {
+		certPath, keyPath := writeCertificate(t, time.Now().AddDate(0, 0, 10).Add(time.Hour))
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("POST", "digital_certificates"),
+			httpmock.JSONFromFile("./fixtures/response.json"),
+		)
+
+		f, stdout, stderr := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--name", "example.com", "--certificate", certPath, "--private-key", keyPath})
+
+		err := cmd.Execute()
+		require.NoError(t, err)
+		assert.Contains(t, stderr.String(), "The certificate expires in 10 days")
+		assert.NotContains(t, stdout.String(), "The certificate expires")
+		assert.Contains(t, stdout.String(), "Created Digital Certificate with ID 1337")
+	})
+
+	t.Run("expired certificate is not uploaded", func(t *testing.T) {
+		certPath, keyPath := writeCertificate(t, time.Now().AddDate(0, -1, 0))
+		mock := &httpmock.Registry{}
+
+		f, _, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--name", "example.com", "--certificate", certPath, "--private-key", keyPath})
+
+		err := cmd.Execute()
+		require.ErrorContains(t, err, "The certificate failed the local validation:\nthe certificate expired on")
+		mock.Verify(t)
+	})
+
+	t.Run("expired certificate is uploaded when validation is skipped", func(t *testing.T) {
+		certPath, keyPath := writeCertificate(t, time.Now().AddDate(0, -1, 0))
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("POST", "digital_certificates"),
+			httpmock.JSONFromFile("./fixtures/response.json"),
+		)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--name", "example.com", "--certificate", certPath, "--private-key", keyPath, "--skip-validation"})
+
+		err := cmd.Execute()
+		require.NoError(t, err)
+		assert.Equal(t, "🚀 Created Digital Certificate with ID 1337\n\n", stdout.String())
+	})
+
+	t.Run("certificate does not cover the domain", func(t *testing.T) {
+		certPath, keyPath := writeCertificate(t, time.Now().AddDate(1, 0, 0))
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("GET", "domains/1678392838"),
+			httpmock.JSONFromFile("./fixtures/domain.json"),
+		)
+
+		f, _, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--name", "example.com", "--certificate", certPath, "--private-key", keyPath, "--domain-id", "1678392838"})
+
+		err := cmd.Execute()
+		require.ErrorContains(t, err, "the certificate's subject alternative names (example.com, *.example.com) do not cover www.example.org")
+	})
+
+	t.Run("missing private key", func(t *testing.T) {
+		certPath, _ := writeCertificate(t, time.Now().AddDate(1, 0, 0))
+		mock := &httpmock.Registry{}
+
+		f, _, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--name", "example.com", "--certificate", certPath})
+
+		err := cmd.Execute()
+		require.Error(t, err)
+	})
+}
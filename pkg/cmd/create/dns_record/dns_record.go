@@ -0,0 +1,136 @@
+package dnsrecord
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"go.uber.org/zap"
+
+	msg "github.com/aziontech/azion-cli/messages/dns_record"
+	api "github.com/aziontech/azion-cli/pkg/api/dns"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/utils"
+	sdk "github.com/aziontech/azionapi-go-sdk/idns"
+	"github.com/spf13/cobra"
+)
+
+type Fields struct {
+	ZoneID      int64
+	Entry       string
+	RecordType  string
+	Answers     []string
+	TTL         int32
+	Description string
+	Policy      string
+	Weight      int32
+	Path        string
+}
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	fields := &Fields{}
+
+	cmd := &cobra.Command{
+		Use:           msg.Usage,
+		Short:         msg.CreateShortDescription,
+		Long:          msg.CreateLongDescription,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Example: heredoc.Doc(`
+		$ azion create dns-record --zone-id 1337 --entry www --record-type A --answers 192.0.2.1,192.0.2.2
+		$ azion create dns-record --zone-id 1337 --entry @ --record-type MX --answers "10 mail.example.com" --ttl 300
+		$ azion create dns-record --zone-id 1337 --entry api --record-type CNAME --answers a.example.net --policy weighted --weight 80
+		$ azion create dns-record --zone-id 1337 --file "create.json"
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !cmd.Flags().Changed("zone-id") {
+				answer, err := utils.AskInput(msg.AskZoneID)
+				if err != nil {
+					return err
+				}
+
+				id, err := strconv.ParseInt(answer, 10, 64)
+				if err != nil {
+					logger.Debug("Error while converting answer to int64", zap.Error(err))
+					return msg.ErrorConvertZoneID
+				}
+				fields.ZoneID = id
+			}
+
+			request := sdk.RecordPostOrPut{}
+
+			if cmd.Flags().Changed("file") {
+				err := utils.FlagFileUnmarshalJSON(fields.Path, &request)
+				if err != nil {
+					logger.Debug("Error while parsing <"+fields.Path+"> file", zap.Error(err))
+					return utils.ErrorUnmarshalReader
+				}
+			} else {
+				if !cmd.Flags().Changed("entry") {
+					answer, err := utils.AskInput(msg.AskEntry)
+					if err != nil {
+						return err
+					}
+					fields.Entry = answer
+				}
+				if !cmd.Flags().Changed("record-type") {
+					answer, err := utils.AskInput(msg.AskRecordType)
+					if err != nil {
+						return err
+					}
+					fields.RecordType = answer
+				}
+
+				recordType := strings.ToUpper(fields.RecordType)
+				if !api.ValidType(recordType) {
+					return fmt.Errorf(msg.ErrorRecordType.Error(), fields.RecordType)
+				}
+				if fields.Policy != api.PolicySimple && fields.Policy != api.PolicyWeighted {
+					return fmt.Errorf(msg.ErrorPolicy.Error(), fields.Policy)
+				}
+				if len(fields.Answers) == 0 {
+					return msg.ErrorMissingAnswers
+				}
+
+				request.SetEntry(fields.Entry)
+				request.SetRecordType(recordType)
+				request.SetAnswersList(fields.Answers)
+				request.SetTtl(fields.TTL)
+				request.SetPolicy(fields.Policy)
+				if cmd.Flags().Changed("description") {
+					request.SetDescription(fields.Description)
+				}
+				if cmd.Flags().Changed("weight") {
+					request.SetWeight(fields.Weight)
+				}
+			}
+
+			client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
+			response, err := client.CreateRecord(context.Background(), fields.ZoneID, &request)
+			if err != nil {
+				return fmt.Errorf(msg.ErrorCreate.Error(), err)
+			}
+
+			logger.LogSuccess(f.IOStreams.Out, fmt.Sprintf(msg.CreateOutputSuccess, response.GetId()))
+			return nil
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.Int64Var(&fields.ZoneID, "zone-id", 0, msg.FlagZoneID)
+	flags.StringVar(&fields.Entry, "entry", "", msg.FlagEntry)
+	flags.StringVar(&fields.RecordType, "record-type", "", msg.FlagRecordType)
+	flags.StringSliceVar(&fields.Answers, "answers", []string{}, msg.FlagAnswers)
+	flags.Int32Var(&fields.TTL, "ttl", 3600, msg.FlagTTL)
+	flags.StringVar(&fields.Description, "description", "", msg.FlagDescription)
+	flags.StringVar(&fields.Policy, "policy", api.PolicySimple, msg.FlagPolicy)
+	flags.Int32Var(&fields.Weight, "weight", 0, msg.FlagWeight)
+	flags.StringVar(&fields.Path, "file", "", msg.CreateFlagFile)
+	flags.BoolP("help", "h", false, msg.CreateHelpFlag)
+	cmdutil.MarkPrompted(cmd, "zone-id")
+	cmdutil.MarkPromptedUnless(cmd, "file", "entry", "record-type")
+	return cmd
+}
@@ -0,0 +1,67 @@
+package dnsrecord
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	msg "github.com/aziontech/azion-cli/messages/dns_record"
+	"github.com/aziontech/azion-cli/pkg/httpmock"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestCreate(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+
+	t.Run("create with flags", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("POST", "intelligent_dns/1337/records"),
+			func(req *http.Request) (*http.Response, error) {
+				payload := map[string]interface{}{}
+				require.NoError(t, json.NewDecoder(req.Body).Decode(&payload))
+				assert.Equal(t, "www", payload["entry"])
+				assert.Equal(t, "A", payload["record_type"])
+				assert.Equal(t, []interface{}{"192.0.2.1", "192.0.2.2"}, payload["answers_list"])
+				assert.Equal(t, float64(300), payload["ttl"])
+				assert.Equal(t, "simple", payload["policy"])
+				assert.NotContains(t, payload, "weight")
+				return httpmock.JSONFromFile("./fixtures/record.json")(req)
+			},
+		)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--zone-id", "1337", "--entry", "www", "--record-type", "a", "--answers", "192.0.2.1,192.0.2.2", "--ttl", "300"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		assert.Equal(t, "🚀 Created Edge DNS Record with ID 42\n\n", stdout.String())
+	})
+
+	t.Run("invalid record type", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+
+		f, _, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--zone-id", "1337", "--entry", "www", "--record-type", "HINFO", "--answers", "PC"})
+
+		_, err := cmd.ExecuteC()
+		require.EqualError(t, err, `Invalid --record-type flag provided: "HINFO". The types are A, AAAA, ANAME, CAA, CNAME, DS, MX, NS, PTR, SRV and TXT`)
+	})
+
+	t.Run("missing answers", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+
+		f, _, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--zone-id", "1337", "--entry", "www", "--record-type", "A"})
+
+		_, err := cmd.ExecuteC()
+		require.ErrorIs(t, err, msg.ErrorMissingAnswers)
+	})
+}
@@ -0,0 +1,92 @@
+package dnszone
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc"
+	"go.uber.org/zap"
+
+	msg "github.com/aziontech/azion-cli/messages/dns_zone"
+	api "github.com/aziontech/azion-cli/pkg/api/dns"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/utils"
+	sdk "github.com/aziontech/azionapi-go-sdk/idns"
+	"github.com/spf13/cobra"
+)
+
+type Fields struct {
+	Name   string
+	Domain string
+	Active string
+	Path   string
+}
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	fields := &Fields{}
+
+	cmd := &cobra.Command{
+		Use:           msg.Usage,
+		Short:         msg.CreateShortDescription,
+		Long:          msg.CreateLongDescription,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Example: heredoc.Doc(`
+		$ azion create dns-zone --domain example.com
+		$ azion create dns-zone --name "shop" --domain example.com --active false
+		$ azion create dns-zone --file "create.json"
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			request := sdk.Zone{}
+
+			if cmd.Flags().Changed("file") {
+				err := utils.FlagFileUnmarshalJSON(fields.Path, &request)
+				if err != nil {
+					logger.Debug("Error while parsing <"+fields.Path+"> file", zap.Error(err))
+					return utils.ErrorUnmarshalReader
+				}
+			} else {
+				if !cmd.Flags().Changed("domain") {
+					answer, err := utils.AskInput(msg.AskDomain)
+					if err != nil {
+						return err
+					}
+					fields.Domain = answer
+				}
+				request.SetDomain(fields.Domain)
+
+				// zones are named after their domain unless told otherwise
+				if fields.Name == "" {
+					fields.Name = fields.Domain
+				}
+				request.SetName(fields.Name)
+
+				active, err := strconv.ParseBool(fields.Active)
+				if err != nil {
+					return msg.ErrorActiveFlag
+				}
+				request.SetIsActive(active)
+			}
+
+			client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
+			response, err := client.CreateZone(context.Background(), &request)
+			if err != nil {
+				return fmt.Errorf(msg.ErrorCreate.Error(), err)
+			}
+
+			logger.LogSuccess(f.IOStreams.Out, fmt.Sprintf(msg.CreateOutputSuccess, response.GetId()))
+			return nil
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&fields.Name, "name", "", msg.FlagName)
+	flags.StringVar(&fields.Domain, "domain", "", msg.FlagDomain)
+	flags.StringVar(&fields.Active, "active", "true", msg.FlagActive)
+	flags.StringVar(&fields.Path, "file", "", msg.CreateFlagFile)
+	flags.BoolP("help", "h", false, msg.CreateHelpFlag)
+	cmdutil.MarkPromptedUnless(cmd, "file", "domain")
+	return cmd
+}
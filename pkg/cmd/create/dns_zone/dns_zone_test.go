@@ -0,0 +1,70 @@
+package dnszone
+
+import (
+	"os"
+	"testing"
+
+	msg "github.com/aziontech/azion-cli/messages/dns_zone"
+	"github.com/aziontech/azion-cli/pkg/httpmock"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestCreate(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+
+	t.Run("create named after its domain", func(t *testing.T) {
+		response, _ := os.ReadFile("./fixtures/response.json")
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("POST", "intelligent_dns"),
+			httpmock.RESTPayload(201, string(response), func(payload map[string]interface{}) {
+				assert.Equal(t, "example.com", payload["name"])
+				assert.Equal(t, "example.com", payload["domain"])
+				assert.Equal(t, true, payload["is_active"])
+			}),
+		)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--domain", "example.com"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		assert.Equal(t, "🚀 Created Edge DNS Zone with ID 1337\n\n", stdout.String())
+	})
+
+	t.Run("create reading the zone from a list", func(t *testing.T) {
+		response, _ := os.ReadFile("./fixtures/response_list.json")
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("POST", "intelligent_dns"),
+			httpmock.RESTPayload(201, string(response), func(payload map[string]interface{}) {
+				assert.Equal(t, "shop", payload["name"])
+				assert.Equal(t, false, payload["is_active"])
+			}),
+		)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--name", "shop", "--domain", "example.com", "--active", "false"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		assert.Equal(t, "🚀 Created Edge DNS Zone with ID 1337\n\n", stdout.String())
+	})
+
+	t.Run("invalid active flag", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+
+		f, _, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--domain", "example.com", "--active", "maybe"})
+
+		_, err := cmd.ExecuteC()
+		require.ErrorIs(t, err, msg.ErrorActiveFlag)
+	})
+}
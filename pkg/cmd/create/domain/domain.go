@@ -122,5 +122,6 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 	flags.StringVar(&fields.IsActive, "active", "true", msg.FlagIsActive)
 	flags.StringVar(&fields.Path, "file", "", msg.FlagFile)
 	flags.BoolP("help", "h", false, msg.HelpFlag)
+	cmdutil.MarkPromptedUnless(cmd, "file", "application-id", "name")
 	return cmd
 }
@@ -4,7 +4,9 @@ import (
 	"net/http"
 	"testing"
 
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
 	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/utils"
 	"go.uber.org/zap/zapcore"
 
 	"github.com/aziontech/azion-cli/pkg/httpmock"
@@ -53,6 +55,18 @@ func TestCreate(t *testing.T) {
 		require.NoError(t, err)
 	})
 
+	t.Run("missing flags without prompts", func(t *testing.T) {
+		f, _, _ := testutils.NewFactory(&httpmock.Registry{})
+
+		cmd := NewCmd(f)
+		utils.SetInteractive(false, func() []string { return cmdutil.MissingPrompted(cmd) })
+		t.Cleanup(func() { utils.SetInteractive(true, nil) })
+		cmd.SetArgs([]string{"--active", "true"})
+
+		err := cmd.Execute()
+		require.ErrorContains(t, err, "missing required flag --application-id, --name. ")
+	})
+
 	t.Run("bad request status 400", func(t *testing.T) {
 		mock := &httpmock.Registry{}
 
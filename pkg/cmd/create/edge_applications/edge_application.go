@@ -97,6 +97,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 
 	flags := cmd.Flags()
 	addFlags(flags, fields)
+	cmdutil.MarkPromptedUnless(cmd, "file", "name")
 
 	return cmd
 }
@@ -106,7 +107,7 @@ func createRequestFromFlags(fields *Fields, request *api.CreateRequest) error {
 		answers, err := utils.AskInput("Enter the new Edge Application's name")
 		if err != nil {
 			logger.Debug("Error while parsing answer", zap.Error(err))
-			return utils.ErrorParseResponse
+			return err
 		}
 
 		fields.Name = answers
@@ -0,0 +1,110 @@
+package edgefirewall
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc"
+	"go.uber.org/zap"
+
+	msg "github.com/aziontech/azion-cli/messages/edge_firewall"
+	api "github.com/aziontech/azion-cli/pkg/api/edge_firewall"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/utils"
+	"github.com/spf13/cobra"
+)
+
+type Fields struct {
+	Name                     string
+	Active                   string
+	EdgeFunctionsEnabled     string
+	NetworkProtectionEnabled string
+	WafEnabled               string
+	Domains                  []int64
+	Path                     string
+}
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	fields := &Fields{}
+
+	cmd := &cobra.Command{
+		Use:           msg.Usage,
+		Short:         msg.CreateShortDescription,
+		Long:          msg.CreateLongDescription,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Example: heredoc.Doc(`
+		$ azion create edge-firewall --name "shop" --waf-enabled true --network-protection-enabled true
+		$ azion create edge-firewall --name "shop" --domains 1678392838,1678392839
+		$ azion create edge-firewall --file "create.json"
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			request := api.CreateRequest{}
+
+			if cmd.Flags().Changed("file") {
+				err := utils.FlagFileUnmarshalJSON(fields.Path, &request)
+				if err != nil {
+					logger.Debug("Error while parsing <"+fields.Path+"> file", zap.Error(err))
+					return utils.ErrorUnmarshalReader
+				}
+			} else {
+				if !cmd.Flags().Changed("name") {
+					answer, err := utils.AskInput(msg.AskName)
+					if err != nil {
+						return err
+					}
+					fields.Name = answer
+				}
+				request.SetName(fields.Name)
+
+				bools := []struct {
+					flag  string
+					value string
+					set   func(bool)
+				}{
+					{"active", fields.Active, request.SetIsActive},
+					{"edge-functions-enabled", fields.EdgeFunctionsEnabled, request.SetEdgeFunctionsEnabled},
+					{"network-protection-enabled", fields.NetworkProtectionEnabled, request.SetNetworkProtectionEnabled},
+					{"waf-enabled", fields.WafEnabled, request.SetWafEnabled},
+				}
+				for _, b := range bools {
+					if !cmd.Flags().Changed(b.flag) {
+						continue
+					}
+					value, err := strconv.ParseBool(b.value)
+					if err != nil {
+						return fmt.Errorf(msg.ErrorBoolFlag.Error(), b.flag)
+					}
+					b.set(value)
+				}
+
+				if cmd.Flags().Changed("domains") {
+					request.SetDomains(fields.Domains)
+				}
+			}
+
+			client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
+			response, err := client.Create(context.Background(), &request)
+			if err != nil {
+				return fmt.Errorf(msg.ErrorCreate.Error(), err)
+			}
+
+			logger.LogSuccess(f.IOStreams.Out, fmt.Sprintf(msg.CreateOutputSuccess, response.GetId()))
+			return nil
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&fields.Name, "name", "", msg.FlagName)
+	flags.StringVar(&fields.Active, "active", "", msg.FlagActive)
+	flags.StringVar(&fields.EdgeFunctionsEnabled, "edge-functions-enabled", "", msg.FlagEdgeFunctionsEnabled)
+	flags.StringVar(&fields.NetworkProtectionEnabled, "network-protection-enabled", "", msg.FlagNetworkProtectionEnabled)
+	flags.StringVar(&fields.WafEnabled, "waf-enabled", "", msg.FlagWafEnabled)
+	flags.Int64SliceVar(&fields.Domains, "domains", []int64{}, msg.FlagDomains)
+	flags.StringVar(&fields.Path, "file", "", msg.CreateFlagFile)
+	flags.BoolP("help", "h", false, msg.CreateHelpFlag)
+	cmdutil.MarkPromptedUnless(cmd, "file", "name")
+	return cmd
+}
@@ -0,0 +1,67 @@
+package edgefirewall
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/aziontech/azion-cli/pkg/httpmock"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestCreate(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+	t.Run("create with flags", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("POST", "edge_firewall"),
+			func(req *http.Request) (*http.Response, error) {
+				payload := map[string]interface{}{}
+				require.NoError(t, json.NewDecoder(req.Body).Decode(&payload))
+				assert.Equal(t, "shop", payload["name"])
+				assert.Equal(t, true, payload["waf_enabled"])
+				assert.Equal(t, []interface{}{float64(1678392838)}, payload["domains"])
+				assert.NotContains(t, payload, "edge_functions_enabled")
+				return httpmock.JSONFromFile("./fixtures/response.json")(req)
+			},
+		)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--name", "shop", "--waf-enabled", "true", "--domains", "1678392838"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		assert.Equal(t, "🚀 Created Edge Firewall with ID 1337\n\n", stdout.String())
+	})
+
+	t.Run("invalid boolean flag", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+
+		f, _, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--name", "shop", "--waf-enabled", "maybe"})
+
+		_, err := cmd.ExecuteC()
+		require.ErrorContains(t, err, "Invalid --waf-enabled flag")
+	})
+
+	t.Run("create fails", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("POST", "edge_firewall"),
+			httpmock.StatusStringResponse(400, `{"name": ["This field is required."]}`),
+		)
+
+		f, _, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--name", "shop"})
+
+		_, err := cmd.ExecuteC()
+		require.Error(t, err)
+	})
+}
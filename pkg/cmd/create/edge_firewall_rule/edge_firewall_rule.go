@@ -0,0 +1,106 @@
+package edgefirewallrule
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc"
+	"go.uber.org/zap"
+
+	msg "github.com/aziontech/azion-cli/messages/edge_firewall_rule"
+	api "github.com/aziontech/azion-cli/pkg/api/edge_firewall"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/utils"
+	"github.com/spf13/cobra"
+)
+
+type Fields struct {
+	EdgeFirewallID int64
+	Path           string
+}
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	fields := &Fields{}
+
+	cmd := &cobra.Command{
+		Use:           msg.Usage,
+		Short:         msg.CreateShortDescription,
+		Long:          msg.CreateLongDescription,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Example: heredoc.Doc(`
+		$ azion create edge-firewall-rule --edge-firewall-id 1337 --file "./rule.json"
+		$ cat rule.json | azion create edge-firewall-rule --edge-firewall-id 1337 --file -
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !cmd.Flags().Changed("edge-firewall-id") {
+				answer, err := utils.AskInput(msg.AskEdgeFirewallID)
+				if err != nil {
+					return err
+				}
+
+				id, err := strconv.ParseInt(answer, 10, 64)
+				if err != nil {
+					logger.Debug("Error while converting answer to int64", zap.Error(err))
+					return msg.ErrorConvertEdgeFirewallID
+				}
+				fields.EdgeFirewallID = id
+			}
+
+			if !cmd.Flags().Changed("file") {
+				answer, err := utils.AskInput(msg.AskFile)
+				if err != nil {
+					return err
+				}
+				fields.Path = answer
+			}
+
+			request := api.RuleSetRequest{}
+			err := utils.FlagFileUnmarshalJSON(fields.Path, &request)
+			if err != nil {
+				logger.Debug("Error while parsing <"+fields.Path+"> file", zap.Error(err))
+				return utils.ErrorUnmarshalReader
+			}
+
+			if err := validateRequest(&request); err != nil {
+				return err
+			}
+
+			client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
+			response, err := client.CreateRule(context.Background(), fields.EdgeFirewallID, &request)
+			if err != nil {
+				return fmt.Errorf(msg.ErrorCreate.Error(), err)
+			}
+
+			logger.LogSuccess(f.IOStreams.Out, fmt.Sprintf(msg.CreateOutputSuccess, response.Id))
+			return nil
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.Int64Var(&fields.EdgeFirewallID, "edge-firewall-id", 0, msg.FlagEdgeFirewallID)
+	flags.StringVar(&fields.Path, "file", "", msg.CreateFlagFile)
+	flags.BoolP("help", "h", false, msg.CreateHelpFlag)
+	cmdutil.MarkPrompted(cmd, "edge-firewall-id", "file")
+	return cmd
+}
+
+func validateRequest(request *api.RuleSetRequest) error {
+	if request.Name == nil || *request.Name == "" {
+		return msg.ErrorNameEmpty
+	}
+	if isEmpty(request.Criteria) {
+		return msg.ErrorCriteriaEmpty
+	}
+	if isEmpty(request.Behaviors) {
+		return msg.ErrorBehaviorsEmpty
+	}
+	return nil
+}
+
+func isEmpty(raw []byte) bool {
+	value := string(raw)
+	return value == "" || value == "null" || value == "[]"
+}
@@ -0,0 +1,54 @@
+package edgefirewallrule
+
+import (
+	"testing"
+
+	msg "github.com/aziontech/azion-cli/messages/edge_firewall_rule"
+	"github.com/aziontech/azion-cli/pkg/httpmock"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestCreate(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+	t.Run("create rule from file", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("POST", "edge_firewall/1337/rules_engine"),
+			httpmock.JSONFromFile("./fixtures/response.json"),
+		)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--edge-firewall-id", "1337", "--file", "./fixtures/rule.json"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		assert.Equal(t, "🚀 Created Edge Firewall rule with ID 42\n\n", stdout.String())
+	})
+
+	t.Run("rule without behaviors", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+
+		f, _, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--edge-firewall-id", "1337", "--file", "./fixtures/no_behaviors.json"})
+
+		_, err := cmd.ExecuteC()
+		require.Equal(t, msg.ErrorBehaviorsEmpty, err)
+	})
+
+	t.Run("file that doesn't exist", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+
+		f, _, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--edge-firewall-id", "1337", "--file", "./fixtures/missing.json"})
+
+		_, err := cmd.ExecuteC()
+		require.Error(t, err)
+	})
+}
@@ -72,6 +72,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 
 	flags := cmd.Flags()
 	addFlags(flags, fields)
+	cmdutil.MarkPromptedUnless(cmd, "file", "name", "code", "active")
 
 	return cmd
 }
@@ -92,7 +93,7 @@ func createRequestFromFlags(cmd *cobra.Command, fields *Fields, request *api.Cre
 
 		if err != nil {
 			logger.Debug("Error while parsing answer", zap.Error(err))
-			return utils.ErrorParseResponse
+			return err
 		}
 
 		fields.Name = answers
@@ -103,7 +104,7 @@ func createRequestFromFlags(cmd *cobra.Command, fields *Fields, request *api.Cre
 
 		if err != nil {
 			logger.Debug("Error while parsing answer", zap.Error(err))
-			return utils.ErrorParseResponse
+			return err
 		}
 
 		fields.Code = answers
@@ -113,7 +114,7 @@ func createRequestFromFlags(cmd *cobra.Command, fields *Fields, request *api.Cre
 		answers, err := utils.Select(msg.AskActive, []string{"true", "false"})
 		if err != nil {
 			logger.Debug("Error while parsing answer", zap.Error(err))
-			return utils.ErrorParseResponse
+			return err
 		}
 
 		fields.Active = answers
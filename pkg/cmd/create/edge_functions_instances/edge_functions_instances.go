@@ -1,4 +1,4 @@
-package create
+package edgefunctionsinstances
 
 import (
 	"context"
@@ -31,10 +31,10 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		SilenceUsage:  true,
 		SilenceErrors: true,
 		Example: heredoc.Doc(`
- 		$ azion edge_functions_instances create --application-id 1673635839 --function-id 12314 --name "ffcafe222sdsdffdf"
-		$ azion edge_functions_instances create -a 1673635839 -f 12314 --name "ffcafe222sdsdffdf"
-        $ azion edge_functions_instances create -a 1673635839 --in "create.json"
-        `),
+		$ azion create edge-function-instance --application-id 1673635839 --function-id 12314 --name "ffcafe222sdsdffdf"
+		$ azion create edge-function-instance -a 1673635839 -f 12314 --name "ffcafe222sdsdffdf"
+		$ azion create edge-function-instance -a 1673635839 --in "create.json"
+		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			request := api.CreateFuncInstancesRequest{}
 			if cmd.Flags().Changed("in") {
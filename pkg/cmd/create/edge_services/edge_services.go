@@ -1,4 +1,4 @@
-package create
+package edgeservices
 
 import (
 	"context"
@@ -8,7 +8,7 @@ import (
 
 	"github.com/MakeNowJust/heredoc"
 	msg "github.com/aziontech/azion-cli/messages/edge_services"
-	"github.com/aziontech/azion-cli/pkg/cmd/edge_services/requests"
+	api "github.com/aziontech/azion-cli/pkg/api/edge_services"
 	"github.com/aziontech/azion-cli/pkg/cmdutil"
 	"github.com/aziontech/azion-cli/utils"
 	sdk "github.com/aziontech/azionapi-go-sdk/edgeservices"
@@ -30,9 +30,9 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		SilenceUsage:  true,
 		SilenceErrors: true,
 		Example: heredoc.Doc(`
-		$ azion edge_services create --name "Hello"
-		$ azion edge_services create --in "<path>/create.json"
-        `),
+		$ azion create edge-service --name "Hello"
+		$ azion create edge-service --in "<path>/create.json"
+		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
 
 			serviceRequest := sdk.CreateServiceRequest{}
@@ -67,10 +67,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 
 			}
 
-			client, err := requests.CreateClient(f)
-			if err != nil {
-				return err
-			}
+			client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
 
 			if err := createNewService(client, f.IOStreams.Out, serviceRequest); err != nil {
 				return err
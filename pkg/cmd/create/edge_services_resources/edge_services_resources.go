@@ -1,4 +1,4 @@
-package create
+package edgeservicesresources
 
 import (
 	"context"
@@ -9,7 +9,7 @@ import (
 
 	"github.com/MakeNowJust/heredoc"
 	msg "github.com/aziontech/azion-cli/messages/edge_services"
-	"github.com/aziontech/azion-cli/pkg/cmd/edge_services/requests"
+	api "github.com/aziontech/azion-cli/pkg/api/edge_services"
 	"github.com/aziontech/azion-cli/pkg/cmdutil"
 	"github.com/aziontech/azion-cli/utils"
 	sdk "github.com/aziontech/azionapi-go-sdk/edgeservices"
@@ -38,8 +38,8 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		SilenceUsage:  true,
 		SilenceErrors: true,
 		Example: heredoc.Doc(`
-		$ azion edge_services resources create --service-id 1234 --name "/tmp/test.txt" --content-type text --content-file "./text.txt"
-		$ azion edge_services resources create --service-id 1234 --name "/tmp/my_script.sh" --content-type shellscript --content-file "./text.txt"
+		$ azion create edge-service-resource --service-id 1234 --name "/tmp/test.txt" --content-type text --content-file "./text.txt"
+		$ azion create edge-service-resource --service-id 1234 --name "/tmp/my_script.sh" --content-type shellscript --content-file "./text.txt"
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if !cmd.Flags().Changed("service-id") {
@@ -115,10 +115,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 				request.SetContent(stringFile)
 			}
 
-			client, err := requests.CreateClient(f)
-			if err != nil {
-				return err
-			}
+			client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
 
 			if err := createNewResource(client, f.IOStreams.Out, fields.ServiceId, request); err != nil {
 				return err
@@ -128,7 +125,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		},
 	}
 
-	createCmd.Flags().Int64VarP(&fields.ServiceId, "service-id", "s", 0, msg.EdgeServiceFlagId)
+	createCmd.Flags().Int64Var(&fields.ServiceId, "service-id", 0, msg.EdgeServiceFlagId)
 	createCmd.Flags().StringVar(&fields.Name, "name", "", msg.EdgeServiceResourceCreateFlagName)
 	createCmd.Flags().StringVar(&fields.Trigger, "trigger", "", msg.EdgeServiceResourceCreateFlagTrigger)
 	createCmd.Flags().StringVar(&fields.ContentType, "content-type", "", msg.EdgeServiceResourceCreateFlagContentType)
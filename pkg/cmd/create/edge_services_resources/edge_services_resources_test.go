@@ -1,4 +1,4 @@
-package create
+package edgeservicesresources
 
 import (
 	"bytes"
@@ -117,7 +117,7 @@ func TestCreate(t *testing.T) {
 
 		cmd := NewCmd(f)
 
-		cmd.SetArgs([]string{"-s", "1234", "--name", "/tmp/bomb.sh", "--content-type", "shellscript", "--content-file", contentFile.Name()})
+		cmd.SetArgs([]string{"--service-id", "1234", "--name", "/tmp/bomb.sh", "--content-type", "shellscript", "--content-file", contentFile.Name()})
 		cmd.SetIn(&bytes.Buffer{})
 		cmd.SetOut(io.Discard)
 		cmd.SetErr(io.Discard)
@@ -132,7 +132,7 @@ func TestCreate(t *testing.T) {
 
 		cmd := NewCmd(f)
 
-		cmd.SetArgs([]string{"-s", "1234", "--name", "/tmp/a.txt", "--content-type", "text"})
+		cmd.SetArgs([]string{"--service-id", "1234", "--name", "/tmp/a.txt", "--content-type", "text"})
 		cmd.SetIn(&bytes.Buffer{})
 		cmd.SetOut(io.Discard)
 		cmd.SetErr(io.Discard)
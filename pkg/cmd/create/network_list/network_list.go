@@ -0,0 +1,122 @@
+package networklist
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"go.uber.org/zap"
+
+	msg "github.com/aziontech/azion-cli/messages/network_list"
+	api "github.com/aziontech/azion-cli/pkg/api/network_list"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/networklist"
+	"github.com/aziontech/azion-cli/utils"
+	"github.com/spf13/cobra"
+)
+
+type Fields struct {
+	Name        string
+	ListType    string
+	Items       []string
+	FromFile    string
+	SkipInvalid bool
+	NoAggregate bool
+	Path        string
+}
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	fields := &Fields{}
+
+	cmd := &cobra.Command{
+		Use:           msg.Usage,
+		Short:         msg.CreateShortDescription,
+		Long:          msg.CreateLongDescription,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Example: heredoc.Doc(`
+		$ azion create network-list --name "blocklist" --from-file "./drop.txt"
+		$ curl -s https://example.com/feed.txt | azion create network-list --name "feed" --from-file - --skip-invalid
+		$ azion create network-list --name "office" --items 192.0.2.0/24,198.51.100.7
+		$ azion create network-list --name "embargo" --list-type countries --items BR,US
+		$ azion create network-list --name "clouds" --list-type asn --items AS16509,15169
+		$ azion create network-list --file "create.json"
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			request := api.Request{}
+			var entries []networklist.Entry
+
+			if cmd.Flags().Changed("file") {
+				err := utils.FlagFileUnmarshalJSON(fields.Path, &request)
+				if err != nil {
+					logger.Debug("Error while parsing <"+fields.Path+"> file", zap.Error(err))
+					return utils.ErrorUnmarshalReader
+				}
+				if request.ListType == nil {
+					request.ListType = &fields.ListType
+				}
+				entries = networklist.FromValues(request.ItemsValues)
+			} else {
+				if cmd.Flags().Changed("items") && cmd.Flags().Changed("from-file") {
+					return msg.ErrorItemsFlags
+				}
+
+				if !cmd.Flags().Changed("name") {
+					answer, err := utils.AskInput(msg.AskName)
+					if err != nil {
+						return err
+					}
+					fields.Name = answer
+				}
+				request.Name = &fields.Name
+				request.ListType = &fields.ListType
+
+				var err error
+				entries, err = readEntries(f, fields)
+				if err != nil {
+					return err
+				}
+			}
+
+			listType := *request.ListType
+			if !networklist.ValidType(listType) {
+				return fmt.Errorf(msg.ErrorListType.Error(), listType)
+			}
+
+			result := networklist.Clean(listType, entries, !fields.NoAggregate)
+			if err := networklist.Report(f.IOStreams.Out, result, fields.SkipInvalid); err != nil {
+				return err
+			}
+			request.ItemsValues = result.Items
+
+			client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
+			response, err := client.Create(context.Background(), &request)
+			if err != nil {
+				return fmt.Errorf(msg.ErrorCreate.Error(), err)
+			}
+
+			logger.LogSuccess(f.IOStreams.Out, fmt.Sprintf(msg.CreateOutputSuccess, response.Id))
+			return nil
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&fields.Name, "name", "", msg.FlagName)
+	flags.StringVar(&fields.ListType, "list-type", networklist.TypeIPCIDR, msg.FlagListType)
+	flags.StringSliceVar(&fields.Items, "items", []string{}, msg.FlagItems)
+	flags.StringVar(&fields.FromFile, "from-file", "", msg.FlagFromFile)
+	flags.BoolVar(&fields.SkipInvalid, "skip-invalid", false, msg.FlagSkipInvalid)
+	flags.BoolVar(&fields.NoAggregate, "no-aggregate", false, msg.FlagNoAggregate)
+	flags.StringVar(&fields.Path, "file", "", msg.CreateFlagFile)
+	flags.BoolP("help", "h", false, msg.CreateHelpFlag)
+	cmdutil.MarkPromptedUnless(cmd, "file", "name")
+	return cmd
+}
+
+func readEntries(f *cmdutil.Factory, fields *Fields) ([]networklist.Entry, error) {
+	if fields.FromFile != "" {
+		return networklist.ReadFile(fields.FromFile, f.IOStreams.In)
+	}
+	return networklist.FromValues(fields.Items), nil
+}
@@ -0,0 +1,113 @@
+package networklist
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/aziontech/azion-cli/pkg/httpmock"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestCreate(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+	response, _ := os.ReadFile("./fixtures/response.json")
+
+	t.Run("import a threat feed", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("POST", "network_lists"),
+			httpmock.RESTPayload(201, string(response), func(payload map[string]interface{}) {
+				assert.Equal(t, "blocklist", payload["name"])
+				assert.Equal(t, "ip_cidr", payload["list_type"])
+				assert.Equal(t, []interface{}{"192.0.2.0/24", "198.51.100.7", "203.0.113.0/24"}, payload["items_values"])
+			}),
+		)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--name", "blocklist", "--from-file", "./fixtures/feed.txt"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		assert.Equal(t,
+			"Read 5 items: 1 duplicated, 1 merged into CIDR blocks, 0 invalid. Uploading 3 items\n"+
+				"🚀 Created Network List with ID 1337\n\n",
+			stdout.String(),
+		)
+	})
+
+	t.Run("import from stdin", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("POST", "network_lists"),
+			httpmock.RESTPayload(201, string(response), func(payload map[string]interface{}) {
+				assert.Equal(t, []interface{}{"16509", "15169"}, payload["items_values"])
+			}),
+		)
+
+		f, _, _ := testutils.NewFactory(mock)
+		f.IOStreams.In = io.NopCloser(strings.NewReader("AS16509\nAS15169\n"))
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--name", "clouds", "--list-type", "asn", "--from-file", "-"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid items fail before the upload", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+
+		f, _, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--name", "blocklist", "--from-file", "./fixtures/invalid.txt"})
+
+		_, err := cmd.ExecuteC()
+		require.ErrorContains(t, err, `1 invalid items, such as "999.0.0.1" on line 2`)
+	})
+
+	t.Run("skip invalid items", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("POST", "network_lists"),
+			httpmock.RESTPayload(201, string(response), func(payload map[string]interface{}) {
+				assert.Equal(t, []interface{}{"192.0.2.0/24", "203.0.113.0/24"}, payload["items_values"])
+			}),
+		)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--name", "blocklist", "--from-file", "./fixtures/invalid.txt", "--skip-invalid"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		assert.Contains(t, stdout.String(), `Skipping invalid item "999.0.0.1" on line 2`)
+	})
+
+	t.Run("invalid list type", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+
+		f, _, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--name", "blocklist", "--list-type", "ips", "--items", "192.0.2.1"})
+
+		_, err := cmd.ExecuteC()
+		require.ErrorContains(t, err, `Invalid --list-type flag provided: "ips"`)
+	})
+
+	t.Run("items and from-file together", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+
+		f, _, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--name", "blocklist", "--items", "192.0.2.1", "--from-file", "./fixtures/feed.txt"})
+
+		_, err := cmd.ExecuteC()
+		require.Error(t, err)
+	})
+}
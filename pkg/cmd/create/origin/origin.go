@@ -80,6 +80,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 
 	flags := cmd.Flags()
 	addFlags(flags, fields)
+	cmdutil.MarkPromptedUnless(cmd, "file", "application-id", "name", "origin-type")
 
 	return cmd
 }
@@ -99,7 +100,7 @@ func createRequestFromFlags(cmd *cobra.Command, fields *Fields, request *api.Cre
 
 		if err != nil {
 			logger.Debug("Error while parsing answer", zap.Error(err))
-			return utils.ErrorParseResponse
+			return err
 		}
 
 		applicationID, err := strconv.Atoi(answers)
@@ -115,7 +116,7 @@ func createRequestFromFlags(cmd *cobra.Command, fields *Fields, request *api.Cre
 		answers, err := utils.AskInput(msg.AskName)
 		if err != nil {
 			logger.Debug("Error while parsing answer", zap.Error(err))
-			return utils.ErrorParseResponse
+			return err
 		}
 
 		fields.Name = answers
@@ -138,7 +139,7 @@ func createRequestFromFlags(cmd *cobra.Command, fields *Fields, request *api.Cre
 			answers, err := utils.AskInput(msg.AskBucket)
 			if err != nil {
 				logger.Debug("Error while parsing answer", zap.Error(err))
-				return utils.ErrorParseResponse
+				return err
 			}
 
 			fields.Bucket = answers
@@ -148,7 +149,7 @@ func createRequestFromFlags(cmd *cobra.Command, fields *Fields, request *api.Cre
 			answers, err := utils.AskInputEmpty(msg.AskPrefix)
 			if err != nil {
 				logger.Debug("Error while parsing answer", zap.Error(err))
-				return utils.ErrorParseResponse
+				return err
 			}
 			fields.Prefix = answers
 
@@ -163,7 +164,7 @@ func createRequestFromFlags(cmd *cobra.Command, fields *Fields, request *api.Cre
 			answers, err := utils.AskInput(msg.AskAddresses)
 			if err != nil {
 				logger.Debug("Error while parsing answer", zap.Error(err))
-				return utils.ErrorParseResponse
+				return err
 			}
 
 			fields.Addresses = []string{answers}
@@ -173,7 +174,7 @@ func createRequestFromFlags(cmd *cobra.Command, fields *Fields, request *api.Cre
 			answers, err := utils.AskInput(msg.AskHostHeader)
 			if err != nil {
 				logger.Debug("Error while parsing answer", zap.Error(err))
-				return utils.ErrorParseResponse
+				return err
 			}
 
 			fields.HostHeader = answers
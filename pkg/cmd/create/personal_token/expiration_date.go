@@ -29,7 +29,7 @@ func ParseExpirationDate(currentDate time.Time, expirationString string) (time.T
 	lastChar := expirationString[len(expirationString) - 1]
 	if interval, ok := suffixMapping[lastChar]; ok {
 		intervalValue := 0
-		fmt.Sscanf(string(expirationString[0]), "%d", &intervalValue)
+		fmt.Sscanf(expirationString[:len(expirationString)-1], "%d", &intervalValue)
 		expirationDate := currentDate.Add(time.Duration(intervalValue) * interval)
 		return time.Parse(constants.FORMAT_DATE, expirationDate.Format(constants.FORMAT_DATE))	
 	}
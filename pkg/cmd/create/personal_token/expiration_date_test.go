@@ -27,6 +27,10 @@ func TestParseExpirationDate(t *testing.T) {
 			name: "1 week", args: args{time.Date(2024, 03, 06, 0, 0, 0, 0, &time.Location{}), "1w"},
 			want: time.Date(2024, 03, 13, 0, 0, 0, 0, &time.Location{}),
 		},
+		{
+			name: "30 days", args: args{currentDate, "30d"},
+			want: time.Date(2008, 12, 01, 0, 0, 0, 0, &time.Location{}),
+		},
 		{
 			name: "2 week", args: args{currentDate, "2w"},
 			want: time.Date(2008, 11, 15, 0, 0, 0, 0, &time.Location{}),
@@ -115,6 +115,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 	flags.StringVar(&fields.Description, "description", "", msg.CreateFlagDescription)
 	flags.StringVar(&fields.Path, "file", "", msg.CreateFlagFile)
 	flags.BoolP("help", "h", false, msg.CreateHelpFlag)
+	cmdutil.MarkPromptedUnless(cmd, "file", "name", "expiration")
 
 	return cmd
 }
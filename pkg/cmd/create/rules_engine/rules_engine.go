@@ -101,6 +101,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 	flags.StringVar(&fields.Phase, "phase", "", msg.FlagPhase)
 	flags.StringVar(&fields.Path, "file", "", msg.FlagFile)
 	flags.BoolP("help", "h", false, msg.HelpFlag)
+	cmdutil.MarkPrompted(cmd, "application-id", "phase", "file")
 	return cmd
 }
 
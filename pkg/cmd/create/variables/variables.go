@@ -70,6 +70,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 	flags.StringVar(&fields.Secret, "secret", "", msg.CreateFlagSecret)
 	flags.StringVar(&fields.FileJSON, "file", "", msg.CreateFlagFileJSON)
 	flags.BoolP("help", "h", false, msg.CreateHelpFlag)
+	cmdutil.MarkPromptedUnless(cmd, "file", "key", "value")
 	return cmd
 }
 
@@ -79,7 +80,7 @@ func createRequestFromFlags(cmd *cobra.Command, fields *Fields, request *api.Req
 
 		if err != nil {
 			logger.Debug("Error while parsing answer", zap.Error(err))
-			return utils.ErrorParseResponse
+			return err
 		}
 
 		fields.Key = answers
@@ -90,7 +91,7 @@ func createRequestFromFlags(cmd *cobra.Command, fields *Fields, request *api.Req
 
 		if err != nil {
 			logger.Debug("Error while parsing answer", zap.Error(err))
-			return utils.ErrorParseResponse
+			return err
 		}
 
 		fields.Value = answers
@@ -0,0 +1,135 @@
+package wafallowedrule
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc"
+	"go.uber.org/zap"
+
+	msg "github.com/aziontech/azion-cli/messages/waf_allowed_rule"
+	api "github.com/aziontech/azion-cli/pkg/api/waf"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/utils"
+	"github.com/spf13/cobra"
+)
+
+type Fields struct {
+	WafRuleSetID int64
+	RuleID       int64
+	Name         string
+	Reason       string
+	URLPath      string
+	UseRegex     string
+	Active       string
+	Path         string
+}
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	fields := &Fields{}
+
+	cmd := &cobra.Command{
+		Use:           msg.Usage,
+		Aliases:       []string{"waf-exception"},
+		Short:         msg.CreateShortDescription,
+		Long:          msg.CreateLongDescription,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Example: heredoc.Doc(`
+		$ azion create waf-allowed-rule --waf-rule-set-id 1337 --rule-id 1010 --name "search" --path "/search" --reason "free text queries"
+		$ azion create waf-allowed-rule --waf-rule-set-id 1337 --rule-id 1010 --name "api" --path "^/api/" --use-regex true
+		$ azion create waf-exception --waf-rule-set-id 1337 --file "allowed_rule.json"
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !cmd.Flags().Changed("waf-rule-set-id") {
+				answer, err := utils.AskInput(msg.AskWafRuleSetID)
+				if err != nil {
+					return err
+				}
+
+				id, err := strconv.ParseInt(answer, 10, 64)
+				if err != nil {
+					logger.Debug("Error while converting answer to int64", zap.Error(err))
+					return msg.ErrorConvertWafRuleSetID
+				}
+				fields.WafRuleSetID = id
+			}
+
+			request := api.AllowedRuleRequest{}
+			if cmd.Flags().Changed("file") {
+				err := utils.FlagFileUnmarshalJSON(fields.Path, &request)
+				if err != nil {
+					logger.Debug("Error while parsing <"+fields.Path+"> file", zap.Error(err))
+					return utils.ErrorUnmarshalReader
+				}
+			} else {
+				if !cmd.Flags().Changed("rule-id") || !cmd.Flags().Changed("name") {
+					return msg.ErrorMissingCreateFlags
+				}
+
+				if err := requestFromFlags(cmd, fields, &request); err != nil {
+					return err
+				}
+			}
+
+			client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
+			response, err := client.CreateAllowedRule(context.Background(), fields.WafRuleSetID, &request)
+			if err != nil {
+				return fmt.Errorf(msg.ErrorCreate.Error(), err)
+			}
+
+			logger.LogSuccess(f.IOStreams.Out, fmt.Sprintf(msg.CreateOutputSuccess, response.Id))
+			return nil
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.Int64Var(&fields.WafRuleSetID, "waf-rule-set-id", 0, msg.FlagWafRuleSetID)
+	flags.Int64Var(&fields.RuleID, "rule-id", 0, msg.FlagRuleID)
+	flags.StringVar(&fields.Name, "name", "", msg.FlagName)
+	flags.StringVar(&fields.Reason, "reason", "", msg.FlagReason)
+	flags.StringVar(&fields.URLPath, "path", "", msg.FlagPath)
+	flags.StringVar(&fields.UseRegex, "use-regex", "", msg.FlagUseRegex)
+	flags.StringVar(&fields.Active, "active", "", msg.FlagActive)
+	flags.StringVar(&fields.Path, "file", "", msg.CreateFlagFile)
+	flags.BoolP("help", "h", false, msg.CreateHelpFlag)
+	cmdutil.MarkPrompted(cmd, "waf-rule-set-id")
+	return cmd
+}
+
+func requestFromFlags(cmd *cobra.Command, fields *Fields, request *api.AllowedRuleRequest) error {
+	if cmd.Flags().Changed("rule-id") {
+		request.RuleId = &fields.RuleID
+	}
+	if cmd.Flags().Changed("name") {
+		request.Name = &fields.Name
+	}
+	if cmd.Flags().Changed("reason") {
+		request.Reason = &fields.Reason
+	}
+	if cmd.Flags().Changed("path") {
+		request.Path = &fields.URLPath
+	}
+
+	bools := []struct {
+		flag  string
+		value string
+		field **bool
+	}{
+		{"use-regex", fields.UseRegex, &request.UseRegex},
+		{"active", fields.Active, &request.IsActive},
+	}
+	for _, b := range bools {
+		if !cmd.Flags().Changed(b.flag) {
+			continue
+		}
+		value, err := strconv.ParseBool(b.value)
+		if err != nil {
+			return fmt.Errorf(msg.ErrorBoolFlag.Error(), b.flag)
+		}
+		*b.field = &value
+	}
+	return nil
+}
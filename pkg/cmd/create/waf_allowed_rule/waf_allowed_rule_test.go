@@ -0,0 +1,64 @@
+package wafallowedrule
+
+import (
+	"os"
+	"testing"
+
+	msg "github.com/aziontech/azion-cli/messages/waf_allowed_rule"
+	"github.com/aziontech/azion-cli/pkg/httpmock"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestCreate(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+	response, _ := os.ReadFile("./fixtures/response.json")
+
+	t.Run("create with flags", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("POST", "waf/rulesets/1337/allowed_rules"),
+			httpmock.RESTPayload(201, string(response), func(payload map[string]interface{}) {
+				assert.Equal(t, map[string]interface{}{
+					"rule_id":   float64(1010),
+					"name":      "allow search query",
+					"path":      "/search",
+					"is_active": true,
+				}, payload)
+			}),
+		)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--waf-rule-set-id", "1337", "--rule-id", "1010", "--name", "allow search query", "--path", "/search", "--active", "true"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		assert.Equal(t, "🚀 Created WAF allowed rule with ID 7\n\n", stdout.String())
+	})
+
+	t.Run("missing rule id", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+
+		f, _, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--waf-rule-set-id", "1337", "--name", "allow search query"})
+
+		_, err := cmd.ExecuteC()
+		require.Equal(t, msg.ErrorMissingCreateFlags, err)
+	})
+
+	t.Run("invalid boolean flag", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+
+		f, _, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--waf-rule-set-id", "1337", "--rule-id", "1010", "--name", "allow", "--use-regex", "sometimes"})
+
+		_, err := cmd.ExecuteC()
+		require.ErrorContains(t, err, "Invalid --use-regex flag")
+	})
+}
@@ -0,0 +1,112 @@
+package wafruleset
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"go.uber.org/zap"
+
+	msg "github.com/aziontech/azion-cli/messages/waf_rule_set"
+	api "github.com/aziontech/azion-cli/pkg/api/waf"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/utils"
+	sdk "github.com/aziontech/azionapi-go-sdk/waf"
+	"github.com/spf13/cobra"
+)
+
+type Fields struct {
+	Name            string
+	Mode            string
+	Active          string
+	Threats         map[string]string
+	BypassAddresses []string
+	Path            string
+}
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	fields := &Fields{}
+
+	cmd := &cobra.Command{
+		Use:           msg.Usage,
+		Short:         msg.CreateShortDescription,
+		Long:          msg.CreateLongDescription,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Example: heredoc.Doc(`
+		$ azion create waf-rule-set --name "shop" --mode blocking
+		$ azion create waf-rule-set --name "shop" --threat sql_injection=high --threat file_upload=off
+		$ azion create waf-rule-set --name "shop" --bypass-addresses 192.0.2.10,198.51.100.0/24
+		$ azion create waf-rule-set --file "create.json"
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			request := api.CreateRequest{}
+
+			if cmd.Flags().Changed("file") {
+				err := utils.FlagFileUnmarshalJSON(fields.Path, &request)
+				if err != nil {
+					logger.Debug("Error while parsing <"+fields.Path+"> file", zap.Error(err))
+					return utils.ErrorUnmarshalReader
+				}
+			} else {
+				if !cmd.Flags().Changed("name") {
+					answer, err := utils.AskInput(msg.AskName)
+					if err != nil {
+						return err
+					}
+					fields.Name = answer
+				}
+
+				active, err := strconv.ParseBool(fields.Active)
+				if err != nil {
+					return msg.ErrorActiveFlag
+				}
+
+				if err := api.ValidateThreats(fields.Threats); err != nil {
+					return err
+				}
+
+				request.SetName(fields.Name)
+				request.SetMode(fields.Mode)
+				request.SetActive(active)
+				request.SetBypassAddresses(fields.BypassAddresses)
+				request.Threats = defaultThreats()
+				for family, sensitivity := range fields.Threats {
+					request.Threats[family] = sensitivity
+				}
+			}
+
+			client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
+			response, err := client.Create(context.Background(), &request)
+			if err != nil {
+				return fmt.Errorf(msg.ErrorCreate.Error(), err)
+			}
+
+			logger.LogSuccess(f.IOStreams.Out, fmt.Sprintf(msg.CreateOutputSuccess, response.GetId()))
+			return nil
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&fields.Name, "name", "", msg.FlagName)
+	flags.StringVar(&fields.Mode, "mode", "counting", msg.FlagMode)
+	flags.StringVar(&fields.Active, "active", "true", msg.FlagActive)
+	flags.StringToStringVar(&fields.Threats, "threat", map[string]string{}, fmt.Sprintf(msg.FlagThreat, strings.Join(api.ThreatFamilies, ", ")))
+	flags.StringSliceVar(&fields.BypassAddresses, "bypass-addresses", []string{}, msg.FlagBypassAddresses)
+	flags.StringVar(&fields.Path, "file", "", msg.CreateFlagFile)
+	flags.BoolP("help", "h", false, msg.CreateHelpFlag)
+	cmdutil.MarkPromptedUnless(cmd, "file", "name")
+	return cmd
+}
+
+// defaultThreats checks every threat family with medium sensitivity
+func defaultThreats() map[string]string {
+	threats := make(map[string]string, len(api.ThreatFamilies))
+	for _, family := range api.ThreatFamilies {
+		threats[family] = string(sdk.MEDIUM)
+	}
+	return threats
+}
@@ -0,0 +1,66 @@
+package wafruleset
+
+import (
+	"os"
+	"testing"
+
+	"github.com/aziontech/azion-cli/pkg/httpmock"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestCreate(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+	response, _ := os.ReadFile("./fixtures/response.json")
+
+	t.Run("create with threat settings", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("POST", "waf/rulesets"),
+			httpmock.WithHeader(httpmock.RESTPayload(201, string(response), func(payload map[string]interface{}) {
+				assert.Equal(t, "shop", payload["name"])
+				assert.Equal(t, "blocking", payload["mode"])
+				assert.Equal(t, true, payload["active"])
+				assert.Equal(t, true, payload["sql_injection"])
+				assert.Equal(t, "high", payload["sql_injection_sensitivity"])
+				assert.Equal(t, false, payload["file_upload"])
+				assert.Equal(t, "medium", payload["file_upload_sensitivity"])
+				assert.Equal(t, true, payload["evading_tricks"])
+				assert.Equal(t, "medium", payload["evading_tricks_sensitivity"])
+			}), "Content-Type", "application/json"),
+		)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--name", "shop", "--mode", "blocking", "--threat", "sql_injection=high", "--threat", "file_upload=off"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		assert.Equal(t, "🚀 Created WAF Rule Set with ID 1337\n\n", stdout.String())
+	})
+
+	t.Run("unknown threat family", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+
+		f, _, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--name", "shop", "--threat", "sql=high"})
+
+		_, err := cmd.ExecuteC()
+		require.ErrorContains(t, err, `"sql" is not a threat family`)
+	})
+
+	t.Run("unknown sensitivity", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+
+		f, _, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--name", "shop", "--threat", "sql_injection=extreme"})
+
+		_, err := cmd.ExecuteC()
+		require.ErrorContains(t, err, `"extreme" is not a sensitivity`)
+	})
+}
@@ -79,5 +79,6 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 	cmd.Flags().Int64Var(&applicationID, "application-id", 0, msg.DeleteFlagApplicationID)
 	cmd.Flags().Int64Var(&cacheSettingsID, "cache-settings-id", 0, msg.DeleteFlagCacheSettingsID)
 	cmd.Flags().BoolP("help", "h", false, msg.DeleteHelpFlag)
+	cmdutil.MarkPrompted(cmd, "application-id", "cache-settings-id")
 	return cmd
 }
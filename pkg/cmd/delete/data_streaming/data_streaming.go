@@ -0,0 +1,62 @@
+package datastreaming
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc"
+	"go.uber.org/zap"
+
+	msg "github.com/aziontech/azion-cli/messages/data_streaming"
+	api "github.com/aziontech/azion-cli/pkg/api/data_streaming"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/utils"
+	"github.com/spf13/cobra"
+)
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	var dataStreamingID int64
+
+	cmd := &cobra.Command{
+		Use:           msg.Usage,
+		Short:         msg.DeleteShortDescription,
+		Long:          msg.DeleteLongDescription,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Example: heredoc.Doc(`
+		$ azion delete data-streaming --data-streaming-id 1337
+		$ azion delete data-streaming
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !cmd.Flags().Changed("data-streaming-id") {
+				answer, err := utils.AskInput(msg.AskDataStreamingID)
+				if err != nil {
+					return err
+				}
+
+				id, err := strconv.ParseInt(answer, 10, 64)
+				if err != nil {
+					logger.Debug("Error while converting answer to int64", zap.Error(err))
+					return msg.ErrorConvertID
+				}
+				dataStreamingID = id
+			}
+
+			client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
+			err := client.Delete(context.Background(), dataStreamingID)
+			if err != nil {
+				return fmt.Errorf(msg.ErrorDelete.Error(), err)
+			}
+
+			logger.LogSuccess(f.IOStreams.Out, fmt.Sprintf(msg.DeleteOutputSuccess, dataStreamingID))
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64Var(&dataStreamingID, "data-streaming-id", 0, msg.FlagDataStreamingID)
+	cmd.Flags().BoolP("help", "h", false, msg.DeleteHelpFlag)
+	cmdutil.MarkPrompted(cmd, "data-streaming-id")
+	return cmd
+}
@@ -4,13 +4,26 @@ import (
 	"github.com/MakeNowJust/heredoc"
 	msg "github.com/aziontech/azion-cli/messages/delete"
 	cache "github.com/aziontech/azion-cli/pkg/cmd/delete/cache_setting"
+	dataStreaming "github.com/aziontech/azion-cli/pkg/cmd/delete/data_streaming"
+	deviceGroups "github.com/aziontech/azion-cli/pkg/cmd/delete/device_groups"
+	digitalCertificate "github.com/aziontech/azion-cli/pkg/cmd/delete/digital_certificate"
+	dnsRecord "github.com/aziontech/azion-cli/pkg/cmd/delete/dns_record"
+	dnsZone "github.com/aziontech/azion-cli/pkg/cmd/delete/dns_zone"
 	domain "github.com/aziontech/azion-cli/pkg/cmd/delete/domain"
 	edgeApplication "github.com/aziontech/azion-cli/pkg/cmd/delete/edge_application"
+	edgeFirewall "github.com/aziontech/azion-cli/pkg/cmd/delete/edge_firewall"
+	edgeFirewallRule "github.com/aziontech/azion-cli/pkg/cmd/delete/edge_firewall_rule"
 	function "github.com/aziontech/azion-cli/pkg/cmd/delete/edge_function"
+	edgeFunctionsInstances "github.com/aziontech/azion-cli/pkg/cmd/delete/edge_functions_instances"
+	edgeServices "github.com/aziontech/azion-cli/pkg/cmd/delete/edge_services"
+	edgeServicesResources "github.com/aziontech/azion-cli/pkg/cmd/delete/edge_services_resources"
+	networkList "github.com/aziontech/azion-cli/pkg/cmd/delete/network_list"
 	origin "github.com/aziontech/azion-cli/pkg/cmd/delete/origin"
 	token "github.com/aziontech/azion-cli/pkg/cmd/delete/personal_token"
 	rulesEngine "github.com/aziontech/azion-cli/pkg/cmd/delete/rules_engine"
 	"github.com/aziontech/azion-cli/pkg/cmd/delete/variables"
+	wafAllowedRule "github.com/aziontech/azion-cli/pkg/cmd/delete/waf_allowed_rule"
+	wafRuleSet "github.com/aziontech/azion-cli/pkg/cmd/delete/waf_rule_set"
 	"github.com/aziontech/azion-cli/pkg/cmdutil"
 	"github.com/spf13/cobra"
 )
@@ -35,6 +48,19 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 	cmd.AddCommand(function.NewCmd(f))
 	cmd.AddCommand(cache.NewCmd(f))
 	cmd.AddCommand(variables.NewCmd(f))
+	cmd.AddCommand(deviceGroups.NewCmd(f))
+	cmd.AddCommand(edgeFunctionsInstances.NewCmd(f))
+	cmd.AddCommand(edgeServices.NewCmd(f))
+	cmd.AddCommand(edgeServicesResources.NewCmd(f))
+	cmd.AddCommand(digitalCertificate.NewCmd(f))
+	cmd.AddCommand(edgeFirewall.NewCmd(f))
+	cmd.AddCommand(edgeFirewallRule.NewCmd(f))
+	cmd.AddCommand(wafRuleSet.NewCmd(f))
+	cmd.AddCommand(wafAllowedRule.NewCmd(f))
+	cmd.AddCommand(networkList.NewCmd(f))
+	cmd.AddCommand(dnsZone.NewCmd(f))
+	cmd.AddCommand(dnsRecord.NewCmd(f))
+	cmd.AddCommand(dataStreaming.NewCmd(f))
 
 	cmd.Flags().BoolP("help", "h", false, msg.FlagHelp)
 	return cmd
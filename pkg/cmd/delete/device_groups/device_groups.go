@@ -1,4 +1,4 @@
-package delete
+package devicegroups
 
 import (
 	"context"
@@ -21,9 +21,9 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		SilenceUsage:  true,
 		SilenceErrors: true,
 		Example: heredoc.Doc(`
-		  $ azion device_groups delete --application-id 1234 --group-id 12312
-		  $ azion device_groups delete -a 1234 -g 12312
-    `),
+		$ azion delete device-group --application-id 1234 --group-id 12312
+		$ azion delete device-group -a 1234 -g 12312
+		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if !cmd.Flags().Changed("application-id") || !cmd.Flags().Changed("group-id") {
 				return msg.ErrorMandatoryFlags
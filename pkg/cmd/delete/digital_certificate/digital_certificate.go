@@ -0,0 +1,62 @@
+package digitalcertificate
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc"
+	"go.uber.org/zap"
+
+	msg "github.com/aziontech/azion-cli/messages/digital_certificate"
+	api "github.com/aziontech/azion-cli/pkg/api/digital_certificate"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/utils"
+	"github.com/spf13/cobra"
+)
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	var certificateID int32
+
+	cmd := &cobra.Command{
+		Use:           msg.Usage,
+		Short:         msg.DeleteShortDescription,
+		Long:          msg.DeleteLongDescription,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Example: heredoc.Doc(`
+		$ azion delete digital-certificate --digital-certificate-id 1337
+		$ azion delete digital-certificate
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !cmd.Flags().Changed("digital-certificate-id") {
+				answer, err := utils.AskInput(msg.AskDigitalCertificateID)
+				if err != nil {
+					return err
+				}
+
+				id, err := strconv.ParseInt(answer, 10, 32)
+				if err != nil {
+					logger.Debug("Error while converting answer to int32", zap.Error(err))
+					return msg.ErrorConvertID
+				}
+				certificateID = int32(id)
+			}
+
+			client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
+			err := client.Delete(context.Background(), certificateID)
+			if err != nil {
+				return fmt.Errorf(msg.ErrorDelete.Error(), err)
+			}
+
+			logger.LogSuccess(f.IOStreams.Out, fmt.Sprintf(msg.DeleteOutputSuccess, certificateID))
+			return nil
+		},
+	}
+
+	cmd.Flags().Int32Var(&certificateID, "digital-certificate-id", 0, msg.FlagDigitalCertificateID)
+	cmd.Flags().BoolP("help", "h", false, msg.DeleteHelpFlag)
+	cmdutil.MarkPrompted(cmd, "digital-certificate-id")
+	return cmd
+}
@@ -0,0 +1,46 @@
+package digitalcertificate
+
+import (
+	"testing"
+
+	"github.com/aziontech/azion-cli/pkg/httpmock"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestDelete(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+	t.Run("delete certificate by id", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("DELETE", "digital_certificates/1337"),
+			httpmock.StatusStringResponse(204, ""),
+		)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--digital-certificate-id", "1337"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		assert.Equal(t, "🚀 Digital Certificate 1337 was successfully deleted\n\n", stdout.String())
+	})
+
+	t.Run("delete certificate that is not found", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("DELETE", "digital_certificates/1337"),
+			httpmock.StatusStringResponse(404, "Not Found"),
+		)
+
+		f, _, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--digital-certificate-id", "1337"})
+
+		_, err := cmd.ExecuteC()
+		require.Error(t, err)
+	})
+}
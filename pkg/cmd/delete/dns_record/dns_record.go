@@ -0,0 +1,77 @@
+package dnsrecord
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc"
+	"go.uber.org/zap"
+
+	msg "github.com/aziontech/azion-cli/messages/dns_record"
+	api "github.com/aziontech/azion-cli/pkg/api/dns"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/utils"
+	"github.com/spf13/cobra"
+)
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	var zoneID, recordID int64
+
+	cmd := &cobra.Command{
+		Use:           msg.Usage,
+		Short:         msg.DeleteShortDescription,
+		Long:          msg.DeleteLongDescription,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Example: heredoc.Doc(`
+		$ azion delete dns-record --zone-id 1337 --record-id 42
+		$ azion delete dns-record
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !cmd.Flags().Changed("zone-id") {
+				answer, err := utils.AskInput(msg.AskZoneID)
+				if err != nil {
+					return err
+				}
+
+				id, err := strconv.ParseInt(answer, 10, 64)
+				if err != nil {
+					logger.Debug("Error while converting answer to int64", zap.Error(err))
+					return msg.ErrorConvertZoneID
+				}
+				zoneID = id
+			}
+
+			if !cmd.Flags().Changed("record-id") {
+				answer, err := utils.AskInput(msg.AskRecordID)
+				if err != nil {
+					return err
+				}
+
+				id, err := strconv.ParseInt(answer, 10, 64)
+				if err != nil {
+					logger.Debug("Error while converting answer to int64", zap.Error(err))
+					return msg.ErrorConvertRecordID
+				}
+				recordID = id
+			}
+
+			client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
+			err := client.DeleteRecord(context.Background(), zoneID, recordID)
+			if err != nil {
+				return fmt.Errorf(msg.ErrorDelete.Error(), err)
+			}
+
+			logger.LogSuccess(f.IOStreams.Out, fmt.Sprintf(msg.DeleteOutputSuccess, recordID))
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64Var(&zoneID, "zone-id", 0, msg.FlagZoneID)
+	cmd.Flags().Int64Var(&recordID, "record-id", 0, msg.FlagRecordID)
+	cmd.Flags().BoolP("help", "h", false, msg.DeleteHelpFlag)
+	cmdutil.MarkPrompted(cmd, "zone-id", "record-id")
+	return cmd
+}
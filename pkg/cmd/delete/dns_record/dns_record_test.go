@@ -0,0 +1,46 @@
+package dnsrecord
+
+import (
+	"testing"
+
+	"github.com/aziontech/azion-cli/pkg/httpmock"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestDelete(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+	t.Run("delete record by id", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("DELETE", "intelligent_dns/1337/records/42"),
+			httpmock.StatusStringResponse(204, ""),
+		)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--zone-id", "1337", "--record-id", "42"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		assert.Equal(t, "🚀 Edge DNS Record 42 was successfully deleted\n\n", stdout.String())
+	})
+
+	t.Run("delete record that is not found", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("DELETE", "intelligent_dns/1337/records/42"),
+			httpmock.StatusStringResponse(404, "Not Found"),
+		)
+
+		f, _, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--zone-id", "1337", "--record-id", "42"})
+
+		_, err := cmd.ExecuteC()
+		require.Error(t, err)
+	})
+}
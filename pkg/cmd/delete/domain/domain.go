@@ -62,6 +62,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 
 	cmd.Flags().Int64Var(&domain_id, "domain-id", 0, msg.FlagId)
 	cmd.Flags().BoolP("help", "h", false, msg.HelpFlag)
+	cmdutil.MarkPrompted(cmd, "domain-id")
 
 	return cmd
 }
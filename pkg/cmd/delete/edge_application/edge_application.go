@@ -60,6 +60,7 @@ func NewCobraCmd(delete *DeleteCmd) *cobra.Command {
 	cmd.Flags().Int64Var(&application_id, "application-id", 0, msg.FlagId)
 	cmd.Flags().Bool("cascade", true, msg.CascadeFlag)
 	cmd.Flags().BoolP("help", "h", false, msg.HelpFlag)
+	cmdutil.MarkPromptedUnless(cmd, "cascade", "application-id")
 
 	return cmd
 }
@@ -76,6 +77,10 @@ func (del *DeleteCmd) run(cmd *cobra.Command, application_id int64) error {
 	}
 
 	if !cmd.Flags().Changed("application-id") {
+		if !utils.Interactive() {
+			return utils.PromptError(msg.AskInput)
+		}
+
 		qs := []*survey.Question{
 			{
 				Name:     "id",
@@ -0,0 +1,62 @@
+package edgefirewall
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc"
+	"go.uber.org/zap"
+
+	msg "github.com/aziontech/azion-cli/messages/edge_firewall"
+	api "github.com/aziontech/azion-cli/pkg/api/edge_firewall"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/utils"
+	"github.com/spf13/cobra"
+)
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	var edgeFirewallID int64
+
+	cmd := &cobra.Command{
+		Use:           msg.Usage,
+		Short:         msg.DeleteShortDescription,
+		Long:          msg.DeleteLongDescription,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Example: heredoc.Doc(`
+		$ azion delete edge-firewall --edge-firewall-id 1337
+		$ azion delete edge-firewall
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !cmd.Flags().Changed("edge-firewall-id") {
+				answer, err := utils.AskInput(msg.AskEdgeFirewallID)
+				if err != nil {
+					return err
+				}
+
+				id, err := strconv.ParseInt(answer, 10, 64)
+				if err != nil {
+					logger.Debug("Error while converting answer to int64", zap.Error(err))
+					return msg.ErrorConvertID
+				}
+				edgeFirewallID = id
+			}
+
+			client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
+			err := client.Delete(context.Background(), edgeFirewallID)
+			if err != nil {
+				return fmt.Errorf(msg.ErrorDelete.Error(), err)
+			}
+
+			logger.LogSuccess(f.IOStreams.Out, fmt.Sprintf(msg.DeleteOutputSuccess, edgeFirewallID))
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64Var(&edgeFirewallID, "edge-firewall-id", 0, msg.FlagEdgeFirewallID)
+	cmd.Flags().BoolP("help", "h", false, msg.DeleteHelpFlag)
+	cmdutil.MarkPrompted(cmd, "edge-firewall-id")
+	return cmd
+}
@@ -60,6 +60,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 
 	cmd.Flags().Int64Var(&function_id, "function-id", 0, msg.FlagID)
 	cmd.Flags().BoolP("help", "h", false, msg.DeleteHelpFlag)
+	cmdutil.MarkPrompted(cmd, "function-id")
 
 	return cmd
 }
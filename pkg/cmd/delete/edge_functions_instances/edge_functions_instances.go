@@ -1,4 +1,4 @@
-package delete
+package edgefunctionsinstances
 
 import (
 	"context"
@@ -22,9 +22,9 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		SilenceUsage:  true,
 		SilenceErrors: true,
 		Example: heredoc.Doc(`
-		  $ azion edge_functions_instances delete --application-id 1673635839 --instance-id 12312
-		  $ azion edge_functions_instances delete -a 1673635839 -i 12312
-    `),
+		$ azion delete edge-function-instance --application-id 1673635839 --instance-id 12312
+		$ azion delete edge-function-instance -a 1673635839 -i 12312
+		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if !cmd.Flags().Changed("application-id") || !cmd.Flags().Changed("instance-id") {
 				return msg.ErrorMissingArgumentsDelete
@@ -1,4 +1,4 @@
-package delete
+package edgeservices
 
 import (
 	"context"
@@ -7,7 +7,7 @@ import (
 
 	"github.com/MakeNowJust/heredoc"
 	msg "github.com/aziontech/azion-cli/messages/edge_services"
-	"github.com/aziontech/azion-cli/pkg/cmd/edge_services/requests"
+	api "github.com/aziontech/azion-cli/pkg/api/edge_services"
 	"github.com/aziontech/azion-cli/pkg/cmdutil"
 	"github.com/aziontech/azion-cli/utils"
 	sdk "github.com/aziontech/azionapi-go-sdk/edgeservices"
@@ -24,17 +24,14 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		SilenceUsage:  true,
 		SilenceErrors: true,
 		Example: heredoc.Doc(`
-        $ azion edge_services delete --service-id 1234
-        `),
+		$ azion delete edge-service --service-id 1234
+		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if !cmd.Flags().Changed("service-id") {
 				return msg.ErrorMissingServiceIdArgument
 			}
 
-			client, err := requests.CreateClient(f)
-			if err != nil {
-				return err
-			}
+			client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
 
 			if err := deleteService(client, f.IOStreams.Out, service_id); err != nil {
 				return err
@@ -44,7 +41,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		},
 	}
 
-	deleteCmd.Flags().Int64VarP(&service_id, "service-id", "s", 0, msg.EdgeServiceFlagId)
+	deleteCmd.Flags().Int64Var(&service_id, "service-id", 0, msg.EdgeServiceFlagId)
 	deleteCmd.Flags().BoolP("help", "h", false, msg.EdgeServiceDeleteFlagHelp)
 
 	return deleteCmd
@@ -1,4 +1,4 @@
-package delete
+package edgeservicesresources
 
 import (
 	"context"
@@ -7,7 +7,7 @@ import (
 
 	"github.com/MakeNowJust/heredoc"
 	msg "github.com/aziontech/azion-cli/messages/edge_services"
-	"github.com/aziontech/azion-cli/pkg/cmd/edge_services/requests"
+	api "github.com/aziontech/azion-cli/pkg/api/edge_services"
 	"github.com/aziontech/azion-cli/pkg/cmdutil"
 	"github.com/aziontech/azion-cli/utils"
 	sdk "github.com/aziontech/azionapi-go-sdk/edgeservices"
@@ -29,17 +29,14 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		SilenceUsage:  true,
 		SilenceErrors: true,
 		Example: heredoc.Doc(`
-        $ azion edge_services resources delete --service-id 1234 --resource-id 81234
-        `),
+		$ azion delete edge-service-resource --service-id 1234 --resource-id 81234
+		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if !cmd.Flags().Changed("service-id") || !cmd.Flags().Changed("resource-id") {
 				return msg.ErrorMissingResourceIdArgument
 			}
 
-			client, err := requests.CreateClient(f)
-			if err != nil {
-				return err
-			}
+			client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
 
 			if err := deleteResource(client, f.IOStreams.Out, fields.ServiceId, fields.ResourceId); err != nil {
 				return err
@@ -49,7 +46,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		},
 	}
 
-	deleteCmd.Flags().Int64VarP(&fields.ServiceId, "service-id", "s", 0, msg.EdgeServiceFlagId)
+	deleteCmd.Flags().Int64Var(&fields.ServiceId, "service-id", 0, msg.EdgeServiceFlagId)
 	deleteCmd.Flags().Int64VarP(&fields.ResourceId, "resource-id", "r", 0, msg.EdgeServiceResourceFlagId)
 	deleteCmd.Flags().BoolP("help", "h", false, msg.EdgeServiceResourceDeleteFlagHelp)
 
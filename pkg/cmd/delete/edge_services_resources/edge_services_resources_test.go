@@ -1,4 +1,4 @@
-package delete
+package edgeservicesresources
 
 import (
 	"bytes"
@@ -47,7 +47,7 @@ func TestCreate(t *testing.T) {
 
 		cmd := NewCmd(f)
 
-		cmd.SetArgs([]string{"-s", "1234", "-r", "456"})
+		cmd.SetArgs([]string{"--service-id", "1234", "-r", "456"})
 		cmd.SetIn(&bytes.Buffer{})
 		cmd.SetOut(io.Discard)
 		cmd.SetErr(io.Discard)
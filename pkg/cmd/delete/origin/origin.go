@@ -66,5 +66,6 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 	cmd.Flags().Int64Var(&applicationID, "application-id", 0, msg.FlagEdgeApplicationID)
 	cmd.Flags().StringVar(&originKey, "origin-key", "", msg.FlagOriginKey)
 	cmd.Flags().BoolP("help", "h", false, msg.DeleteHelpFlag)
+	cmdutil.MarkPrompted(cmd, "application-id", "origin-key")
 	return cmd
 }
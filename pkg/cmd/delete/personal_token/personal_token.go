@@ -55,6 +55,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 
 	deleteCmd.Flags().StringVar(&id, "id", "", msg.FlagID)
 	deleteCmd.Flags().BoolP("help", "h", false, msg.HelpFlag)
+	cmdutil.MarkPrompted(deleteCmd, "id")
 
 	return deleteCmd
 }
@@ -92,6 +92,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 	cmd.Flags().Int64Var(&app_id, "application-id", 0, msg.FlagAppID)
 	cmd.Flags().StringVar(&phase, "phase", "", msg.FlagPhase)
 	cmd.Flags().BoolP("help", "h", false, msg.HelpFlag)
+	cmdutil.MarkPrompted(cmd, "rule-id", "application-id", "phase")
 
 	return cmd
 }
@@ -35,7 +35,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 
 				if err != nil {
 					logger.Debug("Error while parsing answer", zap.Error(err))
-					return utils.ErrorParseResponse
+					return err
 				}
 
 				variableID = answers
@@ -54,6 +54,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 
 	deleteCmd.Flags().StringVar(&variableID, "variable-id", "", msg.FlagVariableID)
 	deleteCmd.Flags().BoolP("help", "h", false, msg.DeleteHelpFlag)
+	cmdutil.MarkPrompted(deleteCmd, "variable-id")
 
 	return deleteCmd
 }
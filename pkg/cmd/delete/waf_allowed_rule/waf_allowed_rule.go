@@ -0,0 +1,77 @@
+package wafallowedrule
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc"
+	"go.uber.org/zap"
+
+	msg "github.com/aziontech/azion-cli/messages/waf_allowed_rule"
+	api "github.com/aziontech/azion-cli/pkg/api/waf"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/utils"
+	"github.com/spf13/cobra"
+)
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	var wafRuleSetID, allowedRuleID int64
+
+	cmd := &cobra.Command{
+		Use:           msg.Usage,
+		Aliases:       []string{"waf-exception"},
+		Short:         msg.DeleteShortDescription,
+		Long:          msg.DeleteLongDescription,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Example: heredoc.Doc(`
+		$ azion delete waf-allowed-rule --waf-rule-set-id 1337 --allowed-rule-id 7
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !cmd.Flags().Changed("waf-rule-set-id") {
+				answer, err := utils.AskInput(msg.AskWafRuleSetID)
+				if err != nil {
+					return err
+				}
+
+				id, err := strconv.ParseInt(answer, 10, 64)
+				if err != nil {
+					logger.Debug("Error while converting answer to int64", zap.Error(err))
+					return msg.ErrorConvertWafRuleSetID
+				}
+				wafRuleSetID = id
+			}
+
+			if !cmd.Flags().Changed("allowed-rule-id") {
+				answer, err := utils.AskInput(msg.AskAllowedRuleID)
+				if err != nil {
+					return err
+				}
+
+				id, err := strconv.ParseInt(answer, 10, 64)
+				if err != nil {
+					logger.Debug("Error while converting answer to int64", zap.Error(err))
+					return msg.ErrorConvertAllowedRuleID
+				}
+				allowedRuleID = id
+			}
+
+			client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
+			err := client.DeleteAllowedRule(context.Background(), wafRuleSetID, allowedRuleID)
+			if err != nil {
+				return fmt.Errorf(msg.ErrorDelete.Error(), err)
+			}
+
+			logger.LogSuccess(f.IOStreams.Out, fmt.Sprintf(msg.DeleteOutputSuccess, allowedRuleID))
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64Var(&wafRuleSetID, "waf-rule-set-id", 0, msg.FlagWafRuleSetID)
+	cmd.Flags().Int64Var(&allowedRuleID, "allowed-rule-id", 0, msg.FlagAllowedRuleID)
+	cmd.Flags().BoolP("help", "h", false, msg.DeleteHelpFlag)
+	cmdutil.MarkPrompted(cmd, "waf-rule-set-id", "allowed-rule-id")
+	return cmd
+}
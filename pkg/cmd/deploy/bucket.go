@@ -53,6 +53,13 @@ func (cmd *DeployCmd) doBucket(client *api.ClientStorage, ctx context.Context, c
 }
 
 func askForInput(msg string, defaultIn string) (string, error) {
+	if !utils.Interactive() {
+		if defaultIn != "" {
+			return defaultIn, nil
+		}
+		return "", utils.PromptError(msg)
+	}
+
 	var userInput string
 	prompt := &survey.Input{
 		Message: msg,
@@ -181,7 +181,10 @@ func (cmd *DeployCmd) doOrigin(client *apiapp.Client, clientOrigin *apiori.Clien
 		conf.Origin.Name = origin.GetName()
 
 		var cacheId int64
-		authorize := utils.Confirm(cmd.F.GlobalFlagAll, msg.AskCreateCacheSettings, false)
+		authorize, err := utils.Confirm(cmd.F.GlobalFlagAll, msg.AskCreateCacheSettings, false)
+		if err != nil {
+			return err
+		}
 		if authorize {
 			var reqCache apiapp.CreateCacheSettingsRequest
 			reqCache.SetName(conf.Name)
@@ -2,21 +2,19 @@ package cachesetting
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"strconv"
 
-	"github.com/fatih/color"
 	"go.uber.org/zap"
 
 	"github.com/MakeNowJust/heredoc"
-	"github.com/MaxwelMazur/tablecli"
 	msg "github.com/aziontech/azion-cli/messages/cache_setting"
 
 	api "github.com/aziontech/azion-cli/pkg/api/cache_setting"
 	"github.com/aziontech/azion-cli/pkg/cmdutil"
 	"github.com/aziontech/azion-cli/pkg/contracts"
 	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/printer"
 	"github.com/aziontech/azion-cli/utils"
 	"github.com/spf13/cobra"
 )
@@ -28,6 +26,7 @@ var (
 
 func NewCmd(f *cmdutil.Factory) *cobra.Command {
 	opts := &contracts.DescribeOptions{}
+	output := &printer.Options{}
 	cmd := &cobra.Command{
 		Use:           msg.Usage,
 		Short:         msg.DescribeShortDescription,
@@ -40,6 +39,9 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
         $ azion describe cache-setting --application-id 1673635839 --cache-setting-id 107313 --out "./tmp/test.json" 
         `),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := output.Validate(); err != nil {
+				return err
+			}
 
 			if !cmd.Flags().Changed("application-id") {
 				answer, err := utils.AskInput(msg.DescibeAskInputApplicationID)
@@ -79,62 +81,47 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 			}
 
 			out := f.IOStreams.Out
-			formattedFuction, err := format(cmd, resp)
-			if err != nil {
-				return utils.ErrorFormatOut
-			}
-
 			if cmd.Flags().Changed("out") {
-				err := cmdutil.WriteDetailsToFile(formattedFuction, opts.OutPath, out)
+				formatted, err := printer.Marshal(output.Format, resp)
 				if err != nil {
-					return fmt.Errorf("%s: %w", utils.ErrorWriteFile, err)
+					return utils.ErrorFormatOut
 				}
-				fmt.Fprintf(out, msg.FileWritten, opts.OutPath)
-			} else {
-				_, err := out.Write(formattedFuction[:])
+
+				err = cmdutil.WriteDetailsToFile(formatted, opts.OutPath, out)
 				if err != nil {
-					return err
+					return fmt.Errorf("%s: %w", utils.ErrorWriteFile, err)
 				}
+				fmt.Fprintf(out, msg.FileWritten, opts.OutPath)
+				return nil
 			}
 
-			return nil
+			return printer.PrintItem(out, output, columns, resp)
 		},
 	}
 
 	cmd.Flags().Int64Var(&applicationID, "application-id", 0, msg.DescribeFlagApplicationID)
 	cmd.Flags().Int64Var(&cacheSettingsID, "cache-setting-id", 0, msg.DescribeFlagCacheSettingsID)
 	cmd.Flags().StringVar(&opts.OutPath, "out", "", msg.DescribeFlagOut)
-	cmd.Flags().StringVar(&opts.Format, "format", "", msg.DescribeFlagFormat)
+	cmdutil.AddOutputFlags(f, cmd, output)
 	cmd.Flags().BoolP("help", "h", false, msg.DescribeHelpFlag)
+	cmdutil.MarkPrompted(cmd, "application-id", "cache-setting-id")
 	return cmd
 }
 
-func format(cmd *cobra.Command, strResp api.GetResponse) ([]byte, error) {
-	format, err := cmd.Flags().GetString("format")
-	if err != nil {
-		return nil, err
-	}
-
-	if format == "json" || cmd.Flags().Changed("out") {
-		return json.MarshalIndent(strResp, "", " ")
-	}
-
-	tbl := tablecli.New("", "")
-	tbl.WithFirstColumnFormatter(color.New(color.FgGreen).SprintfFunc())
-	tbl.AddRow("Id: ", strResp.GetId())
-	tbl.AddRow("Name: ", strResp.GetName())
-	tbl.AddRow("Browser cache settings: ", strResp.GetBrowserCacheSettings())
-	tbl.AddRow("Browser cache settings maximum TTL: ", strResp.GetBrowserCacheSettingsMaximumTtl())
-	tbl.AddRow("Cdn cache settings: ", strResp.GetCdnCacheSettings())
-	tbl.AddRow("Cdn cache settings maximum TTL: ", strResp.GetCdnCacheSettingsMaximumTtl())
-	tbl.AddRow("Cache by query string: ", strResp.GetCacheByQueryString())
-	tbl.AddRow("Query string fields: ", strResp.GetQueryStringFields())
-	tbl.AddRow("Enable query string sort: ", strResp.GetEnableCachingForPost())
-	tbl.AddRow("Cache by cookies: ", strResp.GetCacheByCookies())
-	tbl.AddRow("Cookie Names: ", strResp.GetCookieNames())
-	tbl.AddRow("Adaptive delivery action: ", strResp.GetAdaptiveDeliveryAction())
-	tbl.AddRow("Device group: ", strResp.GetDeviceGroup())
-	tbl.AddRow("EnableCachingForPost: ", strResp.GetEnableCachingForPost())
-	tbl.AddRow("L2 caching enabled: ", strResp.GetL2CachingEnabled())
-	return tbl.GetByteFormat(), nil
+var columns = []printer.Column{
+	{Header: "Id", Path: "id"},
+	{Header: "Name", Path: "name"},
+	{Header: "Browser cache settings", Path: "browser_cache_settings"},
+	{Header: "Browser cache settings maximum TTL", Path: "browser_cache_settings_maximum_ttl"},
+	{Header: "Cdn cache settings", Path: "cdn_cache_settings"},
+	{Header: "Cdn cache settings maximum TTL", Path: "cdn_cache_settings_maximum_ttl"},
+	{Header: "Cache by query string", Path: "cache_by_query_string"},
+	{Header: "Query string fields", Path: "query_string_fields", Format: printer.Join},
+	{Header: "Enable query string sort", Path: "enable_query_string_sort"},
+	{Header: "Cache by cookies", Path: "cache_by_cookies"},
+	{Header: "Cookie Names", Path: "cookie_names", Format: printer.Join},
+	{Header: "Adaptive delivery action", Path: "adaptive_delivery_action"},
+	{Header: "Device group", Path: "device_group", Format: printer.Join},
+	{Header: "Enable caching for post", Path: "enable_caching_for_post"},
+	{Header: "L2 caching enabled", Path: "l2_caching_enabled"},
 }
@@ -0,0 +1,118 @@
+package datastreaming
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/tidwall/gjson"
+	"go.uber.org/zap"
+
+	msg "github.com/aziontech/azion-cli/messages/data_streaming"
+	api "github.com/aziontech/azion-cli/pkg/api/data_streaming"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/contracts"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/printer"
+	"github.com/aziontech/azion-cli/utils"
+	"github.com/spf13/cobra"
+)
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	var dataStreamingID int64
+	opts := &contracts.DescribeOptions{}
+	output := &printer.Options{}
+
+	cmd := &cobra.Command{
+		Use:           msg.Usage,
+		Short:         msg.DescribeShortDescription,
+		Long:          msg.DescribeLongDescription,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Example: heredoc.Doc(`
+		$ azion describe data-streaming --data-streaming-id 1337
+		$ azion describe data-streaming --data-streaming-id 1337 --format yaml
+		$ azion describe data-streaming --data-streaming-id 1337 --out "./data_streaming.json"
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := output.Validate(); err != nil {
+				return err
+			}
+
+			if !cmd.Flags().Changed("data-streaming-id") {
+				answer, err := utils.AskInput(msg.AskDataStreamingID)
+				if err != nil {
+					return err
+				}
+
+				id, err := strconv.ParseInt(answer, 10, 64)
+				if err != nil {
+					logger.Debug("Error while converting answer to int64", zap.Error(err))
+					return msg.ErrorConvertID
+				}
+				dataStreamingID = id
+			}
+
+			client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
+			dataStreaming, err := client.Get(context.Background(), dataStreamingID)
+			if err != nil {
+				return fmt.Errorf(msg.ErrorGet.Error(), err)
+			}
+			// the credentials of the endpoint aren't shown, nor written to files
+			dataStreaming.Endpoint = dataStreaming.Endpoint.Masked()
+
+			out := f.IOStreams.Out
+			if cmd.Flags().Changed("out") {
+				formatted, err := printer.Marshal(output.Format, dataStreaming)
+				if err != nil {
+					return utils.ErrorFormatOut
+				}
+
+				err = cmdutil.WriteDetailsToFile(formatted, opts.OutPath, out)
+				if err != nil {
+					return fmt.Errorf("%s: %w", utils.ErrorWriteFile, err)
+				}
+				fmt.Fprintf(out, msg.FileWritten, filepath.Clean(opts.OutPath))
+				return nil
+			}
+
+			return printer.PrintItem(out, output, columns, dataStreaming)
+		},
+	}
+
+	cmd.Flags().Int64Var(&dataStreamingID, "data-streaming-id", 0, msg.FlagDataStreamingID)
+	cmd.Flags().StringVar(&opts.OutPath, "out", "", msg.DescribeFlagOut)
+	cmdutil.AddOutputFlags(f, cmd, output)
+	cmd.Flags().BoolP("help", "h", false, msg.DescribeHelpFlag)
+	cmdutil.MarkPrompted(cmd, "data-streaming-id")
+
+	return cmd
+}
+
+var columns = []printer.Column{
+	{Header: "ID", Path: "id"},
+	{Header: "Name", Path: "name"},
+	{Header: "Template ID", Path: "template_id"},
+	{Header: "Data Source", Path: "data_source"},
+	{Header: "Active", Path: "active"},
+	{Header: "All Domains", Path: "all_domains"},
+	{Header: "Domains", Path: "domains.#.name", Format: printer.Join},
+	{Header: "Sampling Percentage", Path: "sampling_percentage"},
+	{Header: "Endpoint Type", Path: "endpoint.endpoint_type"},
+	{Header: "Endpoint", Path: "endpoint", Format: endpointAttributes},
+}
+
+// endpointAttributes prints the attributes of the endpoint besides its type, as key=value pairs
+func endpointAttributes(value gjson.Result) string {
+	var attributes []string
+	value.ForEach(func(key, attribute gjson.Result) bool {
+		if key.String() != "endpoint_type" {
+			attributes = append(attributes, key.String()+"="+attribute.String())
+		}
+		return true
+	})
+	return strings.Join(attributes, ", ")
+}
@@ -0,0 +1,74 @@
+package datastreaming
+
+import (
+	"testing"
+
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/httpmock"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestDescribe(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+
+	t.Run("describe a data streaming", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("GET", "data_streaming/streamings/1337"),
+			httpmock.JSONFromFile("./fixtures/data_streaming.json"),
+		)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmdutil.AddFormatFlag(cmd, f)
+		cmd.SetArgs([]string{"--data-streaming-id", "1337"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		assert.Regexp(t, `Name:\s+s3 archive`, stdout.String())
+		assert.Regexp(t, `Domains:\s+shop, blog`, stdout.String())
+		assert.Regexp(t, `Sampling Percentage:\s+25`, stdout.String())
+		assert.Regexp(t, `Endpoint Type:\s+s3`, stdout.String())
+		assert.Contains(t, stdout.String(), "bucket_name=edge-logs")
+		assert.Contains(t, stdout.String(), "secret_key=********")
+		assert.NotContains(t, stdout.String(), "wJalrXUtnFEMI")
+	})
+
+	t.Run("describe masks the credentials in json", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("GET", "data_streaming/streamings/1337"),
+			httpmock.JSONFromFile("./fixtures/data_streaming.json"),
+		)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmdutil.AddFormatFlag(cmd, f)
+		cmd.SetArgs([]string{"--data-streaming-id", "1337", "--format", "json"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		assert.Contains(t, stdout.String(), `"secret_key": "********"`)
+		assert.Contains(t, stdout.String(), `"access_key": "AKIAEXAMPLE"`)
+	})
+
+	t.Run("describe a data streaming that is not found", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("GET", "data_streaming/streamings/1337"),
+			httpmock.StatusStringResponse(404, "Not Found"),
+		)
+
+		f, _, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmdutil.AddFormatFlag(cmd, f)
+		cmd.SetArgs([]string{"--data-streaming-id", "1337"})
+
+		_, err := cmd.ExecuteC()
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,95 @@
+package datastreamingtemplate
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc"
+	"go.uber.org/zap"
+
+	msg "github.com/aziontech/azion-cli/messages/data_streaming_template"
+	api "github.com/aziontech/azion-cli/pkg/api/data_streaming"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/contracts"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/printer"
+	"github.com/aziontech/azion-cli/utils"
+	"github.com/spf13/cobra"
+)
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	var templateID int64
+	opts := &contracts.DescribeOptions{}
+	output := &printer.Options{}
+
+	cmd := &cobra.Command{
+		Use:           msg.Usage,
+		Short:         msg.DescribeShortDescription,
+		Long:          msg.DescribeLongDescription,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Example: heredoc.Doc(`
+		$ azion describe data-streaming-template --template-id 2
+		$ azion describe data-streaming-template --template-id 2 --query template_model
+		$ azion describe data-streaming-template --template-id 2 --out "./template.json"
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := output.Validate(); err != nil {
+				return err
+			}
+
+			if !cmd.Flags().Changed("template-id") {
+				answer, err := utils.AskInput(msg.AskTemplateID)
+				if err != nil {
+					return err
+				}
+
+				id, err := strconv.ParseInt(answer, 10, 64)
+				if err != nil {
+					logger.Debug("Error while converting answer to int64", zap.Error(err))
+					return msg.ErrorConvertID
+				}
+				templateID = id
+			}
+
+			client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
+			template, err := client.GetTemplate(context.Background(), templateID)
+			if err != nil {
+				return fmt.Errorf(msg.ErrorGet.Error(), err)
+			}
+
+			out := f.IOStreams.Out
+			if cmd.Flags().Changed("out") {
+				formatted, err := printer.Marshal(output.Format, template)
+				if err != nil {
+					return utils.ErrorFormatOut
+				}
+
+				err = cmdutil.WriteDetailsToFile(formatted, opts.OutPath, out)
+				if err != nil {
+					return fmt.Errorf("%s: %w", utils.ErrorWriteFile, err)
+				}
+				fmt.Fprintf(out, msg.FileWritten, filepath.Clean(opts.OutPath))
+				return nil
+			}
+
+			return printer.PrintItem(out, output, columns, template)
+		},
+	}
+
+	cmd.Flags().Int64Var(&templateID, "template-id", 0, msg.FlagTemplateID)
+	cmd.Flags().StringVar(&opts.OutPath, "out", "", msg.DescribeFlagOut)
+	cmdutil.AddOutputFlags(f, cmd, output)
+	cmd.Flags().BoolP("help", "h", false, msg.DescribeHelpFlag)
+	cmdutil.MarkPrompted(cmd, "template-id")
+
+	return cmd
+}
+
+var columns = []printer.Column{
+	{Header: "ID", Path: "id"},
+	{Header: "Name", Path: "name"},
+	{Header: "Template Model", Path: "template_model"},
+}
@@ -0,0 +1,48 @@
+package datastreamingtemplate
+
+import (
+	"testing"
+
+	"github.com/aziontech/azion-cli/pkg/httpmock"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestDescribe(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+
+	t.Run("describe a template", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("GET", "data_streaming/templates/2"),
+			httpmock.JSONFromFile("./fixtures/template.json"),
+		)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--template-id", "2"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		assert.Regexp(t, `Name:\s+Edge Applications Event Collector`, stdout.String())
+		assert.Contains(t, stdout.String(), "$request_id")
+	})
+
+	t.Run("describe a template that is not found", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("GET", "data_streaming/templates/2"),
+			httpmock.StatusStringResponse(404, "Not Found"),
+		)
+
+		f, _, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--template-id", "2"})
+
+		_, err := cmd.ExecuteC()
+		require.Error(t, err)
+	})
+}
@@ -4,12 +4,26 @@ import (
 	"github.com/MakeNowJust/heredoc"
 	msg "github.com/aziontech/azion-cli/messages/describe"
 	cache "github.com/aziontech/azion-cli/pkg/cmd/describe/cache_setting"
+	dataStreaming "github.com/aziontech/azion-cli/pkg/cmd/describe/data_streaming"
+	dataStreamingTemplate "github.com/aziontech/azion-cli/pkg/cmd/describe/data_streaming_template"
+	deviceGroups "github.com/aziontech/azion-cli/pkg/cmd/describe/device_groups"
+	digitalCertificate "github.com/aziontech/azion-cli/pkg/cmd/describe/digital_certificate"
+	dnsRecord "github.com/aziontech/azion-cli/pkg/cmd/describe/dns_record"
+	dnsZone "github.com/aziontech/azion-cli/pkg/cmd/describe/dns_zone"
 	"github.com/aziontech/azion-cli/pkg/cmd/describe/domains"
 	edgeApplications "github.com/aziontech/azion-cli/pkg/cmd/describe/edge_applications"
+	edgeFirewall "github.com/aziontech/azion-cli/pkg/cmd/describe/edge_firewall"
+	edgeFirewallRule "github.com/aziontech/azion-cli/pkg/cmd/describe/edge_firewall_rule"
 	function "github.com/aziontech/azion-cli/pkg/cmd/describe/edge_function"
+	edgeFunctionsInstances "github.com/aziontech/azion-cli/pkg/cmd/describe/edge_functions_instances"
+	edgeServices "github.com/aziontech/azion-cli/pkg/cmd/describe/edge_services"
+	edgeServicesResources "github.com/aziontech/azion-cli/pkg/cmd/describe/edge_services_resources"
+	networkList "github.com/aziontech/azion-cli/pkg/cmd/describe/network_list"
 	origin "github.com/aziontech/azion-cli/pkg/cmd/describe/origin"
 	ruleEngine "github.com/aziontech/azion-cli/pkg/cmd/describe/rules_engine"
 	"github.com/aziontech/azion-cli/pkg/cmd/describe/variables"
+	wafAllowedRule "github.com/aziontech/azion-cli/pkg/cmd/describe/waf_allowed_rule"
+	wafRuleSet "github.com/aziontech/azion-cli/pkg/cmd/describe/waf_rule_set"
 	"github.com/aziontech/azion-cli/pkg/cmdutil"
 	"github.com/spf13/cobra"
 )
@@ -26,6 +40,17 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		$ azion describe origin
 		$ azion describe rule-engine
 		$ azion describe variables
+		$ azion describe device-group
+		$ azion describe edge-function-instance
+		$ azion describe edge-service
+		$ azion describe edge-service-resource
+		$ azion describe digital-certificate
+		$ azion describe edge-firewall
+		$ azion describe waf-rule-set
+		$ azion describe network-list
+		$ azion describe dns-zone
+		$ azion describe dns-record
+		$ azion describe data-streaming
         `),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return cmd.Help()
@@ -39,6 +64,20 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 	cmd.AddCommand(cache.NewCmd(f))
 	cmd.AddCommand(function.NewCmd(f))
 	cmd.AddCommand(variables.NewCmd(f))
+	cmd.AddCommand(deviceGroups.NewCmd(f))
+	cmd.AddCommand(edgeFunctionsInstances.NewCmd(f))
+	cmd.AddCommand(edgeServices.NewCmd(f))
+	cmd.AddCommand(edgeServicesResources.NewCmd(f))
+	cmd.AddCommand(digitalCertificate.NewCmd(f))
+	cmd.AddCommand(edgeFirewall.NewCmd(f))
+	cmd.AddCommand(edgeFirewallRule.NewCmd(f))
+	cmd.AddCommand(wafRuleSet.NewCmd(f))
+	cmd.AddCommand(wafAllowedRule.NewCmd(f))
+	cmd.AddCommand(networkList.NewCmd(f))
+	cmd.AddCommand(dnsZone.NewCmd(f))
+	cmd.AddCommand(dnsRecord.NewCmd(f))
+	cmd.AddCommand(dataStreaming.NewCmd(f))
+	cmd.AddCommand(dataStreamingTemplate.NewCmd(f))
 
 	cmd.Flags().BoolP("help", "h", false, msg.FlagHelp)
 	return cmd
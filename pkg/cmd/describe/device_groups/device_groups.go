@@ -1,20 +1,17 @@
-package describe
+package devicegroups
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"path/filepath"
 
-	"github.com/fatih/color"
-
 	"github.com/MakeNowJust/heredoc"
-	"github.com/MaxwelMazur/tablecli"
 	msg "github.com/aziontech/azion-cli/messages/device_groups"
 
 	api "github.com/aziontech/azion-cli/pkg/api/edge_applications"
 	"github.com/aziontech/azion-cli/pkg/cmdutil"
 	"github.com/aziontech/azion-cli/pkg/contracts"
+	"github.com/aziontech/azion-cli/pkg/printer"
 	"github.com/aziontech/azion-cli/utils"
 	"github.com/spf13/cobra"
 )
@@ -26,6 +23,7 @@ var (
 
 func NewCmd(f *cmdutil.Factory) *cobra.Command {
 	opts := &contracts.DescribeOptions{}
+	output := &printer.Options{}
 	cmd := &cobra.Command{
 		Use:           msg.DeviceGroupsDescribeUsage,
 		Short:         msg.DeviceGroupsDescribeShortDescription,
@@ -33,11 +31,15 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		SilenceUsage:  true,
 		SilenceErrors: true,
 		Example: heredoc.Doc(`
-      $ azion device_groups describe --application-id 1673635839 --group-id 31223
-      $ azion device_groups describe -a 1673635839 -g 31223 --format json
-      $ azion device_groups describe --application-id 1673635839 --group-id 31223 --out "./tmp/test.json"
-    `),
+		$ azion describe device-group --application-id 1673635839 --group-id 31223
+		$ azion describe device-group -a 1673635839 -g 31223 --format json
+		$ azion describe device-group --application-id 1673635839 --group-id 31223 --out "./tmp/test.json"
+		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := output.Validate(); err != nil {
+				return err
+			}
+
 			if !cmd.Flags().Changed("application-id") || !cmd.Flags().Changed("group-id") {
 				return msg.ErrorMandatoryFlags
 			}
@@ -50,51 +52,35 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 			}
 
 			out := f.IOStreams.Out
-			formattedFuction, err := format(cmd, groups)
-			if err != nil {
-				return utils.ErrorFormatOut
-			}
-
 			if cmd.Flags().Changed("out") {
-				err := cmdutil.WriteDetailsToFile(formattedFuction, opts.OutPath, out)
+				formatted, err := printer.Marshal(output.Format, groups)
 				if err != nil {
-					return fmt.Errorf("%s: %w", utils.ErrorWriteFile, err)
+					return utils.ErrorFormatOut
 				}
-				fmt.Fprintf(out, msg.DeviceGroupsFileWritten, filepath.Clean(opts.OutPath))
-			} else {
-				_, err := out.Write(formattedFuction[:])
+
+				err = cmdutil.WriteDetailsToFile(formatted, opts.OutPath, out)
 				if err != nil {
-					return err
+					return fmt.Errorf("%s: %w", utils.ErrorWriteFile, err)
 				}
+				fmt.Fprintf(out, msg.DeviceGroupsFileWritten, filepath.Clean(opts.OutPath))
+				return nil
 			}
 
-			return nil
+			return printer.PrintItem(out, output, columns, groups)
 		},
 	}
 
 	cmd.Flags().Int64VarP(&applicationID, "application-id", "a", 0, msg.ApplicationFlagId)
 	cmd.Flags().Int64VarP(&groupID, "group-id", "g", 0, msg.DeviceGroupFlagId)
 	cmd.Flags().StringVar(&opts.OutPath, "out", "", msg.DeviceGroupsDescribeFlagOut)
-	cmd.Flags().StringVar(&opts.Format, "format", "", msg.DeviceGroupsDescribeFlagFormat)
+	cmdutil.AddOutputFlags(f, cmd, output)
 	cmd.Flags().BoolP("help", "h", false, msg.DeviceGroupsDescribeHelpFlag)
 
 	return cmd
 }
 
-func format(cmd *cobra.Command, rules api.DeviceGroupsResponse) ([]byte, error) {
-	format, err := cmd.Flags().GetString("format")
-	if err != nil {
-		return nil, err
-	}
-
-	if format == "json" || cmd.Flags().Changed("out") {
-		return json.MarshalIndent(rules, "", " ")
-	}
-
-	tbl := tablecli.New("", "")
-	tbl.WithFirstColumnFormatter(color.New(color.FgGreen).SprintfFunc())
-	tbl.AddRow("Device Group ID: ", rules.GetId())
-	tbl.AddRow("Name: ", rules.GetName())
-	tbl.AddRow("User Agent: ", rules.GetUserAgent())
-	return tbl.GetByteFormat(), nil
+var columns = []printer.Column{
+	{Header: "Device Group ID", Path: "id"},
+	{Header: "Name", Path: "name"},
+	{Header: "User Agent", Path: "user_agent"},
 }
@@ -0,0 +1,115 @@
+package digitalcertificate
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/tidwall/gjson"
+	"go.uber.org/zap"
+
+	msg "github.com/aziontech/azion-cli/messages/digital_certificate"
+	api "github.com/aziontech/azion-cli/pkg/api/digital_certificate"
+	"github.com/aziontech/azion-cli/pkg/certificate"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/contracts"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/printer"
+	"github.com/aziontech/azion-cli/utils"
+	"github.com/spf13/cobra"
+)
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	var certificateID int64
+	opts := &contracts.DescribeOptions{}
+	output := &printer.Options{}
+
+	cmd := &cobra.Command{
+		Use:           msg.Usage,
+		Short:         msg.DescribeShortDescription,
+		Long:          msg.DescribeLongDescription,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Example: heredoc.Doc(`
+		$ azion describe digital-certificate --digital-certificate-id 1337
+		$ azion describe digital-certificate --digital-certificate-id 1337 --format json
+		$ azion describe digital-certificate --digital-certificate-id 1337 --query validity
+		$ azion describe digital-certificate --digital-certificate-id 1337 --out "./tmp/certificate.json"
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := output.Validate(); err != nil {
+				return err
+			}
+
+			if !cmd.Flags().Changed("digital-certificate-id") {
+				answer, err := utils.AskInput(msg.AskDigitalCertificateID)
+				if err != nil {
+					return err
+				}
+
+				id, err := strconv.ParseInt(answer, 10, 64)
+				if err != nil {
+					logger.Debug("Error while converting answer to int64", zap.Error(err))
+					return msg.ErrorConvertID
+				}
+				certificateID = id
+			}
+
+			client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
+			digitalCertificate, err := client.Get(context.Background(), certificateID)
+			if err != nil {
+				return fmt.Errorf(msg.ErrorGet.Error(), err)
+			}
+
+			out := f.IOStreams.Out
+			if cmd.Flags().Changed("out") {
+				formatted, err := printer.Marshal(output.Format, digitalCertificate)
+				if err != nil {
+					return utils.ErrorFormatOut
+				}
+
+				err = cmdutil.WriteDetailsToFile(formatted, opts.OutPath, out)
+				if err != nil {
+					return fmt.Errorf("%s: %w", utils.ErrorWriteFile, err)
+				}
+				fmt.Fprintf(out, msg.FileWritten, filepath.Clean(opts.OutPath))
+				return nil
+			}
+
+			return printer.PrintItem(out, output, columns, digitalCertificate)
+		},
+	}
+
+	cmd.Flags().Int64Var(&certificateID, "digital-certificate-id", 0, msg.FlagDigitalCertificateID)
+	cmd.Flags().StringVar(&opts.OutPath, "out", "", msg.DescribeFlagOut)
+	cmdutil.AddOutputFlags(f, cmd, output)
+	cmd.Flags().BoolP("help", "h", false, msg.DescribeHelpFlag)
+	cmdutil.MarkPrompted(cmd, "digital-certificate-id")
+
+	return cmd
+}
+
+var columns = []printer.Column{
+	{Header: "ID", Path: "id"},
+	{Header: "Name", Path: "name"},
+	{Header: "Subject Names", Path: "subject_name", Format: printer.Join},
+	{Header: "Issuer", Path: "issuer"},
+	{Header: "Validity", Path: "validity", Format: expiry},
+	{Header: "Status", Path: "status"},
+	{Header: "Certificate Type", Path: "certificate_type"},
+	{Header: "Managed", Path: "managed"},
+	{Header: "Azion Information", Path: "azion_information"},
+}
+
+func expiry(value gjson.Result) string {
+	if value.Type == gjson.Null || !value.Exists() {
+		return "-"
+	}
+	return certificate.Expiry(value.String(), now())
+}
+
+// now is replaced in tests so the days left until expiry are stable
+var now = time.Now
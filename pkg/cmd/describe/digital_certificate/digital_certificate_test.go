@@ -0,0 +1,83 @@
+package digitalcertificate
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/aziontech/azion-cli/pkg/httpmock"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestDescribe(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+	now = func() time.Time { return time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC) }
+
+	t.Run("describe a certificate", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("GET", "digital_certificates/1337"),
+			httpmock.JSONFromFile("./fixtures/certificate.json"),
+		)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--digital-certificate-id", "1337"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		assert.Equal(t,
+			"ID:                  1337                                    \n"+
+				"Name:                example.com                             \n"+
+				"Subject Names:       example.com, *.example.com              \n"+
+				"Issuer:              Example CA                              \n"+
+				"Validity:            2024-09-01 12:00:00-03:00 (in 92 days)  \n"+
+				"Status:              active                                  \n"+
+				"Certificate Type:    edge_certificate                        \n"+
+				"Managed:             false                                   \n"+
+				"Azion Information:   Certificate successfully uploaded       \n",
+			stdout.String(),
+		)
+	})
+
+	t.Run("write the certificate to a file", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("GET", "digital_certificates/1337"),
+			httpmock.JSONFromFile("./fixtures/certificate.json"),
+		)
+
+		path := filepath.Join(t.TempDir(), "certificate.json")
+		f, stdout, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--digital-certificate-id", "1337", "--out", path})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		assert.Equal(t, "File successfully written to: "+path+"\n", stdout.String())
+
+		content, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.Contains(t, string(content), `"validity": "2024-09-01 12:00:00-03:00"`)
+	})
+
+	t.Run("certificate not found", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("GET", "digital_certificates/1337"),
+			httpmock.StatusStringResponse(404, "Not Found"),
+		)
+
+		f, _, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--digital-certificate-id", "1337"})
+
+		_, err := cmd.ExecuteC()
+		require.Error(t, err)
+	})
+}
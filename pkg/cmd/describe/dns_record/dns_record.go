@@ -0,0 +1,115 @@
+package dnsrecord
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc"
+	"go.uber.org/zap"
+
+	msg "github.com/aziontech/azion-cli/messages/dns_record"
+	api "github.com/aziontech/azion-cli/pkg/api/dns"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/contracts"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/printer"
+	"github.com/aziontech/azion-cli/utils"
+	"github.com/spf13/cobra"
+)
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	var zoneID, recordID int64
+	opts := &contracts.DescribeOptions{}
+	output := &printer.Options{}
+
+	cmd := &cobra.Command{
+		Use:           msg.Usage,
+		Short:         msg.DescribeShortDescription,
+		Long:          msg.DescribeLongDescription,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Example: heredoc.Doc(`
+		$ azion describe dns-record --zone-id 1337 --record-id 42
+		$ azion describe dns-record --zone-id 1337 --record-id 42 --format json
+		$ azion describe dns-record --zone-id 1337 --record-id 42 --out "./dns_record.json"
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := output.Validate(); err != nil {
+				return err
+			}
+
+			if !cmd.Flags().Changed("zone-id") {
+				answer, err := utils.AskInput(msg.AskZoneID)
+				if err != nil {
+					return err
+				}
+
+				id, err := strconv.ParseInt(answer, 10, 64)
+				if err != nil {
+					logger.Debug("Error while converting answer to int64", zap.Error(err))
+					return msg.ErrorConvertZoneID
+				}
+				zoneID = id
+			}
+
+			if !cmd.Flags().Changed("record-id") {
+				answer, err := utils.AskInput(msg.AskRecordID)
+				if err != nil {
+					return err
+				}
+
+				id, err := strconv.ParseInt(answer, 10, 64)
+				if err != nil {
+					logger.Debug("Error while converting answer to int64", zap.Error(err))
+					return msg.ErrorConvertRecordID
+				}
+				recordID = id
+			}
+
+			client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
+			record, err := client.GetRecord(context.Background(), zoneID, recordID)
+			if err != nil {
+				return fmt.Errorf(msg.ErrorGet.Error(), err)
+			}
+
+			out := f.IOStreams.Out
+			if cmd.Flags().Changed("out") {
+				formatted, err := printer.Marshal(output.Format, record)
+				if err != nil {
+					return utils.ErrorFormatOut
+				}
+
+				err = cmdutil.WriteDetailsToFile(formatted, opts.OutPath, out)
+				if err != nil {
+					return fmt.Errorf("%s: %w", utils.ErrorWriteFile, err)
+				}
+				fmt.Fprintf(out, msg.FileWritten, filepath.Clean(opts.OutPath))
+				return nil
+			}
+
+			return printer.PrintItem(out, output, columns, record)
+		},
+	}
+
+	cmd.Flags().Int64Var(&zoneID, "zone-id", 0, msg.FlagZoneID)
+	cmd.Flags().Int64Var(&recordID, "record-id", 0, msg.FlagRecordID)
+	cmd.Flags().StringVar(&opts.OutPath, "out", "", msg.DescribeFlagOut)
+	cmdutil.AddOutputFlags(f, cmd, output)
+	cmd.Flags().BoolP("help", "h", false, msg.DescribeHelpFlag)
+	cmdutil.MarkPrompted(cmd, "zone-id", "record-id")
+
+	return cmd
+}
+
+var columns = []printer.Column{
+	{Header: "ID", Path: "record_id"},
+	{Header: "Entry", Path: "entry"},
+	{Header: "Type", Path: "record_type"},
+	{Header: "TTL", Path: "ttl"},
+	{Header: "Answers", Path: "answers_list", Format: printer.Join},
+	{Header: "Policy", Path: "policy"},
+	{Header: "Weight", Path: "weight"},
+	{Header: "Description", Path: "description"},
+}
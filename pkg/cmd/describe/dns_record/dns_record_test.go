@@ -0,0 +1,48 @@
+package dnsrecord
+
+import (
+	"testing"
+
+	"github.com/aziontech/azion-cli/pkg/httpmock"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestDescribe(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+	t.Run("describe a record", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("GET", "intelligent_dns/1337/records"),
+			httpmock.JSONFromFile("./fixtures/records.json"),
+		)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--zone-id", "1337", "--record-id", "43"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		assert.Regexp(t, `ID:\s+43`, stdout.String())
+		assert.Regexp(t, `Type:\s+MX`, stdout.String())
+		assert.Regexp(t, `Answers:\s+10 mail.example.com`, stdout.String())
+	})
+
+	t.Run("describe a record that is not in the zone", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("GET", "intelligent_dns/1337/records"),
+			httpmock.JSONFromFile("./fixtures/records.json"),
+		)
+
+		f, _, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--zone-id", "1337", "--record-id", "7"})
+
+		_, err := cmd.ExecuteC()
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,97 @@
+package dnszone
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc"
+	"go.uber.org/zap"
+
+	msg "github.com/aziontech/azion-cli/messages/dns_zone"
+	api "github.com/aziontech/azion-cli/pkg/api/dns"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/contracts"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/printer"
+	"github.com/aziontech/azion-cli/utils"
+	"github.com/spf13/cobra"
+)
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	var zoneID int64
+	opts := &contracts.DescribeOptions{}
+	output := &printer.Options{}
+
+	cmd := &cobra.Command{
+		Use:           msg.Usage,
+		Short:         msg.DescribeShortDescription,
+		Long:          msg.DescribeLongDescription,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Example: heredoc.Doc(`
+		$ azion describe dns-zone --zone-id 1337
+		$ azion describe dns-zone --zone-id 1337 --format json
+		$ azion describe dns-zone --zone-id 1337 --out "./dns_zone.json"
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := output.Validate(); err != nil {
+				return err
+			}
+
+			if !cmd.Flags().Changed("zone-id") {
+				answer, err := utils.AskInput(msg.AskZoneID)
+				if err != nil {
+					return err
+				}
+
+				id, err := strconv.ParseInt(answer, 10, 64)
+				if err != nil {
+					logger.Debug("Error while converting answer to int64", zap.Error(err))
+					return msg.ErrorConvertID
+				}
+				zoneID = id
+			}
+
+			client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
+			zone, err := client.GetZone(context.Background(), zoneID)
+			if err != nil {
+				return fmt.Errorf(msg.ErrorGet.Error(), err)
+			}
+
+			out := f.IOStreams.Out
+			if cmd.Flags().Changed("out") {
+				formatted, err := printer.Marshal(output.Format, zone)
+				if err != nil {
+					return utils.ErrorFormatOut
+				}
+
+				err = cmdutil.WriteDetailsToFile(formatted, opts.OutPath, out)
+				if err != nil {
+					return fmt.Errorf("%s: %w", utils.ErrorWriteFile, err)
+				}
+				fmt.Fprintf(out, msg.FileWritten, filepath.Clean(opts.OutPath))
+				return nil
+			}
+
+			return printer.PrintItem(out, output, columns, zone)
+		},
+	}
+
+	cmd.Flags().Int64Var(&zoneID, "zone-id", 0, msg.FlagZoneID)
+	cmd.Flags().StringVar(&opts.OutPath, "out", "", msg.DescribeFlagOut)
+	cmdutil.AddOutputFlags(f, cmd, output)
+	cmd.Flags().BoolP("help", "h", false, msg.DescribeHelpFlag)
+	cmdutil.MarkPrompted(cmd, "zone-id")
+
+	return cmd
+}
+
+var columns = []printer.Column{
+	{Header: "ID", Path: "id"},
+	{Header: "Name", Path: "name"},
+	{Header: "Domain", Path: "domain"},
+	{Header: "Active", Path: "is_active"},
+	{Header: "Nameservers", Path: "nameservers", Format: printer.Join},
+}
@@ -2,19 +2,17 @@ package domains
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"path/filepath"
 
 	"github.com/MakeNowJust/heredoc"
-	"github.com/MaxwelMazur/tablecli"
 	msg "github.com/aziontech/azion-cli/messages/describe/domain"
-	"github.com/fatih/color"
 
 	api "github.com/aziontech/azion-cli/pkg/api/domain"
 	"github.com/aziontech/azion-cli/pkg/cmdutil"
 	"github.com/aziontech/azion-cli/pkg/contracts"
 	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/printer"
 	"github.com/aziontech/azion-cli/utils"
 	"github.com/spf13/cobra"
 )
@@ -22,6 +20,7 @@ import (
 func NewCmd(f *cmdutil.Factory) *cobra.Command {
 	var domainID string
 	opts := &contracts.DescribeOptions{}
+	output := &printer.Options{}
 	cmd := &cobra.Command{
 		Use:           msg.Usage,
 		Short:         msg.ShortDescription,
@@ -34,6 +33,10 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
         $ azion describe domain --domain-id 1337 --format json
         `),
 		RunE: func(cmd *cobra.Command, _ []string) error {
+			if err := output.Validate(); err != nil {
+				return err
+			}
+
 			if !cmd.Flags().Changed("domain-id") {
 				answer, err := utils.AskInput(msg.AskInputDomainID)
 				if err != nil {
@@ -52,13 +55,13 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 			}
 
 			out := f.IOStreams.Out
-			formattedFuction, err := format(cmd, domain)
-			if err != nil {
-				return utils.ErrorFormatOut
-			}
-
 			if cmd.Flags().Changed("out") {
-				err := cmdutil.WriteDetailsToFile(formattedFuction, opts.OutPath, out)
+				formatted, err := printer.Marshal(output.Format, domain)
+				if err != nil {
+					return utils.ErrorFormatOut
+				}
+
+				err = cmdutil.WriteDetailsToFile(formatted, opts.OutPath, out)
 				if err != nil {
 					return fmt.Errorf("%s: %w", utils.ErrorWriteFile, err)
 				}
@@ -66,45 +69,25 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 				return nil
 			}
 
-			logger.FInfo(out, string(formattedFuction[:]))
-			return nil
+			return printer.PrintItem(out, output, columns, domain)
 		},
 	}
 
 	cmd.Flags().StringVar(&domainID, "domain-id", "", msg.FlagDomainID)
 	cmd.Flags().StringVar(&opts.OutPath, "out", "", msg.FlagOut)
-	cmd.Flags().StringVar(&opts.Format, "format", "", msg.FlagFormat)
+	cmdutil.AddOutputFlags(f, cmd, output)
 	cmd.Flags().BoolP("help", "h", false, msg.HelpFlag)
+	cmdutil.MarkPrompted(cmd, "domain-id")
 
 	return cmd
 }
 
-func format(cmd *cobra.Command, domain api.DomainResponse) ([]byte, error) {
-	format, err := cmd.Flags().GetString("format")
-	if err != nil {
-		return nil, err
-	}
-
-	if format == "json" || cmd.Flags().Changed("out") {
-		return json.MarshalIndent(domain, "", " ")
-	}
-
-	tbl := tablecli.New("", "")
-	tbl.WithFirstColumnFormatter(color.New(color.FgGreen).SprintfFunc())
-
-	tbl.AddRow("ID: ", domain.GetId())
-	tbl.AddRow("Name: ", domain.GetName())
-	tbl.AddRow("Domain: ", domain.GetDomainName())
-	tbl.AddRow("Cname Access Only: ", domain.GetCnameAccessOnly())
-	if domain.GetCnameAccessOnly() {
-		Cnames := domain.GetCnames()
-		tbl.AddRow("Cnames: ")
-		for _, cname := range Cnames {
-			tbl.AddRow("	", cname)
-		}
-	}
-	tbl.AddRow("Application ID: ", domain.GetEdgeApplicationId())
-	tbl.AddRow("Digital Certificate ID: ", domain.GetDigitalCertificateId())
-
-	return tbl.GetByteFormat(), nil
+var columns = []printer.Column{
+	{Header: "ID", Path: "id"},
+	{Header: "Name", Path: "name"},
+	{Header: "Domain", Path: "domain_name"},
+	{Header: "Cname Access Only", Path: "cname_access_only"},
+	{Header: "Cnames", Path: "cnames", Format: printer.Join},
+	{Header: "Application ID", Path: "edge_application_id"},
+	{Header: "Digital Certificate ID", Path: "digital_certificate_id"},
 }
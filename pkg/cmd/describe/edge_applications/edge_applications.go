@@ -2,24 +2,23 @@ package edge_applications
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"path/filepath"
 
 	"github.com/MakeNowJust/heredoc"
-	"github.com/MaxwelMazur/tablecli"
 	msg "github.com/aziontech/azion-cli/messages/describe/edge_applications"
 	api "github.com/aziontech/azion-cli/pkg/api/edge_applications"
 	"github.com/aziontech/azion-cli/pkg/cmdutil"
 	"github.com/aziontech/azion-cli/pkg/contracts"
+	"github.com/aziontech/azion-cli/pkg/printer"
 	"github.com/aziontech/azion-cli/utils"
-	"github.com/fatih/color"
 	"github.com/spf13/cobra"
 )
 
 func NewCmd(f *cmdutil.Factory) *cobra.Command {
 	var applicationID string
 	opts := &contracts.DescribeOptions{}
+	output := &printer.Options{}
 	cmd := &cobra.Command{
 		Use:           msg.Usage,
 		Short:         msg.ShortDescription,
@@ -32,6 +31,9 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
         $ azion describe edge-application --application-id 1337 --format json
         `),
 		RunE: func(cmd *cobra.Command, _ []string) error {
+			if err := output.Validate(); err != nil {
+				return err
+			}
 
 			if !cmd.Flags().Changed("application-id") {
 				answer, err := utils.AskInput(msg.AskInputApplicationID)
@@ -51,64 +53,49 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 			}
 
 			out := f.IOStreams.Out
-			formattedApp, err := format(cmd, application)
-			if err != nil {
-				return utils.ErrorFormatOut
-			}
-
 			if cmd.Flags().Changed("out") {
-				err := cmdutil.WriteDetailsToFile(formattedApp, opts.OutPath, out)
+				formatted, err := printer.Marshal(output.Format, application)
 				if err != nil {
-					return fmt.Errorf("%s: %w", utils.ErrorWriteFile, err)
+					return utils.ErrorFormatOut
 				}
-				fmt.Fprintf(out, msg.FileWritten, filepath.Clean(opts.OutPath))
-			} else {
-				_, err := out.Write(formattedApp[:])
+
+				err = cmdutil.WriteDetailsToFile(formatted, opts.OutPath, out)
 				if err != nil {
-					return err
+					return fmt.Errorf("%s: %w", utils.ErrorWriteFile, err)
 				}
+				fmt.Fprintf(out, msg.FileWritten, filepath.Clean(opts.OutPath))
+				return nil
 			}
 
-			return nil
+			return printer.PrintItem(out, output, columns, application)
 		},
 	}
 
 	cmd.Flags().StringVar(&applicationID, "application-id", "", msg.FlagId)
 	cmd.Flags().StringVar(&opts.OutPath, "out", "", msg.FlagOut)
-	cmd.Flags().StringVar(&opts.Format, "format", "", msg.FlagFormat)
+	cmdutil.AddOutputFlags(f, cmd, output)
 	cmd.Flags().BoolP("help", "h", false, msg.HelpFlag)
+	cmdutil.MarkPrompted(cmd, "application-id")
 
 	return cmd
 }
 
-func format(cmd *cobra.Command, application api.EdgeApplicationResponse) ([]byte, error) {
-	format, err := cmd.Flags().GetString("format")
-	if err != nil {
-		return nil, err
-	}
-
-	if format == "json" || cmd.Flags().Changed("out") {
-		return json.MarshalIndent(application, "", " ")
-	}
-
-	tbl := tablecli.New("", "")
-	tbl.WithFirstColumnFormatter(color.New(color.FgGreen).SprintfFunc())
-	tbl.AddRow("ID: ", application.GetId())
-	tbl.AddRow("Name: ", application.GetName())
-	tbl.AddRow("Active: ", application.GetActive())
-	tbl.AddRow("Application Acceleration: ", application.GetApplicationAcceleration())
-	tbl.AddRow("Caching: ", application.GetCaching())
-	tbl.AddRow("Delivery Protocol: ", application.GetDeliveryProtocol())
-	tbl.AddRow("Device Detection: ", application.GetDeviceDetection())
-	tbl.AddRow("Edge Firewall: ", application.GetEdgeFirewall())
-	tbl.AddRow("Edge Functions: ", application.GetEdgeFunctions())
-	tbl.AddRow("Http Port: ", application.GetHttpPort())
-	tbl.AddRow("HttpsPort: ", application.GetHttpsPort())
-	tbl.AddRow("Image Optimization: ", application.GetImageOptimization())
-	tbl.AddRow("L2 Caching: ", application.GetL2Caching())
-	tbl.AddRow("Load Balancer: ", application.GetLoadBalancer())
-	tbl.AddRow("Minimum TLS Version: ", application.GetMinimumTlsVersion())
-	tbl.AddRow("Raw Logs: ", application.GetRawLogs())
-	tbl.AddRow("Web Application Firewall: ", application.GetWebApplicationFirewall())
-	return tbl.GetByteFormat(), nil
+var columns = []printer.Column{
+	{Header: "ID", Path: "id"},
+	{Header: "Name", Path: "name"},
+	{Header: "Active", Path: "active"},
+	{Header: "Application Acceleration", Path: "application_acceleration"},
+	{Header: "Caching", Path: "caching"},
+	{Header: "Delivery Protocol", Path: "delivery_protocol"},
+	{Header: "Device Detection", Path: "device_detection"},
+	{Header: "Edge Firewall", Path: "edge_firewall"},
+	{Header: "Edge Functions", Path: "edge_functions"},
+	{Header: "Http Port", Path: "http_port", Format: printer.Join},
+	{Header: "Https Port", Path: "https_port", Format: printer.Join},
+	{Header: "Image Optimization", Path: "image_optimization"},
+	{Header: "L2 Caching", Path: "l2_caching"},
+	{Header: "Load Balancer", Path: "load_balancer"},
+	{Header: "Minimum TLS Version", Path: "minimum_tls_version"},
+	{Header: "Raw Logs", Path: "raw_logs"},
+	{Header: "Web Application Firewall", Path: "web_application_firewall"},
 }
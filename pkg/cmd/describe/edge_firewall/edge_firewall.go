@@ -0,0 +1,102 @@
+package edgefirewall
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc"
+	"go.uber.org/zap"
+
+	msg "github.com/aziontech/azion-cli/messages/edge_firewall"
+	api "github.com/aziontech/azion-cli/pkg/api/edge_firewall"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/contracts"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/printer"
+	"github.com/aziontech/azion-cli/utils"
+	"github.com/spf13/cobra"
+)
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	var edgeFirewallID int64
+	opts := &contracts.DescribeOptions{}
+	output := &printer.Options{}
+
+	cmd := &cobra.Command{
+		Use:           msg.Usage,
+		Short:         msg.DescribeShortDescription,
+		Long:          msg.DescribeLongDescription,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Example: heredoc.Doc(`
+		$ azion describe edge-firewall --edge-firewall-id 1337
+		$ azion describe edge-firewall --edge-firewall-id 1337 --format json
+		$ azion describe edge-firewall --edge-firewall-id 1337 --out "./firewall.json"
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := output.Validate(); err != nil {
+				return err
+			}
+
+			if !cmd.Flags().Changed("edge-firewall-id") {
+				answer, err := utils.AskInput(msg.AskEdgeFirewallID)
+				if err != nil {
+					return err
+				}
+
+				id, err := strconv.ParseInt(answer, 10, 64)
+				if err != nil {
+					logger.Debug("Error while converting answer to int64", zap.Error(err))
+					return msg.ErrorConvertID
+				}
+				edgeFirewallID = id
+			}
+
+			client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
+			edgeFirewall, err := client.Get(context.Background(), edgeFirewallID)
+			if err != nil {
+				return fmt.Errorf(msg.ErrorGet.Error(), err)
+			}
+
+			out := f.IOStreams.Out
+			if cmd.Flags().Changed("out") {
+				formatted, err := printer.Marshal(output.Format, edgeFirewall)
+				if err != nil {
+					return utils.ErrorFormatOut
+				}
+
+				err = cmdutil.WriteDetailsToFile(formatted, opts.OutPath, out)
+				if err != nil {
+					return fmt.Errorf("%s: %w", utils.ErrorWriteFile, err)
+				}
+				fmt.Fprintf(out, msg.FileWritten, filepath.Clean(opts.OutPath))
+				return nil
+			}
+
+			return printer.PrintItem(out, output, columns, edgeFirewall)
+		},
+	}
+
+	cmd.Flags().Int64Var(&edgeFirewallID, "edge-firewall-id", 0, msg.FlagEdgeFirewallID)
+	cmd.Flags().StringVar(&opts.OutPath, "out", "", msg.DescribeFlagOut)
+	cmdutil.AddOutputFlags(f, cmd, output)
+	cmd.Flags().BoolP("help", "h", false, msg.DescribeHelpFlag)
+	cmdutil.MarkPrompted(cmd, "edge-firewall-id")
+
+	return cmd
+}
+
+var columns = []printer.Column{
+	{Header: "ID", Path: "id"},
+	{Header: "Name", Path: "name"},
+	{Header: "Active", Path: "is_active"},
+	{Header: "Domains", Path: "domains", Format: printer.Join},
+	{Header: "Edge Functions Enabled", Path: "edge_functions_enabled"},
+	{Header: "Network Protection Enabled", Path: "network_protection_enabled"},
+	{Header: "WAF Enabled", Path: "waf_enabled"},
+	{Header: "Debug Rules", Path: "debug_rules"},
+	{Header: "Last Editor", Path: "last_editor"},
+	{Header: "Last Modified", Path: "last_modified"},
+}
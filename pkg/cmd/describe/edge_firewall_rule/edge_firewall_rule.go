@@ -0,0 +1,116 @@
+package edgefirewallrule
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc"
+	"go.uber.org/zap"
+
+	msg "github.com/aziontech/azion-cli/messages/edge_firewall_rule"
+	api "github.com/aziontech/azion-cli/pkg/api/edge_firewall"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/contracts"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/printer"
+	"github.com/aziontech/azion-cli/utils"
+	"github.com/spf13/cobra"
+)
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	var edgeFirewallID, ruleID int64
+	opts := &contracts.DescribeOptions{}
+	output := &printer.Options{}
+
+	cmd := &cobra.Command{
+		Use:           msg.Usage,
+		Short:         msg.DescribeShortDescription,
+		Long:          msg.DescribeLongDescription,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Example: heredoc.Doc(`
+		$ azion describe edge-firewall-rule --edge-firewall-id 1337 --rule-id 42
+		$ azion describe edge-firewall-rule --edge-firewall-id 1337 --rule-id 42 --format json
+		$ azion describe edge-firewall-rule --edge-firewall-id 1337 --rule-id 42 --out "./rule.json"
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := output.Validate(); err != nil {
+				return err
+			}
+
+			if !cmd.Flags().Changed("edge-firewall-id") {
+				answer, err := utils.AskInput(msg.AskEdgeFirewallID)
+				if err != nil {
+					return err
+				}
+
+				id, err := strconv.ParseInt(answer, 10, 64)
+				if err != nil {
+					logger.Debug("Error while converting answer to int64", zap.Error(err))
+					return msg.ErrorConvertEdgeFirewallID
+				}
+				edgeFirewallID = id
+			}
+
+			if !cmd.Flags().Changed("rule-id") {
+				answer, err := utils.AskInput(msg.AskRuleID)
+				if err != nil {
+					return err
+				}
+
+				id, err := strconv.ParseInt(answer, 10, 64)
+				if err != nil {
+					logger.Debug("Error while converting answer to int64", zap.Error(err))
+					return msg.ErrorConvertRuleID
+				}
+				ruleID = id
+			}
+
+			client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
+			rule, err := client.GetRule(context.Background(), edgeFirewallID, ruleID)
+			if err != nil {
+				return fmt.Errorf(msg.ErrorGet.Error(), err)
+			}
+
+			out := f.IOStreams.Out
+			if cmd.Flags().Changed("out") {
+				formatted, err := printer.Marshal(output.Format, rule)
+				if err != nil {
+					return utils.ErrorFormatOut
+				}
+
+				err = cmdutil.WriteDetailsToFile(formatted, opts.OutPath, out)
+				if err != nil {
+					return fmt.Errorf("%s: %w", utils.ErrorWriteFile, err)
+				}
+				fmt.Fprintf(out, msg.FileWritten, filepath.Clean(opts.OutPath))
+				return nil
+			}
+
+			return printer.PrintItem(out, output, columns, rule)
+		},
+	}
+
+	cmd.Flags().Int64Var(&edgeFirewallID, "edge-firewall-id", 0, msg.FlagEdgeFirewallID)
+	cmd.Flags().Int64Var(&ruleID, "rule-id", 0, msg.FlagRuleID)
+	cmd.Flags().StringVar(&opts.OutPath, "out", "", msg.DescribeFlagOut)
+	cmdutil.AddOutputFlags(f, cmd, output)
+	cmd.Flags().BoolP("help", "h", false, msg.DescribeHelpFlag)
+	cmdutil.MarkPrompted(cmd, "edge-firewall-id", "rule-id")
+
+	return cmd
+}
+
+var columns = []printer.Column{
+	{Header: "ID", Path: "id"},
+	{Header: "Name", Path: "name"},
+	{Header: "Description", Path: "description"},
+	{Header: "Active", Path: "is_active"},
+	{Header: "Order", Path: "order"},
+	{Header: "Criteria", Path: "criteria"},
+	{Header: "Behaviors", Path: "behaviors"},
+	{Header: "Last Editor", Path: "last_editor"},
+	{Header: "Last Modified", Path: "last_modified"},
+}
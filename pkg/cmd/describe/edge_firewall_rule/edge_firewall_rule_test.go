@@ -0,0 +1,79 @@
+package edgefirewallrule
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/httpmock"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestDescribe(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+	t.Run("describe edge firewall rule", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("GET", "edge_firewall/1337/rules_engine/42"),
+			httpmock.JSONFromFile("./fixtures/rule.json"),
+		)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--edge-firewall-id", "1337", "--rule-id", "42"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		assert.Contains(t, stdout.String(), "block bad bots")
+		assert.Contains(t, stdout.String(), "header_user_agent")
+		assert.Contains(t, stdout.String(), "dev@example.com")
+	})
+
+	t.Run("describe edge firewall rule as json", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("GET", "edge_firewall/1337/rules_engine/42"),
+			httpmock.JSONFromFile("./fixtures/rule.json"),
+		)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmdutil.AddFormatFlag(cmd, f)
+		cmd.SetArgs([]string{"--edge-firewall-id", "1337", "--rule-id", "42", "--format", "json"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+
+		// the criteria and behaviors are kept as the API returned them
+		var rule map[string]interface{}
+		require.NoError(t, json.Unmarshal(stdout.Bytes(), &rule))
+		assert.Equal(t, float64(42), rule["id"])
+		assert.JSONEq(t, `[[{"variable": "header_user_agent", "operator": "matches", "conditional": "if", "argument": "badbot"}]]`, mustJSON(t, rule["criteria"]))
+		assert.JSONEq(t, `[{"name": "run_function", "argument": 8125}]`, mustJSON(t, rule["behaviors"]))
+	})
+
+	t.Run("describe edge firewall rule that is not found", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("GET", "edge_firewall/1337/rules_engine/42"),
+			httpmock.StatusStringResponse(404, "Not Found"),
+		)
+
+		f, _, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--edge-firewall-id", "1337", "--rule-id", "42"})
+
+		_, err := cmd.ExecuteC()
+		require.Error(t, err)
+	})
+}
+
+func mustJSON(t *testing.T, value interface{}) string {
+	out, err := json.Marshal(value)
+	require.NoError(t, err)
+	return string(out)
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/aziontech/azion-cli/pkg/cmdutil"
 	"github.com/aziontech/azion-cli/pkg/contracts"
 	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/printer"
 	"github.com/aziontech/azion-cli/utils"
 	"github.com/spf13/cobra"
 	"go.uber.org/zap"
@@ -22,6 +23,7 @@ import (
 func NewCmd(f *cmdutil.Factory) *cobra.Command {
 	var function_id int64
 	opts := &contracts.DescribeOptions{}
+	output := &printer.Options{}
 	cmd := &cobra.Command{
 		Use:           msg.Usage,
 		Short:         msg.DescribeShortDescription,
@@ -35,6 +37,10 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
         $ azion describe edge-function --function-id 1337 --format json
         `),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := output.Validate(); err != nil {
+				return err
+			}
+
 			if !cmd.Flags().Changed("function-id") {
 				answer, err := utils.AskInput(msg.AskEdgeFunctionID)
 				if err != nil {
@@ -59,33 +65,35 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 			}
 
 			out := f.IOStreams.Out
-			formattedFuction, err := format(cmd, function)
-			if err != nil {
-				return utils.ErrorFormatOut
-			}
-
 			if cmd.Flags().Changed("out") {
-				err := cmdutil.WriteDetailsToFile(formattedFuction, opts.OutPath, out)
+				formatted, err := printer.Marshal(output.Format, function)
 				if err != nil {
-					return fmt.Errorf("%s: %w", utils.ErrorWriteFile, err)
+					return utils.ErrorFormatOut
 				}
-				fmt.Fprintf(out, msg.FileWritten, filepath.Clean(opts.OutPath))
-			} else {
-				_, err := out.Write(formattedFuction[:])
+
+				err = cmdutil.WriteDetailsToFile(formatted, opts.OutPath, out)
 				if err != nil {
-					return err
+					return fmt.Errorf("%s: %w", utils.ErrorWriteFile, err)
 				}
+				fmt.Fprintf(out, msg.FileWritten, filepath.Clean(opts.OutPath))
+				return nil
+			}
+
+			if !output.IsDefault() {
+				return printer.PrintItem(out, output, columns, function)
 			}
 
-			return nil
+			_, err = out.Write(format(cmd, function))
+			return err
 		},
 	}
 
 	cmd.Flags().Int64Var(&function_id, "function-id", 0, msg.FlagID)
 	cmd.Flags().Bool("with-code", false, msg.DescribeFlagWithCode)
 	cmd.Flags().StringVar(&opts.OutPath, "out", "", msg.DescribeFlagOut)
-	cmd.Flags().StringVar(&opts.Format, "format", "", msg.DescribeFlagFormat)
+	cmdutil.AddOutputFlags(f, cmd, output)
 	cmd.Flags().BoolP("help", "h", false, msg.DescribeHelpFlag)
+	cmdutil.MarkPrompted(cmd, "function-id")
 
 	return cmd
 }
@@ -96,35 +104,35 @@ func serializeToJson(data interface{}) string {
 	return string(serialized)
 }
 
-func format(cmd *cobra.Command, function api.EdgeFunctionResponse) ([]byte, error) {
-	var b bytes.Buffer
+var columns = []printer.Column{
+	{Header: "ID", Path: "id"},
+	{Header: "Name", Path: "name"},
+	{Header: "Active", Path: "active"},
+	{Header: "Language", Path: "language"},
+	{Header: "Reference Count", Path: "reference_count"},
+	{Header: "Modified at", Path: "modified"},
+	{Header: "Initiator Type", Path: "initiator_type"},
+	{Header: "Last Editor", Path: "last_editor"},
+	{Header: "Function to run", Path: "function_to_run"},
+	{Header: "JSON Args", Path: "json_args"},
+	{Header: "Code", Path: "code"},
+}
 
-	format, err := cmd.Flags().GetString("format")
-	if err != nil {
-		return nil, err
+func format(cmd *cobra.Command, function api.EdgeFunctionResponse) []byte {
+	var b bytes.Buffer
+	b.Write([]byte(fmt.Sprintf("ID: %d\n", uint64(function.GetId()))))
+	b.Write([]byte(fmt.Sprintf("Name: %s\n", function.GetName())))
+	b.Write([]byte(fmt.Sprintf("Active: %t\n", function.GetActive())))
+	b.Write([]byte(fmt.Sprintf("Language: %s\n", function.GetLanguage())))
+	b.Write([]byte(fmt.Sprintf("Reference Count: %d\n", uint64(function.GetReferenceCount()))))
+	b.Write([]byte(fmt.Sprintf("Modified at: %s\n", function.GetModified())))
+	b.Write([]byte(fmt.Sprintf("Initiator Type: %s\n", function.GetInitiatorType())))
+	b.Write([]byte(fmt.Sprintf("Last Editor: %s\n", function.GetLastEditor())))
+	b.Write([]byte(fmt.Sprintf("Function to run: %s\n", function.GetFunctionToRun())))
+	b.Write([]byte(fmt.Sprintf("JSON Args: %s\n", serializeToJson(function.GetJsonArgs()))))
+	if cmd.Flags().Changed("with-code") {
+		b.Write([]byte(fmt.Sprintf("Code:\n%s\n", function.GetCode())))
 	}
 
-	if format == "json" || cmd.Flags().Changed("out") {
-		file, err := json.MarshalIndent(function, "", " ")
-		if err != nil {
-			return nil, err
-		}
-		return file, nil
-	} else {
-		b.Write([]byte(fmt.Sprintf("ID: %d\n", uint64(function.GetId()))))
-		b.Write([]byte(fmt.Sprintf("Name: %s\n", function.GetName())))
-		b.Write([]byte(fmt.Sprintf("Active: %t\n", function.GetActive())))
-		b.Write([]byte(fmt.Sprintf("Language: %s\n", function.GetLanguage())))
-		b.Write([]byte(fmt.Sprintf("Reference Count: %d\n", uint64(function.GetReferenceCount()))))
-		b.Write([]byte(fmt.Sprintf("Modified at: %s\n", function.GetModified())))
-		b.Write([]byte(fmt.Sprintf("Initiator Type: %s\n", function.GetInitiatorType())))
-		b.Write([]byte(fmt.Sprintf("Last Editor: %s\n", function.GetLastEditor())))
-		b.Write([]byte(fmt.Sprintf("Function to run: %s\n", function.GetFunctionToRun())))
-		b.Write([]byte(fmt.Sprintf("JSON Args: %s\n", serializeToJson(function.GetJsonArgs()))))
-		if cmd.Flags().Changed("with-code") {
-			b.Write([]byte(fmt.Sprintf("Code:\n%s\n", function.GetCode())))
-		}
-
-		return b.Bytes(), nil
-	}
+	return b.Bytes()
 }
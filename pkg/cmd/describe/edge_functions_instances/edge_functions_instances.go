@@ -1,20 +1,17 @@
-package describe
+package edgefunctionsinstances
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"path/filepath"
 
-	"github.com/fatih/color"
-
 	"github.com/MakeNowJust/heredoc"
-	"github.com/MaxwelMazur/tablecli"
 	msg "github.com/aziontech/azion-cli/messages/edge_functions_instances"
 
 	api "github.com/aziontech/azion-cli/pkg/api/edge_applications"
 	"github.com/aziontech/azion-cli/pkg/cmdutil"
 	"github.com/aziontech/azion-cli/pkg/contracts"
+	"github.com/aziontech/azion-cli/pkg/printer"
 	"github.com/aziontech/azion-cli/utils"
 	"github.com/spf13/cobra"
 )
@@ -26,6 +23,7 @@ var (
 
 func NewCmd(f *cmdutil.Factory) *cobra.Command {
 	opts := &contracts.DescribeOptions{}
+	output := &printer.Options{}
 	cmd := &cobra.Command{
 		Use:           msg.EdgeFuncInstanceDescribeUsage,
 		Short:         msg.EdgeFuncInstanceDescribeShortDescription,
@@ -33,11 +31,15 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		SilenceUsage:  true,
 		SilenceErrors: true,
 		Example: heredoc.Doc(`
-      $ azion edge_functions_instances describe --application-id 1674767911 --instance-id 31223
-      $ azion edge_functions_instances describe --application-id 1674767911 --instance-id 31223 --format json
-      $ azion edge_functions_instances describe --application-id 1674767911 --instance-id 31223 --out "./tmp/test.json"
-    `),
+		$ azion describe edge-function-instance --application-id 1674767911 --instance-id 31223
+		$ azion describe edge-function-instance --application-id 1674767911 --instance-id 31223 --format json
+		$ azion describe edge-function-instance --application-id 1674767911 --instance-id 31223 --out "./tmp/test.json"
+		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := output.Validate(); err != nil {
+				return err
+			}
+
 			if !cmd.Flags().Changed("application-id") || !cmd.Flags().Changed("instance-id") {
 				return msg.ErrorMandatoryFlags
 			}
@@ -50,52 +52,36 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 			}
 
 			out := f.IOStreams.Out
-			formattedFuction, err := format(cmd, instance)
-			if err != nil {
-				return utils.ErrorFormatOut
-			}
-
 			if cmd.Flags().Changed("out") {
-				err := cmdutil.WriteDetailsToFile(formattedFuction, opts.OutPath, out)
+				formatted, err := printer.Marshal(output.Format, instance)
 				if err != nil {
-					return fmt.Errorf("%s: %w", utils.ErrorWriteFile, err)
+					return utils.ErrorFormatOut
 				}
-				fmt.Fprintf(out, msg.EdgeFuncInstanceFileWritten, filepath.Clean(opts.OutPath))
-			} else {
-				_, err := out.Write(formattedFuction[:])
+
+				err = cmdutil.WriteDetailsToFile(formatted, opts.OutPath, out)
 				if err != nil {
-					return err
+					return fmt.Errorf("%s: %w", utils.ErrorWriteFile, err)
 				}
+				fmt.Fprintf(out, msg.EdgeFuncInstanceFileWritten, filepath.Clean(opts.OutPath))
+				return nil
 			}
 
-			return nil
+			return printer.PrintItem(out, output, columns, instance)
 		},
 	}
 
 	cmd.Flags().Int64VarP(&applicationID, "application-id", "a", 0, msg.ApplicationFlagId)
 	cmd.Flags().Int64VarP(&instanceID, "instance-id", "i", 0, msg.EdgeFuncInstanceFlagId)
 	cmd.Flags().StringVar(&opts.OutPath, "out", "", msg.EdgeFuncInstanceDescribeFlagOut)
-	cmd.Flags().StringVar(&opts.Format, "format", "", msg.EdgeFuncInstanceDescribeFlagFormat)
+	cmdutil.AddOutputFlags(f, cmd, output)
 	cmd.Flags().BoolP("help", "h", false, msg.EdgeFuncInstanceDescribeHelpFlag)
 
 	return cmd
 }
 
-func format(cmd *cobra.Command, instance api.FunctionsInstancesResponse) ([]byte, error) {
-	format, err := cmd.Flags().GetString("format")
-	if err != nil {
-		return nil, err
-	}
-
-	if format == "json" || cmd.Flags().Changed("out") {
-		return json.MarshalIndent(instance, "", " ")
-	}
-
-	tbl := tablecli.New("", "")
-	tbl.WithFirstColumnFormatter(color.New(color.FgGreen).SprintfFunc())
-	tbl.AddRow("Edge Function Instance ID: ", instance.GetId())
-	tbl.AddRow("Instance Name: ", instance.GetName())
-	tbl.AddRow("Edge Function ID: ", instance.GetEdgeFunctionId())
-	tbl.AddRow("Args: ", instance.GetArgs())
-	return tbl.GetByteFormat(), nil
+var columns = []printer.Column{
+	{Header: "Edge Function Instance ID", Path: "id"},
+	{Header: "Instance Name", Path: "name"},
+	{Header: "Edge Function ID", Path: "edge_function_id"},
+	{Header: "Args", Path: "args"},
 }
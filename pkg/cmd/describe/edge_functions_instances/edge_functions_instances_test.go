@@ -1,4 +1,4 @@
-package describe
+package edgefunctionsinstances
 
 import (
 	"github.com/aziontech/azion-cli/pkg/logger"
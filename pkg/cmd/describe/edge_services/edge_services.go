@@ -1,17 +1,17 @@
-package describe
+package edgeservices
 
 import (
 	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
 	"path/filepath"
 
 	"github.com/MakeNowJust/heredoc"
 	msg "github.com/aziontech/azion-cli/messages/edge_services"
-	"github.com/aziontech/azion-cli/pkg/cmd/edge_services/requests"
+	api "github.com/aziontech/azion-cli/pkg/api/edge_services"
 	"github.com/aziontech/azion-cli/pkg/cmdutil"
 	"github.com/aziontech/azion-cli/pkg/contracts"
+	"github.com/aziontech/azion-cli/pkg/printer"
 	"github.com/aziontech/azion-cli/utils"
 	sdk "github.com/aziontech/azionapi-go-sdk/edgeservices"
 	"github.com/spf13/cobra"
@@ -20,6 +20,7 @@ import (
 func NewCmd(f *cmdutil.Factory) *cobra.Command {
 	var service_id int64
 	opts := &contracts.DescribeOptions{}
+	output := &printer.Options{}
 	// describeCmd represents the describe command
 	describeCmd := &cobra.Command{
 		Use:           msg.EdgeServiceDescribeUsage,
@@ -28,19 +29,20 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		SilenceUsage:  true,
 		SilenceErrors: true,
 		Example: heredoc.Doc(`
-		$ azion edge_services describe --service-id 4312
-		$ azion edge_services describe --service-id 1337 --with-variables
-		$ azion edge_services describe --service-id 1337 --format json
-        `),
+		$ azion describe edge-service --service-id 4312
+		$ azion describe edge-service --service-id 1337 --with-variables
+		$ azion describe edge-service --service-id 1337 --format json
+		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := output.Validate(); err != nil {
+				return err
+			}
+
 			if !cmd.Flags().Changed("service-id") {
 				return msg.ErrorMissingServiceIdArgument
 			}
 
-			client, err := requests.CreateClient(f)
-			if err != nil {
-				return err
-			}
+			client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
 
 			withVariables, err := cmd.Flags().GetBool("with-variables")
 			if err != nil {
@@ -53,32 +55,32 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 			}
 
 			out := f.IOStreams.Out
-			formattedService, err := format(cmd, service)
-			if err != nil {
-				return utils.ErrorFormatOut
-			}
-
 			if cmd.Flags().Changed("out") {
-				err := cmdutil.WriteDetailsToFile(formattedService, opts.OutPath, out)
+				formatted, err := printer.Marshal(output.Format, service)
 				if err != nil {
-					return fmt.Errorf("%s: %w", utils.ErrorWriteFile, err)
+					return utils.ErrorFormatOut
 				}
-				fmt.Fprintf(out, msg.EdgeServiceFileWritten, filepath.Clean(opts.OutPath))
-			} else {
-				_, err := out.Write(formattedService[:])
+
+				err = cmdutil.WriteDetailsToFile(formatted, opts.OutPath, out)
 				if err != nil {
-					return err
+					return fmt.Errorf("%s: %w", utils.ErrorWriteFile, err)
 				}
+				fmt.Fprintf(out, msg.EdgeServiceFileWritten, filepath.Clean(opts.OutPath))
+				return nil
 			}
 
-			return nil
+			if !output.IsDefault() {
+				return printer.PrintItem(out, output, columns, service)
+			}
 
+			_, err = out.Write(format(service, withVariables))
+			return err
 		},
 	}
-	describeCmd.Flags().Int64VarP(&service_id, "service-id", "s", 0, msg.EdgeServiceFlagId)
+	describeCmd.Flags().Int64Var(&service_id, "service-id", 0, msg.EdgeServiceFlagId)
 	describeCmd.Flags().Bool("with-variables", false, msg.EdgeServiceDescribeFlagWithVariable)
 	describeCmd.Flags().StringVar(&opts.OutPath, "out", "", msg.EdgeServiceFlagOut)
-	describeCmd.Flags().StringVar(&opts.Format, "format", "", msg.EdgeServiceFlagFormat)
+	cmdutil.AddOutputFlags(f, describeCmd, output)
 	describeCmd.Flags().BoolP("help", "h", false, msg.EdgeServiceDescribeHelpFlag)
 
 	return describeCmd
@@ -98,35 +100,31 @@ func describeService(client *sdk.APIClient, service_id int64, withVariables bool
 	return &resp, nil
 }
 
-func format(cmd *cobra.Command, service *sdk.ServiceResponse) ([]byte, error) {
+var columns = []printer.Column{
+	{Header: "ID", Path: "id"},
+	{Header: "Name", Path: "name"},
+	{Header: "Active", Path: "active"},
+	{Header: "Updated at", Path: "updated_at"},
+	{Header: "Last Editor", Path: "last_editor"},
+	{Header: "Bound Nodes", Path: "bound_nodes"},
+	{Header: "Permissions", Path: "permissions", Format: printer.Join},
+	{Header: "Variables", Path: "variables.#.name", Format: printer.Join},
+}
 
+func format(service *sdk.ServiceResponse, withVariables bool) []byte {
 	var b bytes.Buffer
-
-	format, err := cmd.Flags().GetString("format")
-	if err != nil {
-		return nil, err
-	}
-
-	if format == "json" || cmd.Flags().Changed("out") {
-		file, err := json.MarshalIndent(service, "", " ")
-		if err != nil {
-			return nil, err
-		}
-		return file, nil
-	} else {
-		b.Write([]byte(fmt.Sprintf("ID: %d\n", uint64(service.GetId()))))
-		b.Write([]byte(fmt.Sprintf("Name: %s\n", service.GetName())))
-		b.Write([]byte(fmt.Sprintf("Active: %t\n", service.GetActive())))
-		b.Write([]byte(fmt.Sprintf("Updated at: %s\n", service.GetUpdatedAt())))
-		b.Write([]byte(fmt.Sprintf("Last Editor: %s\n", service.GetLastEditor())))
-		b.Write([]byte(fmt.Sprintf("Bound Nodes: %d\n", uint64(service.GetBoundNodes()))))
-		b.Write([]byte(fmt.Sprintf("Permissions: %s\n", service.GetPermissions())))
-		if cmd.Flags().Changed("with-variables") {
-			b.Write([]byte("Variables:\n"))
-			for _, variable := range *service.Variables {
-				b.Write([]byte(fmt.Sprintf(" Name: %s\tValue: %s\n", variable.Name, variable.Value)))
-			}
+	b.Write([]byte(fmt.Sprintf("ID: %d\n", uint64(service.GetId()))))
+	b.Write([]byte(fmt.Sprintf("Name: %s\n", service.GetName())))
+	b.Write([]byte(fmt.Sprintf("Active: %t\n", service.GetActive())))
+	b.Write([]byte(fmt.Sprintf("Updated at: %s\n", service.GetUpdatedAt())))
+	b.Write([]byte(fmt.Sprintf("Last Editor: %s\n", service.GetLastEditor())))
+	b.Write([]byte(fmt.Sprintf("Bound Nodes: %d\n", uint64(service.GetBoundNodes()))))
+	b.Write([]byte(fmt.Sprintf("Permissions: %s\n", service.GetPermissions())))
+	if withVariables {
+		b.Write([]byte("Variables:\n"))
+		for _, variable := range service.GetVariables() {
+			b.Write([]byte(fmt.Sprintf(" Name: %s\tValue: %s\n", variable.Name, variable.Value)))
 		}
-		return b.Bytes(), nil
 	}
+	return b.Bytes()
 }
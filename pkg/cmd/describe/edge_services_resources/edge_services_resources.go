@@ -1,18 +1,18 @@
-package describe
+package edgeservicesresources
 
 import (
 	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
 	"path/filepath"
 
 	"github.com/MakeNowJust/heredoc"
 	msg "github.com/aziontech/azion-cli/messages/edge_services"
-	"github.com/aziontech/azion-cli/pkg/cmd/edge_services/requests"
+	api "github.com/aziontech/azion-cli/pkg/api/edge_services"
 	"github.com/aziontech/azion-cli/pkg/cmdutil"
 	"github.com/aziontech/azion-cli/pkg/contracts"
+	"github.com/aziontech/azion-cli/pkg/printer"
 	"github.com/aziontech/azion-cli/utils"
 	sdk "github.com/aziontech/azionapi-go-sdk/edgeservices"
 	"github.com/spf13/cobra"
@@ -26,6 +26,7 @@ type Fields struct {
 func NewCmd(f *cmdutil.Factory) *cobra.Command {
 	fields := &Fields{}
 	opts := &contracts.DescribeOptions{}
+	output := &printer.Options{}
 	// describeCmd represents the describe command
 	describeCmd := &cobra.Command{
 		Use:           msg.EdgeServiceResourceDescribeUsage,
@@ -34,17 +35,20 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		SilenceUsage:  true,
 		SilenceErrors: true,
 		Example: heredoc.Doc(`
-        $ azion edge_services resources describe --service-id 1234 --resource-id 80312
-        `),
+		$ azion describe edge-service-resource --service-id 1234 --resource-id 80312
+		$ azion describe edge-service-resource --service-id 1234 --resource-id 80312 --format yaml
+		$ azion describe edge-service-resource --service-id 1234 --resource-id 80312 --query content
+		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := output.Validate(); err != nil {
+				return err
+			}
+
 			if !cmd.Flags().Changed("service-id") || !cmd.Flags().Changed("resource-id") {
 				return msg.ErrorMissingResourceIdArgument
 			}
 
-			client, err := requests.CreateClient(f)
-			if err != nil {
-				return err
-			}
+			client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
 
 			resource, err := describeResource(client, f.IOStreams.Out, fields.ServiceId, fields.ResourceId)
 			if err != nil {
@@ -52,33 +56,33 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 			}
 
 			out := f.IOStreams.Out
-			formattedResource, err := format(cmd, resource)
-			if err != nil {
-				return utils.ErrorFormatOut
-			}
-
 			if cmd.Flags().Changed("out") {
-				err := cmdutil.WriteDetailsToFile(formattedResource, opts.OutPath, out)
+				formatted, err := printer.Marshal(output.Format, resource)
 				if err != nil {
-					return fmt.Errorf("%s: %w", utils.ErrorWriteFile, err)
+					return utils.ErrorFormatOut
 				}
-				fmt.Fprintf(out, msg.EdgeServiceFileWritten, filepath.Clean(opts.OutPath))
-			} else {
-				_, err := out.Write(formattedResource[:])
+
+				err = cmdutil.WriteDetailsToFile(formatted, opts.OutPath, out)
 				if err != nil {
-					return err
+					return fmt.Errorf("%s: %w", utils.ErrorWriteFile, err)
 				}
+				fmt.Fprintf(out, msg.EdgeServiceFileWritten, filepath.Clean(opts.OutPath))
+				return nil
 			}
 
-			return nil
+			if !output.IsDefault() {
+				return printer.PrintItem(out, output, columns, resource)
+			}
 
+			_, err = out.Write(format(resource))
+			return err
 		},
 	}
 
-	describeCmd.Flags().Int64VarP(&fields.ServiceId, "service-id", "s", 0, msg.EdgeServiceFlagId)
+	describeCmd.Flags().Int64Var(&fields.ServiceId, "service-id", 0, msg.EdgeServiceFlagId)
 	describeCmd.Flags().Int64VarP(&fields.ResourceId, "resource-id", "r", 0, msg.EdgeServiceResourceFlagId)
 	describeCmd.Flags().StringVar(&opts.OutPath, "out", "", msg.EdgeServiceFlagOut)
-	describeCmd.Flags().StringVar(&opts.Format, "format", "", msg.EdgeServiceFlagFormat)
+	cmdutil.AddOutputFlags(f, describeCmd, output)
 	describeCmd.Flags().BoolP("help", "h", false, msg.EdgeServiceResourceDescribeFlagHelp)
 
 	return describeCmd
@@ -99,29 +103,22 @@ func describeResource(client *sdk.APIClient, out io.Writer, service_id int64, re
 	return &resp, nil
 }
 
-func format(cmd *cobra.Command, resource *sdk.ResourceDetail) ([]byte, error) {
+var columns = []printer.Column{
+	{Header: "ID", Path: "id"},
+	{Header: "Name", Path: "name"},
+	{Header: "Trigger", Path: "trigger"},
+	{Header: "Content type", Path: "content_type"},
+	{Header: "Content", Path: "content"},
+}
 
+// format keeps the content out of the table, since resources are usually multi-line scripts
+func format(resource *sdk.ResourceDetail) []byte {
 	var b bytes.Buffer
-
-	format, err := cmd.Flags().GetString("format")
-	if err != nil {
-		return nil, err
-	}
-
-	if format == "json" || cmd.Flags().Changed("out") {
-		file, err := json.MarshalIndent(resource, "", " ")
-		if err != nil {
-			return nil, err
-		}
-		return file, nil
-	} else {
-		b.Write([]byte(fmt.Sprintf("ID: %d\n", uint64(resource.GetId()))))
-		b.Write([]byte(fmt.Sprintf("Name: %s\n", resource.GetName())))
-		b.Write([]byte(fmt.Sprintf("Trigger: %s\n", resource.GetTrigger())))
-		b.Write([]byte(fmt.Sprintf("Content type: %s\n", resource.GetContentType())))
-		b.Write([]byte("Content: \n"))
-		b.Write([]byte(resource.GetContent()))
-		return b.Bytes(), nil
-
-	}
+	b.Write([]byte(fmt.Sprintf("ID: %d\n", uint64(resource.GetId()))))
+	b.Write([]byte(fmt.Sprintf("Name: %s\n", resource.GetName())))
+	b.Write([]byte(fmt.Sprintf("Trigger: %s\n", resource.GetTrigger())))
+	b.Write([]byte(fmt.Sprintf("Content type: %s\n", resource.GetContentType())))
+	b.Write([]byte("Content: \n"))
+	b.Write([]byte(resource.GetContent()))
+	return b.Bytes()
 }
@@ -1,4 +1,4 @@
-package describe
+package edgeservicesresources
 
 import (
 	"bytes"
@@ -77,7 +77,7 @@ func TestDescribe(t *testing.T) {
 
 		cmd := NewCmd(f)
 
-		cmd.SetArgs([]string{"-s", "1234", "-r", "69420"})
+		cmd.SetArgs([]string{"--service-id", "1234", "-r", "69420"})
 		cmd.SetIn(&bytes.Buffer{})
 		cmd.SetOut(io.Discard)
 		cmd.SetErr(io.Discard)
@@ -2,24 +2,23 @@ package origin
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"path/filepath"
 	"strconv"
+	"strings"
 
 	"github.com/MakeNowJust/heredoc"
-	"github.com/fatih/color"
+	"github.com/tidwall/gjson"
 	"go.uber.org/zap"
 
-	"github.com/MaxwelMazur/tablecli"
 	msg "github.com/aziontech/azion-cli/messages/origin"
 
 	api "github.com/aziontech/azion-cli/pkg/api/origin"
 	"github.com/aziontech/azion-cli/pkg/cmdutil"
 	"github.com/aziontech/azion-cli/pkg/contracts"
 	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/printer"
 	"github.com/aziontech/azion-cli/utils"
-	sdk "github.com/aziontech/azionapi-go-sdk/edgeapplications"
 	"github.com/spf13/cobra"
 )
 
@@ -30,6 +29,7 @@ var (
 
 func NewCmd(f *cmdutil.Factory) *cobra.Command {
 	opts := &contracts.DescribeOptions{}
+	output := &printer.Options{}
 	cmd := &cobra.Command{
 		Use:           msg.Usage,
 		Short:         msg.DescribeShortDescription,
@@ -39,14 +39,19 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		Example: heredoc.Doc(`
 		$ azion describe origin --application-id 1673635839 --origin-key 0000000-00000000-00a0a00s0as0-000000
 		$ azion describe origin --application-id 1673635839 --origin-key 0000000-00000000-00a0a00s0as0-000000 --format json
+		$ azion describe origin --application-id 1673635839 --origin-key 0000000-00000000-00a0a00s0as0-000000 --query 'addresses.#.address'
 		$ azion describe origin --application-id 1673635839 --origin-key 0000000-00000000-00a0a00s0as0-000000 --out "./tmp/test.json"
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := output.Validate(); err != nil {
+				return err
+			}
+
 			if !cmd.Flags().Changed("application-id") {
 				answers, err := utils.AskInput(msg.AskAppID)
 				if err != nil {
 					logger.Debug("Error while parsing answer", zap.Error(err))
-					return utils.ErrorParseResponse
+					return err
 				}
 
 				appID, err := strconv.Atoi(answers)
@@ -62,7 +67,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 				answers, err := utils.AskInput(msg.AskOriginKey)
 				if err != nil {
 					logger.Debug("Error while parsing answer", zap.Error(err))
-					return utils.ErrorParseResponse
+					return err
 				}
 
 				originKey = answers
@@ -76,64 +81,57 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 			}
 
 			out := f.IOStreams.Out
-			formattedFuction, err := format(cmd, origin)
-			if err != nil {
-				return utils.ErrorFormatOut
-			}
-
 			if cmd.Flags().Changed("out") {
-				err := cmdutil.WriteDetailsToFile(formattedFuction, opts.OutPath, out)
+				formatted, err := printer.Marshal(output.Format, origin)
 				if err != nil {
-					return fmt.Errorf("%s: %w", utils.ErrorWriteFile, err)
+					return utils.ErrorFormatOut
 				}
-				fmt.Fprintf(out, msg.OriginsFileWritten, filepath.Clean(opts.OutPath))
-			} else {
-				_, err := out.Write(formattedFuction[:])
+
+				err = cmdutil.WriteDetailsToFile(formatted, opts.OutPath, out)
 				if err != nil {
-					return err
+					return fmt.Errorf("%s: %w", utils.ErrorWriteFile, err)
 				}
+				fmt.Fprintf(out, msg.OriginsFileWritten, filepath.Clean(opts.OutPath))
+				return nil
 			}
 
-			return nil
+			return printer.PrintItem(out, output, columns, origin)
 		},
 	}
 
 	cmd.Flags().Int64Var(&applicationID, "application-id", 0, msg.FlagEdgeApplicationID)
 	cmd.Flags().StringVar(&originKey, "origin-key", "", msg.FlagOriginKey)
 	cmd.Flags().StringVar(&opts.OutPath, "out", "", msg.DescribeFlagOut)
-	cmd.Flags().StringVar(&opts.Format, "format", "", msg.DescribeFlagFormat)
+	cmdutil.AddOutputFlags(f, cmd, output)
 	cmd.Flags().BoolP("help", "h", false, msg.DescribeHelpFlag)
+	cmdutil.MarkPrompted(cmd, "application-id", "origin-key")
 
 	return cmd
 }
 
-func format(cmd *cobra.Command, origin sdk.OriginsResultResponse) ([]byte, error) {
-	format, err := cmd.Flags().GetString("format")
-	if err != nil {
-		return nil, err
-	}
+var columns = []printer.Column{
+	{Header: "Origin ID", Path: "origin_id"},
+	{Header: "Origin Key", Path: "origin_key"},
+	{Header: "Name", Path: "name"},
+	{Header: "Origin Type", Path: "origin_type"},
+	{Header: "Addresses", Path: "addresses", Format: addresses},
+	{Header: "Origin Protocol Policy", Path: "origin_protocol_policy"},
+	{Header: "Is Origin Redirection Enable", Path: "is_origin_redirection_enabled"},
+	{Header: "Host Header", Path: "host_header"},
+	{Header: "Method", Path: "method"},
+	{Header: "Origin Path", Path: "origin_path"},
+	{Header: "Connection Timeout", Path: "connection_timeout"},
+	{Header: "Timeout Between Bytes", Path: "timeout_between_bytes"},
+	{Header: "Hmac Authentication", Path: "hmac_authentication"},
+	{Header: "Hmac Region Name", Path: "hmac_region_name"},
+	{Header: "Hmac Secret Key", Path: "hmac_secret_key"},
+	{Header: "Hmac Access Key", Path: "hmac_access_key"},
+}
 
-	if format == "json" || cmd.Flags().Changed("out") {
-		return json.MarshalIndent(origin, "", " ")
+func addresses(value gjson.Result) string {
+	var list []string
+	for _, address := range value.Array() {
+		list = append(list, address.Get("address").String())
 	}
-
-	tbl := tablecli.New("", "")
-	tbl.WithFirstColumnFormatter(color.New(color.FgGreen).SprintfFunc())
-	tbl.AddRow("Origin ID: ", origin.OriginId)
-	tbl.AddRow("Origin Key: ", origin.OriginKey)
-	tbl.AddRow("Name: ", origin.Name)
-	tbl.AddRow("Origin Type: ", origin.OriginType)
-	tbl.AddRow("Addresses: ", origin.Addresses)
-	tbl.AddRow("Origin Protocol Policy: ", origin.OriginProtocolPolicy)
-	tbl.AddRow("Is Origin Redirection Enable: ", origin.IsOriginRedirectionEnabled)
-	tbl.AddRow("Host Header: ", origin.HostHeader)
-	tbl.AddRow("Method: ", origin.Method)
-	tbl.AddRow("Origin Path: ", origin.OriginPath)
-	tbl.AddRow("Connection Timeout: ", origin.ConnectionTimeout)
-	tbl.AddRow("Timeout Between Bytes: ", origin.TimeoutBetweenBytes)
-	tbl.AddRow("Hmac Authentication: ", origin.HmacAuthentication)
-	tbl.AddRow("Hmac Region Name: ", origin.HmacRegionName)
-	tbl.AddRow("Hmac Secret Key: ", origin.HmacSecretKey)
-	tbl.AddRow("Hmac Access Key: ", origin.HmacAccessKey)
-	return tbl.GetByteFormat(), nil
+	return strings.Join(list, ", ")
 }
@@ -2,7 +2,6 @@ package rulesengine
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"path/filepath"
 	"strconv"
@@ -18,6 +17,7 @@ import (
 	"github.com/aziontech/azion-cli/pkg/cmdutil"
 	"github.com/aziontech/azion-cli/pkg/contracts"
 	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/printer"
 	"github.com/aziontech/azion-cli/utils"
 	"github.com/spf13/cobra"
 )
@@ -30,6 +30,7 @@ var (
 
 func NewCmd(f *cmdutil.Factory) *cobra.Command {
 	opts := &contracts.DescribeOptions{}
+	output := &printer.Options{}
 	cmd := &cobra.Command{
 		Use:           msg.Usage,
 		Short:         msg.ShortDescription,
@@ -42,6 +43,10 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
       $ azion describe rules-engine --application-id 1673635839 --rule-id 31223 --phase request --out "./tmp/test.json"
     `),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := output.Validate(); err != nil {
+				return err
+			}
+
 			if !cmd.Flags().Changed("rule-id") {
 
 				answer, err := utils.AskInput(msg.AskInputRulesId)
@@ -92,25 +97,26 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 			}
 
 			out := f.IOStreams.Out
-			formattedFuction, err := format(cmd, rules)
-			if err != nil {
-				return utils.ErrorFormatOut
-			}
-
 			if cmd.Flags().Changed("out") {
-				err := cmdutil.WriteDetailsToFile(formattedFuction, opts.OutPath, out)
+				formatted, err := printer.Marshal(output.Format, rules)
 				if err != nil {
-					return fmt.Errorf("%s: %w", utils.ErrorWriteFile, err)
+					return utils.ErrorFormatOut
 				}
-				fmt.Fprintf(out, msg.FileWritten, filepath.Clean(opts.OutPath))
-			} else {
-				_, err := out.Write(formattedFuction[:])
+
+				err = cmdutil.WriteDetailsToFile(formatted, opts.OutPath, out)
 				if err != nil {
-					return err
+					return fmt.Errorf("%s: %w", utils.ErrorWriteFile, err)
 				}
+				fmt.Fprintf(out, msg.FileWritten, filepath.Clean(opts.OutPath))
+				return nil
+			}
+
+			if !output.IsDefault() {
+				return printer.PrintItem(out, output, columns, rules)
 			}
 
-			return nil
+			_, err = out.Write(format(rules))
+			return err
 		},
 	}
 
@@ -118,22 +124,25 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 	cmd.Flags().Int64Var(&ruleID, "rule-id", 0, msg.FlagRuleID)
 	cmd.Flags().StringVar(&phase, "phase", "request", msg.FlagPhase)
 	cmd.Flags().StringVar(&opts.OutPath, "out", "", msg.DescribeFlagOut)
-	cmd.Flags().StringVar(&opts.Format, "format", "", msg.DescribeFlagFormat)
+	cmdutil.AddOutputFlags(f, cmd, output)
 	cmd.Flags().BoolP("help", "h", false, msg.HelpFlag)
+	cmdutil.MarkPrompted(cmd, "rule-id", "application-id", "phase")
 
 	return cmd
 }
 
-func format(cmd *cobra.Command, rules api.RulesEngineResponse) ([]byte, error) {
-	format, err := cmd.Flags().GetString("format")
-	if err != nil {
-		return nil, err
-	}
-
-	if format == "json" || cmd.Flags().Changed("out") {
-		return json.MarshalIndent(rules, "", " ")
-	}
+var columns = []printer.Column{
+	{Header: "Rules Engine ID", Path: "id"},
+	{Header: "Name", Path: "name"},
+	{Header: "Description", Path: "description"},
+	{Header: "Order", Path: "order"},
+	{Header: "Phase", Path: "phase"},
+	{Header: "Active", Path: "is_active"},
+	{Header: "Behaviors", Path: "behaviors"},
+	{Header: "Criteria", Path: "criteria"},
+}
 
+func format(rules api.RulesEngineResponse) []byte {
 	tbl := tablecli.New("", "")
 	tbl.WithFirstColumnFormatter(color.New(color.FgGreen).SprintfFunc())
 	tbl.AddRow("Rules Engine ID: ", rules.GetId())
@@ -166,5 +175,5 @@ func format(cmd *cobra.Command, rules api.RulesEngineResponse) ([]byte, error) {
 			tbl.AddRow("")
 		}
 	}
-	return tbl.GetByteFormat(), nil
+	return tbl.GetByteFormat()
 }
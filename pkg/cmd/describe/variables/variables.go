@@ -2,21 +2,19 @@ package variables
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"path/filepath"
 
-	"github.com/fatih/color"
 	"go.uber.org/zap"
 
 	"github.com/MakeNowJust/heredoc"
-	"github.com/MaxwelMazur/tablecli"
 	msg "github.com/aziontech/azion-cli/messages/variables"
 
 	api "github.com/aziontech/azion-cli/pkg/api/variables"
 	"github.com/aziontech/azion-cli/pkg/cmdutil"
 	"github.com/aziontech/azion-cli/pkg/contracts"
 	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/printer"
 	"github.com/aziontech/azion-cli/utils"
 	"github.com/spf13/cobra"
 )
@@ -24,6 +22,7 @@ import (
 func NewCmd(f *cmdutil.Factory) *cobra.Command {
 	var variableID string
 	opts := &contracts.DescribeOptions{}
+	output := &printer.Options{}
 	cmd := &cobra.Command{
 		Use:           msg.Usage,
 		Short:         msg.DescribeShortDescription,
@@ -36,12 +35,16 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
       $ azion describe variables --variable-id 7a187044-4a00-4a4a-93ed-d230900421f3 --out "./tmp/test.json" --format json
     `),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := output.Validate(); err != nil {
+				return err
+			}
+
 			if !cmd.Flags().Changed("variable-id") {
 				answers, err := utils.AskInput(msg.AskVariableID)
 
 				if err != nil {
 					logger.Debug("Error while parsing answer", zap.Error(err))
-					return utils.ErrorParseResponse
+					return err
 				}
 
 				variableID = answers
@@ -55,54 +58,39 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 			}
 
 			out := f.IOStreams.Out
-			formattedFuction, err := format(cmd, variable)
-			if err != nil {
-				return utils.ErrorFormatOut
-			}
-
 			if cmd.Flags().Changed("out") {
-				err := cmdutil.WriteDetailsToFile(formattedFuction, opts.OutPath, out)
+				formatted, err := printer.Marshal(output.Format, variable)
 				if err != nil {
-					return fmt.Errorf("%s: %w", utils.ErrorWriteFile, err)
+					return utils.ErrorFormatOut
 				}
-				logger.LogSuccess(out, fmt.Sprintf(msg.FileWritten, filepath.Clean(opts.OutPath)))
-			} else {
-				_, err := out.Write(formattedFuction[:])
+
+				err = cmdutil.WriteDetailsToFile(formatted, opts.OutPath, out)
 				if err != nil {
-					return err
+					return fmt.Errorf("%s: %w", utils.ErrorWriteFile, err)
 				}
+				logger.LogSuccess(out, fmt.Sprintf(msg.FileWritten, filepath.Clean(opts.OutPath)))
+				return nil
 			}
 
-			return nil
+			return printer.PrintItem(out, output, columns, variable)
 		},
 	}
 
 	cmd.Flags().StringVar(&variableID, "variable-id", "", msg.FlagVariableID)
-	cmd.Flags().StringVar(&opts.Format, "format", "", msg.DescribeFlagFormat)
 	cmd.Flags().StringVar(&opts.OutPath, "out", "", msg.DescribeFlagOut)
+	cmdutil.AddOutputFlags(f, cmd, output)
 	cmd.Flags().BoolP("help", "h", false, msg.DescribeHelpFlag)
+	cmdutil.MarkPrompted(cmd, "variable-id")
 
 	return cmd
 }
 
-func format(cmd *cobra.Command, variable api.Response) ([]byte, error) {
-	format, err := cmd.Flags().GetString("format")
-	if err != nil {
-		return nil, err
-	}
-
-	if format == "json" || cmd.Flags().Changed("out") {
-		return json.MarshalIndent(variable, "", " ")
-	}
-
-	tbl := tablecli.New("", "")
-	tbl.WithFirstColumnFormatter(color.New(color.FgGreen).SprintfFunc())
-	tbl.AddRow("Uuid: ", variable.GetUuid())
-	tbl.AddRow("Key: ", variable.GetKey())
-	tbl.AddRow("Value: ", variable.GetValue())
-	tbl.AddRow("Secret: ", variable.GetSecret())
-	tbl.AddRow("Last Editor: ", variable.GetLastEditor())
-	tbl.AddRow("Create At: ", variable.GetCreatedAt())
-	tbl.AddRow("Update At: ", variable.GetUpdatedAt())
-	return tbl.GetByteFormat(), nil
+var columns = []printer.Column{
+	{Header: "Uuid", Path: "uuid"},
+	{Header: "Key", Path: "key"},
+	{Header: "Value", Path: "value"},
+	{Header: "Secret", Path: "secret"},
+	{Header: "Last Editor", Path: "last_editor"},
+	{Header: "Create At", Path: "created_at"},
+	{Header: "Update At", Path: "updated_at"},
 }
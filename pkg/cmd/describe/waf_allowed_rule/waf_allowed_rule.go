@@ -0,0 +1,118 @@
+package wafallowedrule
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc"
+	"go.uber.org/zap"
+
+	msg "github.com/aziontech/azion-cli/messages/waf_allowed_rule"
+	api "github.com/aziontech/azion-cli/pkg/api/waf"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/contracts"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/printer"
+	"github.com/aziontech/azion-cli/utils"
+	"github.com/spf13/cobra"
+)
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	var wafRuleSetID, allowedRuleID int64
+	opts := &contracts.DescribeOptions{}
+	output := &printer.Options{}
+
+	cmd := &cobra.Command{
+		Use:           msg.Usage,
+		Aliases:       []string{"waf-exception"},
+		Short:         msg.DescribeShortDescription,
+		Long:          msg.DescribeLongDescription,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Example: heredoc.Doc(`
+		$ azion describe waf-allowed-rule --waf-rule-set-id 1337 --allowed-rule-id 7
+		$ azion describe waf-allowed-rule --waf-rule-set-id 1337 --allowed-rule-id 7 --format json
+		$ azion describe waf-allowed-rule --waf-rule-set-id 1337 --allowed-rule-id 7 --out "./allowed_rule.json"
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := output.Validate(); err != nil {
+				return err
+			}
+
+			if !cmd.Flags().Changed("waf-rule-set-id") {
+				answer, err := utils.AskInput(msg.AskWafRuleSetID)
+				if err != nil {
+					return err
+				}
+
+				id, err := strconv.ParseInt(answer, 10, 64)
+				if err != nil {
+					logger.Debug("Error while converting answer to int64", zap.Error(err))
+					return msg.ErrorConvertWafRuleSetID
+				}
+				wafRuleSetID = id
+			}
+
+			if !cmd.Flags().Changed("allowed-rule-id") {
+				answer, err := utils.AskInput(msg.AskAllowedRuleID)
+				if err != nil {
+					return err
+				}
+
+				id, err := strconv.ParseInt(answer, 10, 64)
+				if err != nil {
+					logger.Debug("Error while converting answer to int64", zap.Error(err))
+					return msg.ErrorConvertAllowedRuleID
+				}
+				allowedRuleID = id
+			}
+
+			client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
+			allowedRule, err := client.GetAllowedRule(context.Background(), wafRuleSetID, allowedRuleID)
+			if err != nil {
+				return fmt.Errorf(msg.ErrorGet.Error(), err)
+			}
+
+			out := f.IOStreams.Out
+			if cmd.Flags().Changed("out") {
+				formatted, err := printer.Marshal(output.Format, allowedRule)
+				if err != nil {
+					return utils.ErrorFormatOut
+				}
+
+				err = cmdutil.WriteDetailsToFile(formatted, opts.OutPath, out)
+				if err != nil {
+					return fmt.Errorf("%s: %w", utils.ErrorWriteFile, err)
+				}
+				fmt.Fprintf(out, msg.FileWritten, filepath.Clean(opts.OutPath))
+				return nil
+			}
+
+			return printer.PrintItem(out, output, columns, allowedRule)
+		},
+	}
+
+	cmd.Flags().Int64Var(&wafRuleSetID, "waf-rule-set-id", 0, msg.FlagWafRuleSetID)
+	cmd.Flags().Int64Var(&allowedRuleID, "allowed-rule-id", 0, msg.FlagAllowedRuleID)
+	cmd.Flags().StringVar(&opts.OutPath, "out", "", msg.DescribeFlagOut)
+	cmdutil.AddOutputFlags(f, cmd, output)
+	cmd.Flags().BoolP("help", "h", false, msg.DescribeHelpFlag)
+	cmdutil.MarkPrompted(cmd, "waf-rule-set-id", "allowed-rule-id")
+
+	return cmd
+}
+
+var columns = []printer.Column{
+	{Header: "ID", Path: "id"},
+	{Header: "Rule ID", Path: "rule_id"},
+	{Header: "Name", Path: "name"},
+	{Header: "Reason", Path: "reason"},
+	{Header: "Path", Path: "path"},
+	{Header: "Use Regex", Path: "use_regex"},
+	{Header: "Match Zones", Path: "match_zones"},
+	{Header: "Active", Path: "is_active"},
+	{Header: "Last Editor", Path: "last_editor"},
+	{Header: "Last Modified", Path: "last_modified"},
+}
@@ -0,0 +1,74 @@
+package wafallowedrule
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/httpmock"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestDescribe(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+	t.Run("describe allowed rule", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("GET", "waf/rulesets/1337/allowed_rules/7"),
+			httpmock.JSONFromFile("./fixtures/allowed_rule.json"),
+		)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--waf-rule-set-id", "1337", "--allowed-rule-id", "7"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		assert.Contains(t, stdout.String(), "allow search query")
+		assert.Contains(t, stdout.String(), "1010")
+		assert.Contains(t, stdout.String(), "query_string")
+	})
+
+	t.Run("describe allowed rule as json", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("GET", "waf/rulesets/1337/allowed_rules/7"),
+			httpmock.JSONFromFile("./fixtures/allowed_rule.json"),
+		)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmdutil.AddFormatFlag(cmd, f)
+		cmd.SetArgs([]string{"--waf-rule-set-id", "1337", "--allowed-rule-id", "7", "--format", "json"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+
+		var rule struct {
+			Id         int64           `json:"id"`
+			MatchZones json.RawMessage `json:"match_zones"`
+		}
+		require.NoError(t, json.Unmarshal(stdout.Bytes(), &rule))
+		assert.Equal(t, int64(7), rule.Id)
+		assert.JSONEq(t, `[{"zone": "query_string", "matches_on": "value", "zone_input": null}]`, string(rule.MatchZones))
+	})
+
+	t.Run("describe allowed rule that is not found", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("GET", "waf/rulesets/1337/allowed_rules/7"),
+			httpmock.StatusStringResponse(404, "Not Found"),
+		)
+
+		f, _, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--waf-rule-set-id", "1337", "--allowed-rule-id", "7"})
+
+		_, err := cmd.ExecuteC()
+		require.Error(t, err)
+	})
+}
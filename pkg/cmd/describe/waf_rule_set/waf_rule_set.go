@@ -0,0 +1,121 @@
+package wafruleset
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/tidwall/gjson"
+	"go.uber.org/zap"
+
+	msg "github.com/aziontech/azion-cli/messages/waf_rule_set"
+	api "github.com/aziontech/azion-cli/pkg/api/waf"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/contracts"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/printer"
+	"github.com/aziontech/azion-cli/utils"
+	"github.com/spf13/cobra"
+)
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	var wafRuleSetID int64
+	opts := &contracts.DescribeOptions{}
+	output := &printer.Options{}
+
+	cmd := &cobra.Command{
+		Use:           msg.Usage,
+		Short:         msg.DescribeShortDescription,
+		Long:          msg.DescribeLongDescription,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Example: heredoc.Doc(`
+		$ azion describe waf-rule-set --waf-rule-set-id 1337
+		$ azion describe waf-rule-set --waf-rule-set-id 1337 --format json
+		$ azion describe waf-rule-set --waf-rule-set-id 1337 --out "./waf.json"
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := output.Validate(); err != nil {
+				return err
+			}
+
+			if !cmd.Flags().Changed("waf-rule-set-id") {
+				answer, err := utils.AskInput(msg.AskWafRuleSetID)
+				if err != nil {
+					return err
+				}
+
+				id, err := strconv.ParseInt(answer, 10, 64)
+				if err != nil {
+					logger.Debug("Error while converting answer to int64", zap.Error(err))
+					return msg.ErrorConvertID
+				}
+				wafRuleSetID = id
+			}
+
+			client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
+			ruleSet, err := client.Get(context.Background(), wafRuleSetID)
+			if err != nil {
+				return fmt.Errorf(msg.ErrorGet.Error(), err)
+			}
+
+			out := f.IOStreams.Out
+			if cmd.Flags().Changed("out") {
+				formatted, err := printer.Marshal(output.Format, ruleSet)
+				if err != nil {
+					return utils.ErrorFormatOut
+				}
+
+				err = cmdutil.WriteDetailsToFile(formatted, opts.OutPath, out)
+				if err != nil {
+					return fmt.Errorf("%s: %w", utils.ErrorWriteFile, err)
+				}
+				fmt.Fprintf(out, msg.FileWritten, filepath.Clean(opts.OutPath))
+				return nil
+			}
+
+			return printer.PrintItem(out, output, columns, ruleSet)
+		},
+	}
+
+	cmd.Flags().Int64Var(&wafRuleSetID, "waf-rule-set-id", 0, msg.FlagWafRuleSetID)
+	cmd.Flags().StringVar(&opts.OutPath, "out", "", msg.DescribeFlagOut)
+	cmdutil.AddOutputFlags(f, cmd, output)
+	cmd.Flags().BoolP("help", "h", false, msg.DescribeHelpFlag)
+	cmdutil.MarkPrompted(cmd, "waf-rule-set-id")
+
+	return cmd
+}
+
+var columns = []printer.Column{
+	{Header: "ID", Path: "id"},
+	{Header: "Name", Path: "name"},
+	{Header: "Mode", Path: "mode"},
+	{Header: "Active", Path: "active"},
+	threat("SQL Injection", "sql_injection"),
+	threat("Remote File Inclusion", "remote_file_inclusion"),
+	threat("Directory Traversal", "directory_traversal"),
+	threat("Cross Site Scripting", "cross_site_scripting"),
+	threat("Evading Tricks", "evading_tricks"),
+	threat("File Upload", "file_upload"),
+	threat("Unwanted Access", "unwanted_access"),
+	threat("Identified Attack", "identified_attack"),
+	{Header: "Bypass Addresses", Path: "bypass_addresses", Format: printer.Join},
+}
+
+// threat shows the sensitivity of a threat family, or off when the family isn't checked
+func threat(header, family string) printer.Column {
+	return printer.Column{
+		Header: header,
+		Path:   fmt.Sprintf("[%s,%s_sensitivity]", family, family),
+		Format: func(value gjson.Result) string {
+			settings := value.Array()
+			if len(settings) < 2 || !settings[0].Bool() {
+				return api.ThreatOff
+			}
+			return settings[1].String()
+		},
+	}
+}
@@ -0,0 +1,56 @@
+package wafruleset
+
+import (
+	"testing"
+
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/httpmock"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestDescribe(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+	t.Run("describe as json", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("GET", "waf/rulesets/1337"),
+			httpmock.JSONFromFile("./fixtures/waf_rule_set.json"),
+		)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmdutil.AddFormatFlag(cmd, f)
+		cmd.SetArgs([]string{"--waf-rule-set-id", "1337", "--format", "json"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		out := stdout.String()
+		assert.Contains(t, out, `"sql_injection_sensitivity": "high"`)
+		assert.Contains(t, out, `"bypass_addresses"`)
+	})
+
+	t.Run("describe shows off for unchecked families", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("GET", "waf/rulesets/1337"),
+			httpmock.JSONFromFile("./fixtures/waf_rule_set.json"),
+		)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmdutil.AddFormatFlag(cmd, f)
+		cmd.SetArgs([]string{"--waf-rule-set-id", "1337"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		out := stdout.String()
+		assert.Regexp(t, `SQL Injection:\s+high`, out)
+		assert.Regexp(t, `File Upload:\s+off`, out)
+		assert.Regexp(t, `Unwanted Access:\s+low`, out)
+		assert.Regexp(t, `Bypass Addresses:\s+192.0.2.10, 198.51.100.0/24`, out)
+	})
+}
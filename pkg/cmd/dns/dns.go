@@ -0,0 +1,29 @@
+package dns
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	msg "github.com/aziontech/azion-cli/messages/dns"
+	exportzone "github.com/aziontech/azion-cli/pkg/cmd/dns/export_zone"
+	importzone "github.com/aziontech/azion-cli/pkg/cmd/dns/import_zone"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   msg.Usage,
+		Short: msg.ShortDescription,
+		Long:  msg.LongDescription, Example: heredoc.Doc(`
+		$ azion dns import --zone-file db.example.com
+		$ azion dns export --zone-id 1337 --out db.example.com
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+	}
+
+	cmd.AddCommand(importzone.NewCmd(f))
+	cmd.AddCommand(exportzone.NewCmd(f))
+	cmd.Flags().BoolP("help", "h", false, msg.FlagHelp)
+	return cmd
+}
@@ -0,0 +1,109 @@
+package exportzone
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"path/filepath"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc"
+	"go.uber.org/zap"
+
+	msg "github.com/aziontech/azion-cli/messages/dns"
+	api "github.com/aziontech/azion-cli/pkg/api/dns"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/zonefile"
+	"github.com/aziontech/azion-cli/utils"
+	sdk "github.com/aziontech/azionapi-go-sdk/idns"
+	"github.com/spf13/cobra"
+)
+
+// defaultTTL is the $TTL of exported zones; Edge DNS records always have their own
+const defaultTTL = 3600
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	var zoneID int64
+	var outPath string
+
+	cmd := &cobra.Command{
+		Use:           msg.ExportUsage,
+		Short:         msg.ExportShortDescription,
+		Long:          msg.ExportLongDescription,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Example: heredoc.Doc(`
+		$ azion dns export --zone-id 1337
+		$ azion dns export --zone-id 1337 --out db.example.com
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !cmd.Flags().Changed("zone-id") {
+				answer, err := utils.AskInput(msg.AskZoneID)
+				if err != nil {
+					return err
+				}
+
+				id, err := strconv.ParseInt(answer, 10, 64)
+				if err != nil {
+					logger.Debug("Error while converting answer to int64", zap.Error(err))
+					return msg.ErrorConvertID
+				}
+				zoneID = id
+			}
+
+			ctx := context.Background()
+			client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
+			zone, err := client.GetZone(ctx, zoneID)
+			if err != nil {
+				return fmt.Errorf(msg.ErrorGetZone.Error(), err)
+			}
+			records, err := client.AllRecords(ctx, zoneID)
+			if err != nil {
+				return fmt.Errorf(msg.ErrorListRecords.Error(), err)
+			}
+
+			var content bytes.Buffer
+			fmt.Fprintf(&content, "; Edge DNS Zone %d, %s\n", zone.GetId(), zone.GetName())
+			if err := zonefile.Write(&content, zone.GetDomain(), defaultTTL, recordSets(zone, records)); err != nil {
+				return fmt.Errorf(msg.ErrorWriteZone.Error(), err)
+			}
+
+			out := f.IOStreams.Out
+			if !cmd.Flags().Changed("out") {
+				_, err := out.Write(content.Bytes())
+				return err
+			}
+
+			if err := cmdutil.WriteDetailsToFile(content.Bytes(), outPath, out); err != nil {
+				return fmt.Errorf("%s: %w", utils.ErrorWriteFile, err)
+			}
+			fmt.Fprintf(out, msg.FileWritten, filepath.Clean(outPath))
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64Var(&zoneID, "zone-id", 0, msg.FlagZoneID)
+	cmd.Flags().StringVar(&outPath, "out", "", msg.ExportFlagOut)
+	cmd.Flags().BoolP("help", "h", false, msg.ExportHelpFlag)
+	cmdutil.MarkPrompted(cmd, "zone-id")
+	return cmd
+}
+
+// recordSets turns the zone's records into record sets, starting with the nameservers of the
+// zone, which Edge DNS keeps apart from its records
+func recordSets(zone *sdk.Zone, records []sdk.RecordGet) []zonefile.RecordSet {
+	var sets []zonefile.RecordSet
+	if len(zone.Nameservers) > 0 {
+		sets = append(sets, zonefile.RecordSet{Name: zonefile.Apex, Type: "NS", Answers: zone.Nameservers})
+	}
+	for _, record := range records {
+		sets = append(sets, zonefile.RecordSet{
+			Name:    record.GetEntry(),
+			Type:    record.GetRecordType(),
+			TTL:     uint32(record.GetTtl()),
+			Answers: record.AnswersList,
+		})
+	}
+	return sets
+}
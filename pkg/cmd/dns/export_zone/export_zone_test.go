@@ -0,0 +1,69 @@
+package exportzone
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aziontech/azion-cli/pkg/httpmock"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+const zoneFile = "; Edge DNS Zone 1337, example.com\n" +
+	"$ORIGIN example.com.\n" +
+	"$TTL 3600\n" +
+	"\n" +
+	"@\t\tIN\tNS\tns1.aziondns.net.\n" +
+	"@\t\tIN\tNS\tns2.aziondns.com.\n" +
+	"@\t\tIN\tNS\tns3.aziondns.org.\n" +
+	"www\t300\tIN\tA\t192.0.2.1\n" +
+	"www\t300\tIN\tA\t192.0.2.2\n" +
+	"@\t3600\tIN\tMX\t10 mail.example.com.\n" +
+	"@\t3600\tIN\tTXT\t\"v=spf1 -all\"\n"
+
+func TestExport(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+
+	mockZone := func() *httpmock.Registry {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("GET", "intelligent_dns/1337"),
+			httpmock.JSONFromFile("./fixtures/zone.json"),
+		)
+		mock.Register(
+			httpmock.REST("GET", "intelligent_dns/1337/records"),
+			httpmock.JSONFromFile("./fixtures/records.json"),
+		)
+		return mock
+	}
+
+	t.Run("export to the standard output", func(t *testing.T) {
+		f, stdout, _ := testutils.NewFactory(mockZone())
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--zone-id", "1337"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		assert.Equal(t, zoneFile, stdout.String())
+	})
+
+	t.Run("export to a file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "db.example.com")
+
+		f, stdout, _ := testutils.NewFactory(mockZone())
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--zone-id", "1337", "--out", path})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		assert.Equal(t, "File successfully written to: "+path+"\n", stdout.String())
+
+		written, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, zoneFile, string(written))
+	})
+}
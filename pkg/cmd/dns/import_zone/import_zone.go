@@ -0,0 +1,224 @@
+package importzone
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+
+	msg "github.com/aziontech/azion-cli/messages/dns"
+	api "github.com/aziontech/azion-cli/pkg/api/dns"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/zonefile"
+	"github.com/aziontech/azion-cli/utils"
+	sdk "github.com/aziontech/azionapi-go-sdk/idns"
+	"github.com/spf13/cobra"
+)
+
+type Fields struct {
+	ZoneFile string
+	Domain   string
+	ZoneID   int64
+	TTL      uint32
+	DryRun   bool
+}
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	fields := &Fields{}
+
+	cmd := &cobra.Command{
+		Use:           msg.ImportUsage,
+		Short:         msg.ImportShortDescription,
+		Long:          msg.ImportLongDescription,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Example: heredoc.Doc(`
+		$ azion dns import --zone-file db.example.com
+		$ azion dns import --zone-file db.example.com --dry-run
+		$ azion dns import --zone-file exported.zone --domain example.com --zone-id 1337
+		$ cat db.example.com | azion dns import --zone-file - --domain example.com
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !cmd.Flags().Changed("zone-file") {
+				answer, err := utils.AskInput(msg.AskZoneFile)
+				if err != nil {
+					return err
+				}
+				fields.ZoneFile = answer
+			}
+
+			zone, err := readZone(fields, f.IOStreams.In)
+			if err != nil {
+				return err
+			}
+
+			sets := importable(f.IOStreams.Out, zone)
+			if len(sets) == 0 {
+				return msg.ErrorNoRecords
+			}
+
+			out := f.IOStreams.Out
+			if fields.DryRun {
+				for _, set := range sets {
+					logger.FInfo(out, fmt.Sprintf(msg.ImportRecord, set.Name, set.Type, strings.Join(set.Answers, ", ")))
+				}
+				logger.FInfo(out, fmt.Sprintf(msg.ImportDryRun, len(sets), zone.Origin))
+				return nil
+			}
+
+			ctx := context.Background()
+			client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
+
+			zoneID, err := findZone(ctx, out, client, fields.ZoneID, zone.Origin)
+			if err != nil {
+				return err
+			}
+
+			// records already in the zone are skipped, so that a failed import can run again
+			existing, err := client.AllRecords(ctx, zoneID)
+			if err != nil {
+				return fmt.Errorf(msg.ErrorListRecords.Error(), err)
+			}
+			exists := make(map[string]bool, len(existing))
+			for _, record := range existing {
+				exists[record.GetEntry()+" "+strings.ToUpper(record.GetRecordType())] = true
+			}
+
+			created, failed := 0, 0
+			for _, set := range sets {
+				if exists[set.Name+" "+set.Type] {
+					logger.LogWarning(out, fmt.Sprintf(msg.ImportSkippedExisting, set.Name, set.Type))
+					continue
+				}
+
+				ttl := set.TTL
+				if ttl == 0 {
+					ttl = fields.TTL
+				}
+				request := sdk.RecordPostOrPut{}
+				request.SetEntry(set.Name)
+				request.SetRecordType(set.Type)
+				request.SetAnswersList(set.Answers)
+				request.SetTtl(int32(ttl))
+				request.SetPolicy(api.PolicySimple)
+
+				if _, err := client.CreateRecord(ctx, zoneID, &request); err != nil {
+					failed++
+					logger.FInfo(out, fmt.Sprintf(msg.ImportRecordFailed, set.Name, set.Type, err))
+					continue
+				}
+				created++
+				logger.FInfo(out, fmt.Sprintf(msg.ImportRecord, set.Name, set.Type, strings.Join(set.Answers, ", ")))
+			}
+
+			if failed > 0 {
+				return fmt.Errorf(msg.ErrorImport.Error(), failed, created+failed)
+			}
+			logger.LogSuccess(out, fmt.Sprintf(msg.ImportOutputSuccess, created, zone.Origin))
+			return nil
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&fields.ZoneFile, "zone-file", "", msg.ImportFlagZoneFile)
+	flags.StringVar(&fields.Domain, "domain", "", msg.ImportFlagDomain)
+	flags.Int64Var(&fields.ZoneID, "zone-id", 0, msg.FlagZoneID)
+	flags.Uint32Var(&fields.TTL, "ttl", 3600, msg.ImportFlagTTL)
+	flags.BoolVar(&fields.DryRun, "dry-run", false, msg.ImportFlagDryRun)
+	flags.BoolP("help", "h", false, msg.ImportHelpFlag)
+	cmdutil.MarkPrompted(cmd, "zone-file")
+	return cmd
+}
+
+// readZone parses the zone file. Without the --domain flag, the zone's domain is the $ORIGIN of the
+// file or, for files without one, its name without the db. prefix that BIND setups use
+func readZone(fields *Fields, in io.Reader) (*zonefile.Zone, error) {
+	if fields.ZoneFile != "-" {
+		file, err := os.Open(fields.ZoneFile)
+		if err != nil {
+			return nil, fmt.Errorf(msg.ErrorReadZoneFile.Error(), fields.ZoneFile, err)
+		}
+		defer file.Close()
+		in = file
+	}
+	data, err := io.ReadAll(in)
+	if err != nil {
+		return nil, fmt.Errorf(msg.ErrorReadZoneFile.Error(), fields.ZoneFile, err)
+	}
+
+	zone, err := zonefile.Parse(bytes.NewReader(data), fields.Domain)
+	base := filepath.Base(fields.ZoneFile)
+	if err != nil && fields.Domain == "" && strings.HasPrefix(base, "db.") {
+		if guessed, guessErr := zonefile.Parse(bytes.NewReader(data), strings.TrimPrefix(base, "db.")); guessErr == nil {
+			return guessed, nil
+		}
+	}
+	if errors.Is(err, zonefile.ErrNoOrigin) {
+		return nil, msg.ErrorNoDomain
+	}
+	if err != nil {
+		return nil, fmt.Errorf(msg.ErrorReadZoneFile.Error(), fields.ZoneFile, err)
+	}
+	return zone, nil
+}
+
+// importable returns the record sets Edge DNS can serve, warning about the ones left out
+func importable(out io.Writer, zone *zonefile.Zone) []zonefile.RecordSet {
+	firstLine := map[string]int{}
+	for _, record := range zone.Records {
+		if _, ok := firstLine[record.Name+" "+record.Type]; !ok {
+			firstLine[record.Name+" "+record.Type] = record.Line
+		}
+	}
+
+	var sets []zonefile.RecordSet
+	for _, set := range zone.RecordSets() {
+		line := firstLine[set.Name+" "+set.Type]
+		switch {
+		case set.Type == "SOA" || (set.Type == "NS" && set.Name == zonefile.Apex):
+			logger.LogWarning(out, fmt.Sprintf(msg.ImportSkippedSOA, set.Name, set.Type, line, set.Type))
+		case !api.ValidType(set.Type):
+			logger.LogWarning(out, fmt.Sprintf(msg.ImportSkippedType, set.Name, set.Type, line, set.Type))
+		default:
+			sets = append(sets, set)
+		}
+	}
+	return sets
+}
+
+// findZone returns the ID of the zone the records are imported into, creating one for the domain
+// when the account has none
+func findZone(ctx context.Context, out io.Writer, client *api.Client, zoneID int64, domain string) (int64, error) {
+	if zoneID != 0 {
+		if _, err := client.GetZone(ctx, zoneID); err != nil {
+			return 0, fmt.Errorf(msg.ErrorGetZone.Error(), err)
+		}
+		return zoneID, nil
+	}
+
+	zone, err := client.FindZone(ctx, domain)
+	if err != nil {
+		return 0, fmt.Errorf(msg.ErrorGetZone.Error(), err)
+	}
+	if zone != nil {
+		return int64(zone.GetId()), nil
+	}
+
+	request := sdk.Zone{}
+	request.SetName(domain)
+	request.SetDomain(domain)
+	request.SetIsActive(true)
+	zone, err = client.CreateZone(ctx, &request)
+	if err != nil {
+		return 0, fmt.Errorf(msg.ErrorCreateZone.Error(), err)
+	}
+	logger.FInfo(out, fmt.Sprintf(msg.ImportZoneCreated, domain, zone.GetId()))
+	return int64(zone.GetId()), nil
+}
@@ -0,0 +1,132 @@
+package importzone
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	msg "github.com/aziontech/azion-cli/messages/dns"
+	"github.com/aziontech/azion-cli/pkg/httpmock"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestImport(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+
+	t.Run("import into a new zone named after the file", func(t *testing.T) {
+		zone, _ := os.ReadFile("./fixtures/zone.json")
+		var created []map[string]interface{}
+
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("GET", "intelligent_dns"),
+			httpmock.JSONFromFile("./fixtures/no_zones.json"),
+		)
+		mock.Register(
+			httpmock.REST("POST", "intelligent_dns"),
+			httpmock.RESTPayload(201, string(zone), func(payload map[string]interface{}) {
+				assert.Equal(t, "example.com", payload["domain"])
+			}),
+		)
+		mock.Register(
+			httpmock.REST("GET", "intelligent_dns/1337/records"),
+			httpmock.JSONFromFile("./fixtures/records.json"),
+		)
+		for i := 0; i < 4; i++ {
+			mock.Register(
+				nthRequest("intelligent_dns/1337/records", i, &created),
+				func(req *http.Request) (*http.Response, error) {
+					payload := map[string]interface{}{}
+					require.NoError(t, json.NewDecoder(req.Body).Decode(&payload))
+					created = append(created, payload)
+					return httpmock.JSONFromFile("./fixtures/record.json")(req)
+				},
+			)
+		}
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--zone-file", "./fixtures/db.example.com"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		mock.Verify(t)
+
+		require.Len(t, created, 4)
+		assert.Equal(t, map[string]interface{}{
+			"entry":        "@",
+			"record_type":  "A",
+			"answers_list": []interface{}{"192.0.2.1"},
+			"ttl":          float64(3600),
+			"policy":       "simple",
+		}, created[0])
+		assert.Equal(t, []interface{}{"www.example.com"}, created[1]["answers_list"])
+		assert.Equal(t, []interface{}{"10 mail.example.com"}, created[2]["answers_list"])
+		assert.Equal(t, []interface{}{"v=spf1 -all"}, created[3]["answers_list"])
+
+		assert.Equal(t,
+			"⚠️ Skipping @ SOA on line 3: Edge DNS answers with its own SOA records for the zone\n"+
+				"⚠️ Skipping @ NS on line 5: Edge DNS answers with its own NS records for the zone\n"+
+				"⚠️ Skipping @ HINFO on line 13: Edge DNS doesn't serve HINFO records\n"+
+				"Created Edge DNS Zone example.com with ID 1337\n"+
+				"@ A: 192.0.2.1\n"+
+				"⚠️ Skipping www A: the zone already has a record with this name and type\n"+
+				"blog CNAME: www.example.com\n"+
+				"@ MX: 10 mail.example.com\n"+
+				"@ TXT: v=spf1 -all\n"+
+				"🚀 Imported 4 records into the example.com zone\n",
+			stdout.String(),
+		)
+	})
+
+	t.Run("dry run from stdin", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		f.IOStreams.In = io.NopCloser(strings.NewReader("www 300 IN A 192.0.2.1\nwww 300 IN A 192.0.2.2\n"))
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--zone-file", "-", "--domain", "example.com", "--dry-run"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		assert.Equal(t, "www A: 192.0.2.1, 192.0.2.2\n1 records would be created in the example.com zone\n", stdout.String())
+	})
+
+	t.Run("zone file without a domain", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+
+		f, _, _ := testutils.NewFactory(mock)
+		f.IOStreams.In = io.NopCloser(strings.NewReader("example.com. 300 IN A 192.0.2.1\n"))
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--zone-file", "-"})
+
+		_, err := cmd.ExecuteC()
+		require.ErrorIs(t, err, msg.ErrorNoDomain)
+	})
+
+	t.Run("zone file with errors", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+
+		f, _, _ := testutils.NewFactory(mock)
+		f.IOStreams.In = io.NopCloser(strings.NewReader("$ORIGIN example.com.\nwww A\n"))
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--zone-file", "-"})
+
+		_, err := cmd.ExecuteC()
+		require.EqualError(t, err, "Failed to read the zone file -: line 2: the A record has no data. Check the file and try again")
+	})
+}
+
+// nthRequest matches the nth POST request to path, counting the responses so far
+func nthRequest[T any](path string, n int, responses *[]T) httpmock.Matcher {
+	return func(req *http.Request) bool {
+		return httpmock.REST("POST", path)(req) && len(*responses) == n
+	}
+}
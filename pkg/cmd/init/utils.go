@@ -14,11 +14,18 @@ import (
 	"go.uber.org/zap"
 )
 
-func shouldDevDeploy(info *InitInfo, msg string, defaultYes bool) bool {
+func shouldDevDeploy(info *InitInfo, msg string, defaultYes bool) (bool, error) {
 	return helpers.Confirm(info.GlobalFlagAll, msg, defaultYes)
 }
 
 func askForInput(msg string, defaultIn string) (string, error) {
+	if !helpers.Interactive() {
+		if defaultIn != "" {
+			return defaultIn, nil
+		}
+		return "", helpers.PromptError(msg)
+	}
+
 	var userInput string
 	prompt := &survey.Input{
 		Message: msg,
@@ -35,6 +42,9 @@ func askForInput(msg string, defaultIn string) (string, error) {
 }
 
 func (cmd *InitCmd) selectVulcanTemplates(info *InitInfo) error {
+	if !helpers.Interactive() {
+		return msg.ErrorNonInteractive
+	}
 
 	// checking if vulcan major is correct
 	vulcanVer, err := cmd.CommandRunnerOutput(cmd.F, "npm show edge-functions version", []string{})
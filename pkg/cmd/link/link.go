@@ -48,8 +48,8 @@ type LinkCmd struct {
 	GitPlainClone         func(path string, isBare bool, o *git.CloneOptions) (*git.Repository, error)
 	CommandRunner         func(f *cmdutil.Factory, comm string, envVars []string) (string, error)
 	CommandRunInteractive func(f *cmdutil.Factory, comm string) error
-	ShouldConfigure       func(info *LinkInfo) bool
-	ShouldDevDeploy       func(info *LinkInfo, msg string, defaultYes bool) bool
+	ShouldConfigure       func(info *LinkInfo) (bool, error)
+	ShouldDevDeploy       func(info *LinkInfo, msg string, defaultYes bool) (bool, error)
 	DeployCmd             func(f *cmdutil.Factory) *deploy.DeployCmd
 	DevCmd                func(f *cmdutil.Factory) *dev.DevCmd
 	F                     *cmdutil.Factory
@@ -113,6 +113,7 @@ func NewCobraCmd(link *LinkCmd, f *cmdutil.Factory) *cobra.Command {
 	cobraCmd.Flags().StringVar(&info.Preset, "preset", "", msg.EdgeApplicationsLinkFlagTemplate)
 	cobraCmd.Flags().StringVar(&info.Mode, "mode", "", msg.EdgeApplicationsLinkFlagMode)
 	cobraCmd.Flags().BoolVar(&info.Auto, "auto", false, msg.LinkFlagAuto)
+	cmdutil.MarkPrompted(cobraCmd, "preset", "mode")
 
 	return cobraCmd
 }
@@ -131,7 +132,10 @@ func (cmd *LinkCmd) run(info *LinkInfo, options *contracts.AzionApplicationOptio
 	}
 	info.PathWorkingDir = path
 
-	shouldLink := cmd.ShouldConfigure(info)
+	shouldLink, err := cmd.ShouldConfigure(info)
+	if err != nil {
+		return err
+	}
 	if !shouldLink {
 		return nil
 	}
@@ -170,10 +174,16 @@ func (cmd *LinkCmd) run(info *LinkInfo, options *contracts.AzionApplicationOptio
 		logger.FInfo(cmd.Io.Out, msg.WebAppLinkCmdSuccess)
 
 		if !info.Auto {
-			shouldDev := cmd.ShouldDevDeploy(info, "Do you want to start a local development server? (y/N)", false)
+			shouldDev, err := cmd.ShouldDevDeploy(info, "Do you want to start a local development server? (y/N)", false)
+			if err != nil {
+				return err
+			}
 
 			if shouldDev {
-				shouldDeps := cmd.ShouldDevDeploy(info, "Do you want to install project dependencies? This may be required to start local development server (y/N)", false)
+				shouldDeps, err := cmd.ShouldDevDeploy(info, "Do you want to install project dependencies? This may be required to start local development server (y/N)", false)
+				if err != nil {
+					return err
+				}
 
 				if shouldDeps {
 					answer, err := utils.GetPackageManager()
@@ -198,10 +208,16 @@ func (cmd *LinkCmd) run(info *LinkInfo, options *contracts.AzionApplicationOptio
 				logger.FInfo(cmd.Io.Out, msg.LinkDevCommand)
 			}
 
-			shouldDeploy := cmd.ShouldDevDeploy(info, "Do you want to deploy your project? (y/N)", false)
+			shouldDeploy, err := cmd.ShouldDevDeploy(info, "Do you want to deploy your project? (y/N)", false)
+			if err != nil {
+				return err
+			}
 
 			if shouldDeploy {
-				shouldYarn := cmd.ShouldDevDeploy(info, "Do you want to install project dependencies? This may be required to deploy the project (y/N)", false)
+				shouldYarn, err := cmd.ShouldDevDeploy(info, "Do you want to install project dependencies? This may be required to deploy the project (y/N)", false)
+				if err != nil {
+					return err
+				}
 
 				if shouldYarn {
 					answer, err := utils.GetPackageManager()
@@ -13,15 +13,15 @@ import (
 	"go.uber.org/zap"
 )
 
-func shouldConfigure(info *LinkInfo) bool {
+func shouldConfigure(info *LinkInfo) (bool, error) {
 	if info.Auto {
-		return true
+		return true, nil
 	}
 	msg := fmt.Sprintf("Do you want to link %s to Azion? (y/N)", info.PathWorkingDir)
 	return helpers.Confirm(info.GlobalFlagAll, msg, false)
 }
 
-func shouldDevDeploy(info *LinkInfo, msg string, defaultYes bool) bool {
+func shouldDevDeploy(info *LinkInfo, msg string, defaultYes bool) (bool, error) {
 	return helpers.Confirm(info.GlobalFlagAll, msg, defaultYes)
 }
 
@@ -32,7 +32,7 @@ func shouldFetch(cmd *LinkCmd, info *LinkInfo) (bool, error) {
 		if info.GlobalFlagAll || info.Auto {
 			shouldFetchTemplates = true
 		} else {
-			return helpers.Confirm(info.GlobalFlagAll, "This project was already configured. Do you want to override the previous configuration? (y/N)", false), nil
+			return helpers.Confirm(info.GlobalFlagAll, "This project was already configured. Do you want to override the previous configuration? (y/N)", false)
 		}
 
 		if shouldFetchTemplates {
@@ -48,6 +48,13 @@ func shouldFetch(cmd *LinkCmd, info *LinkInfo) (bool, error) {
 }
 
 func askForInput(msg string, defaultIn string) (string, error) {
+	if !helpers.Interactive() {
+		if defaultIn != "" {
+			return defaultIn, nil
+		}
+		return "", helpers.PromptError(msg)
+	}
+
 	var userInput string
 	prompt := &survey.Input{
 		Message: msg,
@@ -95,6 +102,9 @@ func (cmd *LinkCmd) selectVulcanMode(info *LinkInfo) error {
 		Message: "Choose a preset and mode:",
 		Options: listPresets,
 	}
+	if !helpers.Interactive() {
+		return helpers.PromptError(prompt.Message)
+	}
 
 	var answer, template, mode string
 	err = survey.AskOne(prompt, &answer)
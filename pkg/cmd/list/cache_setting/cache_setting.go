@@ -3,19 +3,18 @@ package cachesetting
 import (
 	"context"
 	"strconv"
-	"strings"
 
-	"github.com/fatih/color"
 	"go.uber.org/zap"
 
 	"github.com/MakeNowJust/heredoc"
-	table "github.com/MaxwelMazur/tablecli"
 	msg "github.com/aziontech/azion-cli/messages/cache_setting"
 	api "github.com/aziontech/azion-cli/pkg/api/cache_setting"
 	"github.com/aziontech/azion-cli/pkg/cmdutil"
 	"github.com/aziontech/azion-cli/pkg/contracts"
 	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/printer"
 	"github.com/aziontech/azion-cli/utils"
+	sdk "github.com/aziontech/azionapi-go-sdk/edgeapplications"
 	"github.com/spf13/cobra"
 )
 
@@ -23,6 +22,7 @@ var edgeApplicationID int64
 
 func NewCmd(f *cmdutil.Factory) *cobra.Command {
 	opts := &contracts.ListOptions{}
+	output := &printer.Options{}
 	cmd := &cobra.Command{
 		Use:           msg.Usage,
 		Short:         msg.ListShortDescription,
@@ -32,9 +32,14 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		Example: heredoc.Doc(`
 		$ azion list cache-setting --application-id 16736354321
 		$ azion list cache-setting --application-id 16736354321 --details
+		$ azion list cache-setting --application-id 16736354321 --format json
         `),
 
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := output.Validate(); err != nil {
+				return err
+			}
+
 			if !cmd.Flags().Changed("application-id") {
 				answer, err := utils.AskInput(msg.ListAskInputApplicationID)
 				if err != nil {
@@ -50,7 +55,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 				edgeApplicationID = num
 			}
 
-			if err := PrintTable(cmd, f, opts); err != nil {
+			if err := PrintTable(cmd, f, opts, output); err != nil {
 				return msg.ErrorGetCaches
 			}
 			return nil
@@ -60,12 +65,30 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 	cmdutil.AddAzionApiFlags(cmd, opts)
 	cmd.Flags().Int64Var(&edgeApplicationID, "application-id", 0, msg.FlagEdgeApplicationID)
 	cmd.Flags().BoolP("help", "h", false, msg.ListHelpFlag)
+	cmdutil.AddOutputFlags(f, cmd, output)
+	cmdutil.AddFilterFlags(cmd, output)
+	cmdutil.MarkPrompted(cmd, "application-id")
 	return cmd
 }
 
-func PrintTable(cmd *cobra.Command, f *cmdutil.Factory, opts *contracts.ListOptions) error {
+var columns = []printer.Column{
+	{Header: "ID", Path: "id"},
+	{Header: "NAME", Path: "name"},
+	{Header: "BROWSER CACHE SETTINGS", Path: "browser_cache_settings"},
+	{Header: "CDN CACHE SETTINGS", Path: "cdn_cache_settings", Details: true},
+	{Header: "CACHE BY COOKIES", Path: "cache_by_cookies", Details: true},
+	{Header: "ENABLE CACHING FOR POST", Path: "enable_caching_for_post", Details: true},
+}
+
+func PrintTable(cmd *cobra.Command, f *cmdutil.Factory, opts *contracts.ListOptions, output *printer.Options) error {
 	client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
 	ctx := context.Background()
+	var caches []sdk.ApplicationCacheResults
+
+	// lists that start past the first page are continuations, printed without the header
+	if opts.Page > 1 {
+		output.NoHeaders = true
+	}
 
 	for {
 		cache, err := client.List(ctx, opts, edgeApplicationID)
@@ -73,32 +96,7 @@ func PrintTable(cmd *cobra.Command, f *cmdutil.Factory, opts *contracts.ListOpti
 			return msg.ErrorGetCaches
 		}
 
-		tbl := table.New("ID", "NAME", "BROWSER CACHE SETTINGS")
-		tbl.WithWriter(f.IOStreams.Out)
-
-		if cmd.Flags().Changed("details") {
-			tbl = table.New("ID", "NAME", "BROWSER CACHE SETTINGS", "CDN CACHE SETTINGS", "CACHE BY COOKIES", "ENABLE CACHING FOR POST")
-		}
-
-		headerFmt := color.New(color.FgBlue, color.Underline).SprintfFunc()
-		columnFmt := color.New(color.FgGreen).SprintfFunc()
-		tbl.WithHeaderFormatter(headerFmt).WithFirstColumnFormatter(columnFmt)
-
-		for _, v := range cache.Results {
-			tbl.AddRow(v.Id, v.Name, v.BrowserCacheSettings, v.CdnCacheSettings, v.CacheByCookies, v.EnableCachingForPost)
-		}
-
-		format := strings.Repeat("%s", len(tbl.GetHeader())) + "\n"
-		tbl.CalculateWidths([]string{})
-
-		// print the header only in the first flow
-		if opts.Page == 1 {
-			logger.PrintHeader(tbl, format)
-		}
-
-		for _, row := range tbl.GetRows() {
-			logger.PrintRow(tbl, format, row)
-		}
+		caches = append(caches, cache.Results...)
 
 		if opts.Page >= cache.TotalPages {
 			break
@@ -111,5 +109,5 @@ func PrintTable(cmd *cobra.Command, f *cmdutil.Factory, opts *contracts.ListOpti
 		opts.Page++
 	}
 
-	return nil
+	return printer.PrintList(f.IOStreams.Out, output, columns, caches, cmd.Flags().Changed("details"))
 }
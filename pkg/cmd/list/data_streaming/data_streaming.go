@@ -0,0 +1,97 @@
+package datastreaming
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+
+	msg "github.com/aziontech/azion-cli/messages/data_streaming"
+	api "github.com/aziontech/azion-cli/pkg/api/data_streaming"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/contracts"
+	"github.com/aziontech/azion-cli/pkg/printer"
+	"github.com/spf13/cobra"
+)
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	opts := &contracts.ListOptions{}
+	output := &printer.Options{}
+
+	cmd := &cobra.Command{
+		Use:           msg.Usage,
+		Short:         msg.ListShortDescription,
+		Long:          msg.ListLongDescription,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Example: heredoc.Doc(`
+		$ azion list data-streaming
+		$ azion list data-streaming --details
+		$ azion list data-streaming --page 2 --page-size 10
+		$ azion list data-streaming --filter data_source=waf --format json
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := output.Validate(); err != nil {
+				return err
+			}
+
+			if err := PrintTable(cmd, f, opts, output); err != nil {
+				return fmt.Errorf(msg.ErrorList.Error(), err)
+			}
+			return nil
+		},
+	}
+
+	cmdutil.AddAzionApiFlags(cmd, opts)
+	cmd.Flags().BoolP("help", "h", false, msg.ListHelpFlag)
+	cmdutil.AddOutputFlags(f, cmd, output)
+	cmdutil.AddFilterFlags(cmd, output)
+	return cmd
+}
+
+var columns = []printer.Column{
+	{Header: "ID", Path: "id"},
+	{Header: "NAME", Path: "name", Truncate: true},
+	{Header: "DATA SOURCE", Path: "data_source"},
+	{Header: "ENDPOINT TYPE", Path: "endpoint.endpoint_type"},
+	{Header: "ACTIVE", Path: "active"},
+	{Header: "TEMPLATE ID", Path: "template_id", Details: true},
+	{Header: "ALL DOMAINS", Path: "all_domains", Details: true},
+	{Header: "SAMPLING", Path: "sampling_percentage", Details: true},
+}
+
+func PrintTable(cmd *cobra.Command, f *cmdutil.Factory, opts *contracts.ListOptions, output *printer.Options) error {
+	client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
+	ctx := context.Background()
+	var dataStreamings []api.DataStreaming
+
+	// lists that start past the first page are continuations, printed without the header
+	if opts.Page > 1 {
+		output.NoHeaders = true
+	}
+
+	for {
+		resp, err := client.List(ctx, opts)
+		if err != nil {
+			return err
+		}
+
+		// the list doesn't need the credentials of the endpoints
+		for _, dataStreaming := range resp.Results {
+			dataStreaming.Endpoint = dataStreaming.Endpoint.Masked()
+			dataStreamings = append(dataStreamings, dataStreaming)
+		}
+
+		if opts.Page >= resp.TotalPages {
+			break
+		}
+
+		if cmd.Flags().Changed("page") || cmd.Flags().Changed("page-size") {
+			break
+		}
+
+		opts.Page++
+	}
+
+	return printer.PrintList(f.IOStreams.Out, output, columns, dataStreamings, opts.Details)
+}
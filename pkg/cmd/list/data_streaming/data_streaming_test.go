@@ -0,0 +1,68 @@
+package datastreaming
+
+import (
+	"testing"
+
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/httpmock"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestList(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+
+	t.Run("list data streamings", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("GET", "data_streaming/streamings"),
+			httpmock.JSONFromFile("./fixtures/data_streamings.json"),
+		)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmdutil.AddFormatFlag(cmd, f)
+		cmd.SetArgs([]string{})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		assert.Regexp(t, `1337\s+siem\s+http\s+standard\s+true`, stdout.String())
+		assert.Regexp(t, `1338\s+waf events\s+waf\s+datadog\s+false`, stdout.String())
+	})
+
+	t.Run("list with the credentials masked", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("GET", "data_streaming/streamings"),
+			httpmock.JSONFromFile("./fixtures/data_streamings.json"),
+		)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmdutil.AddFormatFlag(cmd, f)
+		cmd.SetArgs([]string{"--format", "json"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		assert.NotContains(t, stdout.String(), "dd-secret")
+	})
+
+	t.Run("list no data streamings", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("GET", "data_streaming/streamings"),
+			httpmock.StringResponse(`{"count": 0, "total_pages": 0, "results": []}`),
+		)
+
+		f, _, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmdutil.AddFormatFlag(cmd, f)
+		cmd.SetArgs([]string{})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,64 @@
+package datastreamingdomain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+
+	msg "github.com/aziontech/azion-cli/messages/data_streaming_domain"
+	api "github.com/aziontech/azion-cli/pkg/api/data_streaming"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/printer"
+	"github.com/spf13/cobra"
+)
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	var dataStreamingID int64
+	var selected bool
+	output := &printer.Options{}
+
+	cmd := &cobra.Command{
+		Use:           msg.Usage,
+		Short:         msg.ListShortDescription,
+		Long:          msg.ListLongDescription,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Example: heredoc.Doc(`
+		$ azion list data-streaming-domain
+		$ azion list data-streaming-domain --data-streaming-id 1337
+		$ azion list data-streaming-domain --data-streaming-id 1337 --selected
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := output.Validate(); err != nil {
+				return err
+			}
+
+			if selected && dataStreamingID == 0 {
+				return msg.ErrorSelectedFlags
+			}
+
+			client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
+			domains, err := client.ListDomains(context.Background(), dataStreamingID, selected)
+			if err != nil {
+				return fmt.Errorf(msg.ErrorList.Error(), err)
+			}
+
+			// whether a domain is streamed only makes sense for a given Data Streaming
+			return printer.PrintList(f.IOStreams.Out, output, columns, domains, dataStreamingID != 0)
+		},
+	}
+
+	cmd.Flags().Int64Var(&dataStreamingID, "data-streaming-id", 0, msg.ListFlagDataStreamingID)
+	cmd.Flags().BoolVar(&selected, "selected", false, msg.ListFlagSelected)
+	cmd.Flags().BoolP("help", "h", false, msg.ListHelpFlag)
+	cmdutil.AddOutputFlags(f, cmd, output)
+	cmdutil.AddFilterFlags(cmd, output)
+	return cmd
+}
+
+var columns = []printer.Column{
+	{Header: "ID", Path: "domain_id"},
+	{Header: "NAME", Path: "name", Truncate: true},
+	{Header: "STREAMED", Path: "selected", Details: true},
+}
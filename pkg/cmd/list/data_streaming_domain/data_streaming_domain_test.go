@@ -0,0 +1,66 @@
+package datastreamingdomain
+
+import (
+	"net/http"
+	"testing"
+
+	msg "github.com/aziontech/azion-cli/messages/data_streaming_domain"
+	"github.com/aziontech/azion-cli/pkg/httpmock"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestList(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+
+	t.Run("list domains", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("GET", "data_streaming/domains"),
+			httpmock.JSONFromFile("./fixtures/domains.json"),
+		)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		assert.Regexp(t, `1234\s+shop\s*\n`, stdout.String())
+		assert.NotContains(t, stdout.String(), "STREAMED")
+	})
+
+	t.Run("list the domains of a data streaming", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			func(req *http.Request) bool {
+				return httpmock.REST("GET", "data_streaming/domains")(req) &&
+					req.URL.Query().Get("streaming_id") == "1337" &&
+					req.URL.Query().Get("selected") == "true"
+			},
+			httpmock.JSONFromFile("./fixtures/domains.json"),
+		)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--data-streaming-id", "1337", "--selected"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		assert.Regexp(t, `1234\s+shop\s+true`, stdout.String())
+	})
+
+	t.Run("selected without a data streaming", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+
+		f, _, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--selected"})
+
+		_, err := cmd.ExecuteC()
+		require.ErrorIs(t, err, msg.ErrorSelectedFlags)
+	})
+}
@@ -0,0 +1,57 @@
+package datastreamingtemplate
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+
+	msg "github.com/aziontech/azion-cli/messages/data_streaming_template"
+	"github.com/aziontech/azion-cli/messages/general"
+	api "github.com/aziontech/azion-cli/pkg/api/data_streaming"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/printer"
+	"github.com/spf13/cobra"
+)
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	var details bool
+	output := &printer.Options{}
+
+	cmd := &cobra.Command{
+		Use:           msg.Usage,
+		Short:         msg.ListShortDescription,
+		Long:          msg.ListLongDescription,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Example: heredoc.Doc(`
+		$ azion list data-streaming-template
+		$ azion list data-streaming-template --format json
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := output.Validate(); err != nil {
+				return err
+			}
+
+			client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
+			templates, err := client.ListTemplates(context.Background())
+			if err != nil {
+				return fmt.Errorf(msg.ErrorList.Error(), err)
+			}
+
+			return printer.PrintList(f.IOStreams.Out, output, columns, templates, details)
+		},
+	}
+
+	cmd.Flags().BoolVar(&details, "details", false, general.ApiListFlagDetails)
+	cmd.Flags().BoolP("help", "h", false, msg.ListHelpFlag)
+	cmdutil.AddOutputFlags(f, cmd, output)
+	cmdutil.AddFilterFlags(cmd, output)
+	return cmd
+}
+
+var columns = []printer.Column{
+	{Header: "ID", Path: "id"},
+	{Header: "NAME", Path: "name"},
+	{Header: "TEMPLATE MODEL", Path: "template_model", Truncate: true, Details: true},
+}
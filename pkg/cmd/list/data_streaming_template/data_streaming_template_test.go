@@ -0,0 +1,49 @@
+package datastreamingtemplate
+
+import (
+	"testing"
+
+	"github.com/aziontech/azion-cli/pkg/httpmock"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestList(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+
+	t.Run("list templates", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("GET", "data_streaming/templates"),
+			httpmock.JSONFromFile("./fixtures/templates.json"),
+		)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		assert.Regexp(t, `2\s+Edge Applications Event Collector`, stdout.String())
+		assert.Regexp(t, `4\s+WAF Event Collector`, stdout.String())
+		assert.NotContains(t, stdout.String(), "$request_id")
+	})
+
+	t.Run("list templates fails", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("GET", "data_streaming/templates"),
+			httpmock.StatusStringResponse(500, "Internal Server Error"),
+		)
+
+		f, _, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{})
+
+		_, err := cmd.ExecuteC()
+		require.Error(t, err)
+	})
+}
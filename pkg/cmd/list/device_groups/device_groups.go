@@ -0,0 +1,97 @@
+package devicegroups
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	msg "github.com/aziontech/azion-cli/messages/device_groups"
+	api "github.com/aziontech/azion-cli/pkg/api/edge_applications"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/contracts"
+	"github.com/aziontech/azion-cli/pkg/printer"
+	sdk "github.com/aziontech/azionapi-go-sdk/edgeapplications"
+	"github.com/spf13/cobra"
+)
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	var edgeApplicationID int64 = 0
+	opts := &contracts.ListOptions{}
+	output := &printer.Options{}
+	cmd := &cobra.Command{
+		Use:           msg.DeviceGroupsListUsage,
+		Short:         msg.DeviceGroupsListShortDescription,
+		Long:          msg.DeviceGroupsListLongDescription,
+		SilenceUsage:  true,
+		SilenceErrors: true, Example: heredoc.Doc(`
+		$ azion list device-group -a 16736354321
+		$ azion list device-group --application-id 16736354321
+		$ azion list device-group --application-id 16736354321 --details
+		$ azion list device-group --application-id 16736354321 --order-by "id"
+		$ azion list device-group --application-id 16736354321 --page 1
+		$ azion list device-group --application-id 16736354321 --page-size 5
+		$ azion list device-group --application-id 16736354321 --sort "asc"
+		$ azion list device-group --application-id 16736354321 --format json
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := output.Validate(); err != nil {
+				return err
+			}
+
+			if !cmd.Flags().Changed("application-id") {
+				return msg.ErrorMissingApplicationIDArgument
+			}
+
+			if err := PrintTable(cmd, f, opts, output, edgeApplicationID); err != nil {
+				return fmt.Errorf(msg.ErrorListDeviceGroups.Error(), err)
+			}
+			return nil
+		},
+	}
+
+	cmdutil.AddAzionApiFlags(cmd, opts)
+	flags := cmd.Flags()
+	flags.Int64VarP(&edgeApplicationID, "application-id", "a", 0, msg.DeviceGroupsListFlagEdgeApplicationID)
+	flags.BoolP("help", "h", false, msg.DeviceGroupsListHelpFlag)
+	cmdutil.AddOutputFlags(f, cmd, output)
+	cmdutil.AddFilterFlags(cmd, output)
+	return cmd
+}
+
+var columns = []printer.Column{
+	{Header: "ID", Path: "id"},
+	{Header: "NAME", Path: "name"},
+	{Header: "USER AGENT", Path: "user_agent", Details: true},
+}
+
+func PrintTable(cmd *cobra.Command, f *cmdutil.Factory, opts *contracts.ListOptions, output *printer.Options, edgeApplicationID int64) error {
+	client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
+	ctx := context.Background()
+	var groups []sdk.DeviceGroupsResultResponse
+
+	// lists that start past the first page are continuations, printed without the header
+	if opts.Page > 1 {
+		output.NoHeaders = true
+	}
+
+	for {
+		resp, err := client.DeviceGroupsList(ctx, opts, edgeApplicationID)
+		if err != nil {
+			return err
+		}
+
+		groups = append(groups, resp.Results...)
+
+		if opts.Page >= resp.TotalPages {
+			break
+		}
+
+		if cmd.Flags().Changed("page") || cmd.Flags().Changed("page-size") {
+			break
+		}
+
+		opts.Page++
+	}
+
+	return printer.PrintList(f.IOStreams.Out, output, columns, groups, opts.Details)
+}
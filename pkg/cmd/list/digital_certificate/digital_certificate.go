@@ -0,0 +1,81 @@
+package digitalcertificate
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/tidwall/gjson"
+
+	msg "github.com/aziontech/azion-cli/messages/digital_certificate"
+	general "github.com/aziontech/azion-cli/messages/general"
+	api "github.com/aziontech/azion-cli/pkg/api/digital_certificate"
+	"github.com/aziontech/azion-cli/pkg/certificate"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/contracts"
+	"github.com/aziontech/azion-cli/pkg/printer"
+	"github.com/spf13/cobra"
+)
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	opts := &contracts.ListOptions{}
+	output := &printer.Options{}
+
+	cmd := &cobra.Command{
+		Use:           msg.Usage,
+		Short:         msg.ListShortDescription,
+		Long:          msg.ListLongDescription,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Example: heredoc.Doc(`
+		$ azion list digital-certificate
+		$ azion list digital-certificate --details
+		$ azion list digital-certificate --order-by validity --sort asc
+		$ azion list digital-certificate --filter status=active --columns id,name,validity
+		`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if err := output.Validate(); err != nil {
+				return err
+			}
+
+			client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
+			certificates, err := client.List(context.Background(), opts)
+			if err != nil {
+				return fmt.Errorf(msg.ErrorList.Error(), err)
+			}
+
+			return printer.PrintList(f.IOStreams.Out, output, columns, certificates, opts.Details)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.BoolVar(&opts.Details, "details", false, general.ApiListFlagDetails)
+	flags.StringVar(&opts.OrderBy, "order-by", "", general.ApiListFlagOrderBy)
+	flags.StringVar(&opts.Sort, "sort", "", general.ApiListFlagSort)
+	flags.BoolP("help", "h", false, msg.ListHelpFlag)
+	cmdutil.AddOutputFlags(f, cmd, output)
+	cmdutil.AddFilterFlags(cmd, output)
+	return cmd
+}
+
+var columns = []printer.Column{
+	{Header: "ID", Path: "id"},
+	{Header: "NAME", Path: "name", Truncate: true},
+	{Header: "VALIDITY", Path: "validity", Format: expiry},
+	{Header: "STATUS", Path: "status"},
+	{Header: "SUBJECT NAMES", Path: "subject_name", Details: true, Format: printer.Join},
+	{Header: "ISSUER", Path: "issuer", Details: true},
+	{Header: "TYPE", Path: "certificate_type", Details: true},
+	{Header: "MANAGED", Path: "managed", Details: true},
+}
+
+func expiry(value gjson.Result) string {
+	if value.Type == gjson.Null || !value.Exists() {
+		return "-"
+	}
+	return certificate.Expiry(value.String(), now())
+}
+
+// now is replaced in tests so the days left until expiry are stable
+var now = time.Now
@@ -0,0 +1,112 @@
+package digitalcertificate
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/httpmock"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+// page matches the request for the given page of certificates
+func page(n string) httpmock.Matcher {
+	return func(req *http.Request) bool {
+		return httpmock.REST("GET", "digital_certificates")(req) && req.URL.Query().Get("page") == n
+	}
+}
+
+func TestList(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+	now = func() time.Time { return time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC) }
+
+	t.Run("list certificates with their expiry", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("GET", "digital_certificates"),
+			httpmock.JSONFromFile("./fixtures/certificates.json"),
+		)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmdutil.AddFormatFlag(cmd, f)
+		cmd.SetArgs([]string{})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		assert.Equal(t,
+			"ID    NAME                VALIDITY                                STATUS  \n"+
+				"1337  example.com         2024-09-01 12:00:00-03:00 (in 92 days)  active  \n"+
+				"1338  legacy.example.com  2024-05-01 12:00:00-03:00 (expired)     active  \n",
+			stdout.String(),
+		)
+	})
+
+	t.Run("list details as csv", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("GET", "digital_certificates"),
+			httpmock.JSONFromFile("./fixtures/certificates.json"),
+		)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmdutil.AddFormatFlag(cmd, f)
+		cmd.SetArgs([]string{"--details", "--format", "csv"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		assert.Equal(t,
+			"id,name,validity,status,subject_name,issuer,certificate_type,managed\n"+
+				"1337,example.com,2024-09-01 12:00:00-03:00 (in 92 days),active,\"example.com, *.example.com\",Example CA,edge_certificate,false\n"+
+				"1338,legacy.example.com,2024-05-01 12:00:00-03:00 (expired),active,legacy.example.com,Example CA,edge_certificate,false\n",
+			stdout.String(),
+		)
+	})
+
+	t.Run("list every page", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(page("1"), httpmock.JSONFromString(`{"count": 2, "total_pages": 2, "results": [
+			{"id": 1337, "name": "example.com", "validity": "2024-09-01 12:00:00-03:00", "status": "active"}
+		]}`))
+		mock.Register(page("2"), httpmock.JSONFromString(`{"count": 2, "total_pages": 2, "results": [
+			{"id": 1338, "name": "legacy.example.com", "validity": "2024-05-01 12:00:00-03:00", "status": "active"}
+		]}`))
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmdutil.AddFormatFlag(cmd, f)
+		cmd.SetArgs([]string{"--format", "csv"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		mock.Verify(t)
+		assert.Equal(t,
+			"id,name,validity,status\n"+
+				"1337,example.com,2024-09-01 12:00:00-03:00 (in 92 days),active\n"+
+				"1338,legacy.example.com,2024-05-01 12:00:00-03:00 (expired),active\n",
+			stdout.String(),
+		)
+	})
+
+	t.Run("list fails", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("GET", "digital_certificates"),
+			httpmock.StatusStringResponse(500, "Internal Server Error"),
+		)
+
+		f, _, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmdutil.AddFormatFlag(cmd, f)
+		cmd.SetArgs([]string{})
+
+		_, err := cmd.ExecuteC()
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,116 @@
+package dnsrecord
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc"
+	"go.uber.org/zap"
+
+	msg "github.com/aziontech/azion-cli/messages/dns_record"
+	api "github.com/aziontech/azion-cli/pkg/api/dns"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/contracts"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/printer"
+	"github.com/aziontech/azion-cli/utils"
+	sdk "github.com/aziontech/azionapi-go-sdk/idns"
+	"github.com/spf13/cobra"
+)
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	var zoneID int64
+	opts := &contracts.ListOptions{}
+	output := &printer.Options{}
+
+	cmd := &cobra.Command{
+		Use:           msg.Usage,
+		Short:         msg.ListShortDescription,
+		Long:          msg.ListLongDescription,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Example: heredoc.Doc(`
+		$ azion list dns-record --zone-id 1337
+		$ azion list dns-record --zone-id 1337 --details
+		$ azion list dns-record --zone-id 1337 --page 2 --page-size 10
+		$ azion list dns-record --zone-id 1337 --filter record_type=MX --format json
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := output.Validate(); err != nil {
+				return err
+			}
+
+			if !cmd.Flags().Changed("zone-id") {
+				answer, err := utils.AskInput(msg.AskZoneID)
+				if err != nil {
+					return err
+				}
+
+				id, err := strconv.ParseInt(answer, 10, 64)
+				if err != nil {
+					logger.Debug("Error while converting answer to int64", zap.Error(err))
+					return msg.ErrorConvertZoneID
+				}
+				zoneID = id
+			}
+
+			if err := PrintTable(cmd, f, zoneID, opts, output); err != nil {
+				return fmt.Errorf(msg.ErrorList.Error(), err)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64Var(&zoneID, "zone-id", 0, msg.FlagZoneID)
+	cmdutil.AddAzionApiFlags(cmd, opts)
+	cmd.Flags().BoolP("help", "h", false, msg.ListHelpFlag)
+	cmdutil.AddOutputFlags(f, cmd, output)
+	cmdutil.AddFilterFlags(cmd, output)
+	cmdutil.MarkPrompted(cmd, "zone-id")
+	return cmd
+}
+
+var columns = []printer.Column{
+	{Header: "ID", Path: "record_id"},
+	{Header: "ENTRY", Path: "entry", Truncate: true},
+	{Header: "TYPE", Path: "record_type"},
+	{Header: "TTL", Path: "ttl"},
+	{Header: "ANSWERS", Path: "answers_list", Format: printer.Join, Truncate: true},
+	{Header: "POLICY", Path: "policy", Details: true},
+	{Header: "WEIGHT", Path: "weight", Details: true},
+	{Header: "DESCRIPTION", Path: "description", Truncate: true, Details: true},
+}
+
+func PrintTable(cmd *cobra.Command, f *cmdutil.Factory, zoneID int64, opts *contracts.ListOptions, output *printer.Options) error {
+	client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
+	ctx := context.Background()
+	var records []sdk.RecordGet
+
+	// lists that start past the first page are continuations, printed without the header
+	if opts.Page > 1 {
+		output.NoHeaders = true
+	}
+
+	for {
+		resp, err := client.ListRecords(ctx, zoneID, opts)
+		if err != nil {
+			return err
+		}
+
+		results := resp.GetResults()
+		records = append(records, results.Records...)
+
+		if opts.Page >= int64(resp.GetTotalPages()) {
+			break
+		}
+
+		if cmd.Flags().Changed("page") || cmd.Flags().Changed("page-size") {
+			break
+		}
+
+		opts.Page++
+	}
+
+	return printer.PrintList(f.IOStreams.Out, output, columns, records, opts.Details)
+}
@@ -0,0 +1,33 @@
+package dnsrecord
+
+import (
+	"testing"
+
+	"github.com/aziontech/azion-cli/pkg/httpmock"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestList(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+	t.Run("list the records of a zone", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("GET", "intelligent_dns/1337/records"),
+			httpmock.JSONFromFile("./fixtures/records.json"),
+		)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--zone-id", "1337"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		assert.Regexp(t, `ID\s+ENTRY\s+TYPE\s+TTL\s+ANSWERS`, stdout.String())
+		assert.Regexp(t, `42\s+www\s+A\s+300\s+192.0.2.1, 192.0.2.2`, stdout.String())
+		assert.Regexp(t, `43\s+@\s+MX\s+3600\s+10 mail.example.com`, stdout.String())
+	})
+}
@@ -0,0 +1,91 @@
+package dnszone
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+
+	msg "github.com/aziontech/azion-cli/messages/dns_zone"
+	api "github.com/aziontech/azion-cli/pkg/api/dns"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/contracts"
+	"github.com/aziontech/azion-cli/pkg/printer"
+	sdk "github.com/aziontech/azionapi-go-sdk/idns"
+	"github.com/spf13/cobra"
+)
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	opts := &contracts.ListOptions{}
+	output := &printer.Options{}
+
+	cmd := &cobra.Command{
+		Use:           msg.Usage,
+		Short:         msg.ListShortDescription,
+		Long:          msg.ListLongDescription,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Example: heredoc.Doc(`
+		$ azion list dns-zone
+		$ azion list dns-zone --details
+		$ azion list dns-zone --page 2 --page-size 10
+		$ azion list dns-zone --filter is_active=true --format json
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := output.Validate(); err != nil {
+				return err
+			}
+
+			if err := PrintTable(cmd, f, opts, output); err != nil {
+				return fmt.Errorf(msg.ErrorList.Error(), err)
+			}
+			return nil
+		},
+	}
+
+	cmdutil.AddAzionApiFlags(cmd, opts)
+	cmd.Flags().BoolP("help", "h", false, msg.ListHelpFlag)
+	cmdutil.AddOutputFlags(f, cmd, output)
+	cmdutil.AddFilterFlags(cmd, output)
+	return cmd
+}
+
+var columns = []printer.Column{
+	{Header: "ID", Path: "id"},
+	{Header: "NAME", Path: "name", Truncate: true},
+	{Header: "DOMAIN", Path: "domain"},
+	{Header: "ACTIVE", Path: "is_active"},
+	{Header: "NAMESERVERS", Path: "nameservers", Format: printer.Join, Details: true},
+}
+
+func PrintTable(cmd *cobra.Command, f *cmdutil.Factory, opts *contracts.ListOptions, output *printer.Options) error {
+	client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
+	ctx := context.Background()
+	var zones []sdk.Zone
+
+	// lists that start past the first page are continuations, printed without the header
+	if opts.Page > 1 {
+		output.NoHeaders = true
+	}
+
+	for {
+		resp, err := client.ListZones(ctx, opts)
+		if err != nil {
+			return err
+		}
+
+		zones = append(zones, resp.Results...)
+
+		if opts.Page >= int64(resp.GetTotalPages()) {
+			break
+		}
+
+		if cmd.Flags().Changed("page") || cmd.Flags().Changed("page-size") {
+			break
+		}
+
+		opts.Page++
+	}
+
+	return printer.PrintList(f.IOStreams.Out, output, columns, zones, opts.Details)
+}
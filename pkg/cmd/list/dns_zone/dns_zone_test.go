@@ -0,0 +1,32 @@
+package dnszone
+
+import (
+	"testing"
+
+	"github.com/aziontech/azion-cli/pkg/httpmock"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestList(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+	t.Run("list zones", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("GET", "intelligent_dns"),
+			httpmock.JSONFromFile("./fixtures/zones.json"),
+		)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--details"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		assert.Regexp(t, `ID\s+NAME\s+DOMAIN\s+ACTIVE\s+NAMESERVERS`, stdout.String())
+		assert.Regexp(t, `1337\s+example.com\s+example.com\s+true\s+ns1.aziondns.net`, stdout.String())
+	})
+}
@@ -2,23 +2,20 @@ package domain
 
 import (
 	"context"
-	"strings"
-
-	"github.com/fatih/color"
 
 	"github.com/MakeNowJust/heredoc"
-	table "github.com/MaxwelMazur/tablecli"
 	msg "github.com/aziontech/azion-cli/messages/list/domain"
 	api "github.com/aziontech/azion-cli/pkg/api/domain"
 	"github.com/aziontech/azion-cli/pkg/cmdutil"
 	"github.com/aziontech/azion-cli/pkg/contracts"
-	"github.com/aziontech/azion-cli/pkg/logger"
-	"github.com/aziontech/azion-cli/utils"
+	"github.com/aziontech/azion-cli/pkg/printer"
+	sdk "github.com/aziontech/azionapi-go-sdk/domains"
 	"github.com/spf13/cobra"
 )
 
 func NewCmd(f *cmdutil.Factory) *cobra.Command {
 	opts := &contracts.ListOptions{}
+	output := &printer.Options{}
 	cmd := &cobra.Command{
 		Use:           msg.Usage,
 		Short:         msg.ShortDescription,
@@ -27,9 +24,13 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		SilenceErrors: true, Example: heredoc.Doc(`
 		$ azion list domain
 		$ azion list domain --details
+		$ azion list domain --columns id,domain_name --format csv
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			if err := PrintTable(cmd, f, opts); err != nil {
+			if err := output.Validate(); err != nil {
+				return err
+			}
+			if err := PrintTable(cmd, f, opts, output); err != nil {
 				return msg.ErrorGetDomains
 			}
 			return nil
@@ -38,12 +39,31 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 
 	cmdutil.AddAzionApiFlags(cmd, opts)
 	cmd.Flags().BoolP("help", "h", false, msg.HelpFlag)
+	cmdutil.AddOutputFlags(f, cmd, output)
+	cmdutil.AddFilterFlags(cmd, output)
 	return cmd
 }
 
-func PrintTable(cmd *cobra.Command, f *cmdutil.Factory, opts *contracts.ListOptions) error {
+var columns = []printer.Column{
+	{Header: "ID", Path: "id"},
+	{Header: "NAME", Path: "name", Truncate: true},
+	{Header: "EDGE DOMAIN", Path: "domain_name", Details: true},
+	{Header: "DIGITAL CERTIFICATE ID", Path: "digital_certificate_id", Details: true},
+	{Header: "EDGE APPLICATION ID", Path: "edge_application_id", Details: true},
+	{Header: "CNAME ACCESS ONLY", Path: "cname_access_only", Details: true},
+	{Header: "CNAMES", Path: "cnames", Details: true, Format: printer.Join},
+	{Header: "ACTIVE", Path: "is_active", Details: true},
+}
+
+func PrintTable(cmd *cobra.Command, f *cmdutil.Factory, opts *contracts.ListOptions, output *printer.Options) error {
 	client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
 	ctx := context.Background()
+	var domains []sdk.DomainResults
+
+	// lists that start past the first page are continuations, printed without the header
+	if opts.Page > 1 {
+		output.NoHeaders = true
+	}
 
 	for {
 		resp, err := client.List(ctx, opts)
@@ -51,41 +71,7 @@ func PrintTable(cmd *cobra.Command, f *cmdutil.Factory, opts *contracts.ListOpti
 			return err
 		}
 
-		tbl := table.New("ID", "NAME")
-		tbl.WithWriter(f.IOStreams.Out)
-
-		if opts.Details {
-			tbl = table.New("ID", "NAME", "EDGE DOMAIN", "DIGITAL CERTIFICATE ID", "EDGE APPLICATION ID", "CNAME ACCESS ONLY", "CNAMES", "ACTIVE")
-		}
-
-		headerFmt := color.New(color.FgBlue, color.Underline).SprintfFunc()
-		columnFmt := color.New(color.FgGreen).SprintfFunc()
-		tbl.WithHeaderFormatter(headerFmt).WithFirstColumnFormatter(columnFmt)
-
-		for _, v := range resp.Results {
-			tbl.AddRow(
-				v.Id,
-				utils.TruncateString(v.Name),
-				v.DomainName,
-				v.DigitalCertificateId,
-				v.EdgeApplicationId,
-				v.CnameAccessOnly,
-				v.Cnames,
-				v.IsActive,
-			)
-		}
-
-		format := strings.Repeat("%s", len(tbl.GetHeader())) + "\n"
-		tbl.CalculateWidths([]string{})
-
-		// print the header only in the first flow
-		if opts.Page == 1 {
-			logger.PrintHeader(tbl, format)
-		}
-
-		for _, row := range tbl.GetRows() {
-			logger.PrintRow(tbl, format, row)
-		}
+		domains = append(domains, resp.Results...)
 
 		if opts.Page >= resp.TotalPages {
 			break
@@ -98,5 +84,5 @@ func PrintTable(cmd *cobra.Command, f *cmdutil.Factory, opts *contracts.ListOpti
 		opts.Page++
 	}
 
-	return nil
+	return printer.PrintList(f.IOStreams.Out, output, columns, domains, opts.Details)
 }
@@ -3,25 +3,21 @@ package edge_applications
 import (
 	"context"
 	"fmt"
-	"strings"
-
-	"github.com/aziontech/azion-cli/pkg/logger"
-
-	"github.com/fatih/color"
 
 	"github.com/MakeNowJust/heredoc"
-	table "github.com/MaxwelMazur/tablecli"
 	"github.com/aziontech/azion-cli/messages/general"
 	msg "github.com/aziontech/azion-cli/messages/list/edge_applications"
 	api "github.com/aziontech/azion-cli/pkg/api/edge_applications"
 	"github.com/aziontech/azion-cli/pkg/cmdutil"
 	"github.com/aziontech/azion-cli/pkg/contracts"
-	"github.com/aziontech/azion-cli/utils"
+	"github.com/aziontech/azion-cli/pkg/printer"
+	sdk "github.com/aziontech/azionapi-go-sdk/edgeapplications"
 	"github.com/spf13/cobra"
 )
 
 func NewCmd(f *cmdutil.Factory) *cobra.Command {
 	opts := &contracts.ListOptions{}
+	output := &printer.Options{}
 
 	cmd := &cobra.Command{
 		Use:           msg.Usage,
@@ -33,11 +29,19 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		$ azion list edge-application --details
 		$ azion list edge-application --page 1 
 		$ azion list edge-application --page-size 5
+		$ azion list edge-application --format json
+		$ azion list edge-application --columns id,name --no-headers
+		$ azion list edge-application --query '#(active==false)#.id'
+		$ azion list edge-application --filter 'name~^prod-' --filter active=true
 		`),
 		RunE: func(cmd *cobra.Command, _ []string) error {
+			if err := output.Validate(); err != nil {
+				return err
+			}
+
 			client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
 
-			if err := PrintTable(cmd, client, f, opts); err != nil {
+			if err := PrintTable(cmd, client, f, opts, output); err != nil {
 				return fmt.Errorf(msg.ErrorGetAll.Error(), err)
 			}
 			return nil
@@ -49,11 +53,28 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 	flags.Int64Var(&opts.PageSize, "page-size", 10, general.ApiListFlagPageSize)
 	flags.BoolVar(&opts.Details, "details", false, general.ApiListFlagDetails)
 	flags.BoolP("help", "h", false, msg.HelpFlag)
+	cmdutil.AddOutputFlags(f, cmd, output)
+	cmdutil.AddFilterFlags(cmd, output)
 	return cmd
 }
 
-func PrintTable(cmd *cobra.Command, client *api.Client, f *cmdutil.Factory, opts *contracts.ListOptions) error {
+var columns = []printer.Column{
+	{Header: "ID", Path: "id"},
+	{Header: "NAME", Path: "name", Truncate: true},
+	{Header: "ACTIVE", Path: "active"},
+	{Header: "LAST EDITOR", Path: "last_editor", Details: true},
+	{Header: "LAST MODIFIED", Path: "last_modified", Details: true},
+	{Header: "DEBUG RULES", Path: "debug_rules", Details: true},
+}
+
+func PrintTable(cmd *cobra.Command, client *api.Client, f *cmdutil.Factory, opts *contracts.ListOptions, output *printer.Options) error {
 	c := context.Background()
+	var applications []sdk.ApplicationsResults
+
+	// lists that start past the first page are continuations, printed without the header
+	if opts.Page > 1 {
+		output.NoHeaders = true
+	}
 
 	for {
 		resp, err := client.List(c, opts)
@@ -61,39 +82,7 @@ func PrintTable(cmd *cobra.Command, client *api.Client, f *cmdutil.Factory, opts
 			return err
 		}
 
-		tbl := table.New("ID", "NAME", "ACTIVE")
-		tbl.WithWriter(f.IOStreams.Out)
-
-		if opts.Details {
-			tbl = table.New("ID", "NAME", "ACTIVE", "LAST EDITOR", "LAST MODIFIED", "DEBUG RULES")
-		}
-
-		headerFmt := color.New(color.FgBlue, color.Underline).SprintfFunc()
-		columnFmt := color.New(color.FgGreen).SprintfFunc()
-		tbl.WithHeaderFormatter(headerFmt).WithFirstColumnFormatter(columnFmt)
-
-		for _, v := range resp.Results {
-			tbl.AddRow(
-				v.Id,
-				utils.TruncateString(v.Name),
-				v.Active,
-				v.LastEditor,
-				v.LastModified,
-				v.DebugRules,
-			)
-		}
-
-		format := strings.Repeat("%s", len(tbl.GetHeader())) + "\n"
-		tbl.CalculateWidths([]string{})
-
-		// print the header only in the first flow
-		if opts.Page == 1 {
-			logger.PrintHeader(tbl, format)
-		}
-
-		for _, row := range tbl.GetRows() {
-			logger.PrintRow(tbl, format, row)
-		}
+		applications = append(applications, resp.Results...)
 
 		if opts.Page >= resp.TotalPages {
 			break
@@ -106,5 +95,5 @@ func PrintTable(cmd *cobra.Command, client *api.Client, f *cmdutil.Factory, opts
 		opts.Page++
 	}
 
-	return nil
+	return printer.PrintList(f.IOStreams.Out, output, columns, applications, opts.Details)
 }
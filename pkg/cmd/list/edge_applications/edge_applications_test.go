@@ -9,6 +9,8 @@ import (
 	"github.com/aziontech/azion-cli/pkg/httpmock"
 	"github.com/aziontech/azion-cli/pkg/logger"
 	"github.com/aziontech/azion-cli/pkg/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewCmd(t *testing.T) {
@@ -72,3 +74,21 @@ func TestNewCmd(t *testing.T) {
 		})
 	}
 }
+
+func TestQuery(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+
+	mock := &httpmock.Registry{}
+	mock.Register(
+		httpmock.REST("GET", "edge_applications"),
+		httpmock.JSONFromFile("./fixtures/response.json"),
+	)
+
+	f, stdout, _ := testutils.NewFactory(mock)
+	cmd := NewCmd(f)
+	cmd.SetArgs([]string{"--filter", "name~^bobo", "--filter", "active=true", "--query", "#.id"})
+
+	_, err := cmd.ExecuteC()
+	require.NoError(t, err)
+	assert.Equal(t, "1679423488\n", stdout.String())
+}
@@ -0,0 +1,95 @@
+package edgefirewall
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+
+	msg "github.com/aziontech/azion-cli/messages/edge_firewall"
+	api "github.com/aziontech/azion-cli/pkg/api/edge_firewall"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/contracts"
+	"github.com/aziontech/azion-cli/pkg/printer"
+	sdk "github.com/aziontech/azionapi-go-sdk/edgefirewall"
+	"github.com/spf13/cobra"
+)
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	opts := &contracts.ListOptions{}
+	output := &printer.Options{}
+
+	cmd := &cobra.Command{
+		Use:           msg.Usage,
+		Short:         msg.ListShortDescription,
+		Long:          msg.ListLongDescription,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Example: heredoc.Doc(`
+		$ azion list edge-firewall
+		$ azion list edge-firewall --details
+		$ azion list edge-firewall --page 2 --page-size 10
+		$ azion list edge-firewall --filter waf_enabled=true --format json
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := output.Validate(); err != nil {
+				return err
+			}
+
+			if err := PrintTable(cmd, f, opts, output); err != nil {
+				return fmt.Errorf(msg.ErrorList.Error(), err)
+			}
+			return nil
+		},
+	}
+
+	cmdutil.AddAzionApiFlags(cmd, opts)
+	cmd.Flags().BoolP("help", "h", false, msg.ListHelpFlag)
+	cmdutil.AddOutputFlags(f, cmd, output)
+	cmdutil.AddFilterFlags(cmd, output)
+	return cmd
+}
+
+var columns = []printer.Column{
+	{Header: "ID", Path: "id"},
+	{Header: "NAME", Path: "name", Truncate: true},
+	{Header: "ACTIVE", Path: "is_active"},
+	{Header: "DOMAINS", Path: "domains", Format: printer.Join},
+	{Header: "EDGE FUNCTIONS", Path: "edge_functions_enabled", Details: true},
+	{Header: "NETWORK PROTECTION", Path: "network_protection_enabled", Details: true},
+	{Header: "WAF", Path: "waf_enabled", Details: true},
+	{Header: "LAST EDITOR", Path: "last_editor", Details: true},
+	{Header: "LAST MODIFIED", Path: "last_modified", Details: true},
+}
+
+func PrintTable(cmd *cobra.Command, f *cmdutil.Factory, opts *contracts.ListOptions, output *printer.Options) error {
+	client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
+	ctx := context.Background()
+	var edgeFirewalls []sdk.EdgeFirewall
+
+	// lists that start past the first page are continuations, printed without the header
+	if opts.Page > 1 {
+		output.NoHeaders = true
+	}
+
+	for {
+		resp, err := client.List(ctx, opts)
+		if err != nil {
+			return err
+		}
+
+		edgeFirewalls = append(edgeFirewalls, resp.Results...)
+
+		if opts.Page >= resp.GetTotalPages() {
+			break
+		}
+
+		if cmd.Flags().Changed("page") || cmd.Flags().Changed("page-size") {
+			break
+		}
+
+		opts.Page++
+	}
+
+	return printer.PrintList(f.IOStreams.Out, output, columns, edgeFirewalls, opts.Details)
+}
@@ -0,0 +1,67 @@
+package edgefirewall
+
+import (
+	"testing"
+
+	"github.com/aziontech/azion-cli/pkg/httpmock"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestList(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+	t.Run("list edge firewalls", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("GET", "edge_firewall"),
+			httpmock.JSONFromFile("./fixtures/edge_firewalls.json"),
+		)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		assert.Equal(t,
+			"ID    NAME  ACTIVE  DOMAINS                 \n"+
+				"1337  shop  true    1678392838, 1678392839  \n"+
+				"1338  blog  false                           \n",
+			stdout.String(),
+		)
+	})
+
+	t.Run("filter waf enabled as json", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("GET", "edge_firewall"),
+			httpmock.JSONFromFile("./fixtures/edge_firewalls.json"),
+		)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--filter", "waf_enabled=true", "--query", "#.name"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		assert.Equal(t, "shop\n", stdout.String())
+	})
+
+	t.Run("list fails", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("GET", "edge_firewall"),
+			httpmock.StatusStringResponse(500, "Internal Server Error"),
+		)
+
+		f, _, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{})
+
+		_, err := cmd.ExecuteC()
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,104 @@
+package edgefirewallrule
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/tidwall/gjson"
+
+	msg "github.com/aziontech/azion-cli/messages/edge_firewall_rule"
+	api "github.com/aziontech/azion-cli/pkg/api/edge_firewall"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/contracts"
+	"github.com/aziontech/azion-cli/pkg/printer"
+	"github.com/spf13/cobra"
+)
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	var edgeFirewallID int64
+	opts := &contracts.ListOptions{}
+	output := &printer.Options{}
+
+	cmd := &cobra.Command{
+		Use:           msg.Usage,
+		Short:         msg.ListShortDescription,
+		Long:          msg.ListLongDescription,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Example: heredoc.Doc(`
+		$ azion list edge-firewall-rule --edge-firewall-id 1337
+		$ azion list edge-firewall-rule --edge-firewall-id 1337 --details
+		$ azion list edge-firewall-rule --edge-firewall-id 1337 --format yaml
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := output.Validate(); err != nil {
+				return err
+			}
+
+			if !cmd.Flags().Changed("edge-firewall-id") {
+				return msg.ErrorMissingEdgeFirewallID
+			}
+
+			if err := PrintTable(cmd, f, opts, output, edgeFirewallID); err != nil {
+				return fmt.Errorf(msg.ErrorList.Error(), err)
+			}
+			return nil
+		},
+	}
+
+	cmdutil.AddAzionApiFlags(cmd, opts)
+	cmd.Flags().Int64Var(&edgeFirewallID, "edge-firewall-id", 0, msg.FlagEdgeFirewallID)
+	cmd.Flags().BoolP("help", "h", false, msg.ListHelpFlag)
+	cmdutil.AddOutputFlags(f, cmd, output)
+	cmdutil.AddFilterFlags(cmd, output)
+	return cmd
+}
+
+var columns = []printer.Column{
+	{Header: "ID", Path: "id"},
+	{Header: "ORDER", Path: "order"},
+	{Header: "NAME", Path: "name", Truncate: true},
+	{Header: "ACTIVE", Path: "is_active"},
+	{Header: "BEHAVIORS", Path: "behaviors", Format: behaviorNames},
+	{Header: "DESCRIPTION", Path: "description", Details: true, Truncate: true},
+	{Header: "LAST EDITOR", Path: "last_editor", Details: true},
+	{Header: "LAST MODIFIED", Path: "last_modified", Details: true},
+}
+
+// behaviorNames summarizes the behaviors of a rule by their names
+func behaviorNames(value gjson.Result) string {
+	return printer.Join(value.Get("#.name"))
+}
+
+func PrintTable(cmd *cobra.Command, f *cmdutil.Factory, opts *contracts.ListOptions, output *printer.Options, edgeFirewallID int64) error {
+	client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
+	ctx := context.Background()
+	var rules []api.RuleSet
+
+	// lists that start past the first page are continuations, printed without the header
+	if opts.Page > 1 {
+		output.NoHeaders = true
+	}
+
+	for {
+		resp, err := client.ListRules(ctx, edgeFirewallID, opts)
+		if err != nil {
+			return err
+		}
+
+		rules = append(rules, resp.Results...)
+
+		if opts.Page >= resp.TotalPages {
+			break
+		}
+
+		if cmd.Flags().Changed("page") || cmd.Flags().Changed("page-size") {
+			break
+		}
+
+		opts.Page++
+	}
+
+	return printer.PrintList(f.IOStreams.Out, output, columns, rules, opts.Details)
+}
@@ -0,0 +1,47 @@
+package edgefirewallrule
+
+import (
+	"testing"
+
+	"github.com/aziontech/azion-cli/pkg/httpmock"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestList(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+	t.Run("list rules with their behaviors", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("GET", "edge_firewall/1337/rules_engine"),
+			httpmock.JSONFromFile("./fixtures/rules.json"),
+		)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--edge-firewall-id", "1337"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		assert.Equal(t,
+			"ID  ORDER  NAME            ACTIVE  BEHAVIORS                  \n"+
+				"42  1      block bad bots  true    deny                       \n"+
+				"43  2      rate limit api  false   set_rate_limit, tag_event  \n",
+			stdout.String(),
+		)
+	})
+
+	t.Run("missing edge firewall id", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+
+		f, _, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{})
+
+		_, err := cmd.ExecuteC()
+		require.Error(t, err)
+	})
+}
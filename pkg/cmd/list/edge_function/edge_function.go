@@ -3,22 +3,20 @@ package edgefunction
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/MakeNowJust/heredoc"
 	msg "github.com/aziontech/azion-cli/messages/edge_function"
 	api "github.com/aziontech/azion-cli/pkg/api/edge_function"
 	"github.com/aziontech/azion-cli/pkg/cmdutil"
 	"github.com/aziontech/azion-cli/pkg/contracts"
-	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/printer"
+	sdk "github.com/aziontech/azionapi-go-sdk/edgefunctions"
 	"github.com/spf13/cobra"
-
-	table "github.com/MaxwelMazur/tablecli"
-	"github.com/fatih/color"
 )
 
 func NewCmd(f *cmdutil.Factory) *cobra.Command {
 	opts := &contracts.ListOptions{}
+	output := &printer.Options{}
 
 	cmd := &cobra.Command{
 		Use:           msg.Usage,
@@ -32,10 +30,14 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		$ azion list edge-function --page 1  
 		$ azion list edge-function --page_size 5
 		$ azion list edge-function --sort "asc" 
+		$ azion list edge-function --format template --template '{{.id}} {{.name}}'
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := output.Validate(); err != nil {
+				return err
+			}
 
-			if err := PrintTable(cmd, f, opts); err != nil {
+			if err := PrintTable(cmd, f, opts, output); err != nil {
 				return fmt.Errorf(msg.ErrorGetFunctions.Error(), err)
 			}
 			return nil
@@ -44,12 +46,31 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 
 	cmdutil.AddAzionApiFlags(cmd, opts)
 	cmd.Flags().BoolP("help", "h", false, msg.ListHelpFlag)
+	cmdutil.AddOutputFlags(f, cmd, output)
+	cmdutil.AddFilterFlags(cmd, output)
 	return cmd
 }
 
-func PrintTable(cmd *cobra.Command, f *cmdutil.Factory, opts *contracts.ListOptions) error {
+var columns = []printer.Column{
+	{Header: "ID", Path: "id"},
+	{Header: "NAME", Path: "name"},
+	{Header: "LANGUAGE", Path: "language"},
+	{Header: "ACTIVE", Path: "active"},
+	{Header: "LAST EDITOR", Path: "last_editor", Details: true},
+	{Header: "MODIFIED", Path: "modified", Details: true},
+	{Header: "REFERENCE COUNT", Path: "reference_count", Details: true},
+	{Header: "INITIATOR_TYPE", Path: "initiator_type", Details: true},
+}
+
+func PrintTable(cmd *cobra.Command, f *cmdutil.Factory, opts *contracts.ListOptions, output *printer.Options) error {
 	client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
 	ctx := context.Background()
+	var results []sdk.Results
+
+	// lists that start past the first page are continuations, printed without the header
+	if opts.Page > 1 {
+		output.NoHeaders = true
+	}
 
 	for {
 		functions, err := client.List(ctx, opts)
@@ -57,32 +78,7 @@ func PrintTable(cmd *cobra.Command, f *cmdutil.Factory, opts *contracts.ListOpti
 			return fmt.Errorf(msg.ErrorGetFunctions.Error(), err)
 		}
 
-		tbl := table.New("ID", "NAME", "LANGUAGE", "ACTIVE")
-		tbl.WithWriter(f.IOStreams.Out)
-
-		if opts.Details {
-			tbl = table.New("ID", "NAME", "LANGUAGE", "ACTIVE", "LAST EDITOR", "MODIFIED", "REFERENCE COUNT", "INITIATOR_TYPE")
-		}
-
-		headerFmt := color.New(color.FgBlue, color.Underline).SprintfFunc()
-		columnFmt := color.New(color.FgGreen).SprintfFunc()
-		tbl.WithHeaderFormatter(headerFmt).WithFirstColumnFormatter(columnFmt)
-
-		for _, v := range functions.Results {
-			tbl.AddRow(v.GetId(), v.GetName(), v.GetLanguage(), v.GetActive(), v.GetLastEditor(), v.GetModified(), v.GetReferenceCount(), v.GetInitiatorType())
-		}
-
-		format := strings.Repeat("%s", len(tbl.GetHeader())) + "\n"
-		tbl.CalculateWidths([]string{})
-
-		// print the header only in the first flow
-		if opts.Page == 1 {
-			logger.PrintHeader(tbl, format)
-		}
-
-		for _, row := range tbl.GetRows() {
-			logger.PrintRow(tbl, format, row)
-		}
+		results = append(results, functions.Results...)
 
 		if opts.Page >= *functions.TotalPages {
 			break
@@ -95,5 +91,5 @@ func PrintTable(cmd *cobra.Command, f *cmdutil.Factory, opts *contracts.ListOpti
 		opts.Page++
 	}
 
-	return nil
+	return printer.PrintList(f.IOStreams.Out, output, columns, results, opts.Details)
 }
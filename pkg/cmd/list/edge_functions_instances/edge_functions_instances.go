@@ -0,0 +1,97 @@
+package edgefunctionsinstances
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	msg "github.com/aziontech/azion-cli/messages/edge_functions_instances"
+	api "github.com/aziontech/azion-cli/pkg/api/edge_applications"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/contracts"
+	"github.com/aziontech/azion-cli/pkg/printer"
+	sdk "github.com/aziontech/azionapi-go-sdk/edgeapplications"
+	"github.com/spf13/cobra"
+)
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	opts := &contracts.ListOptions{}
+	output := &printer.Options{}
+	var edgeApplicationID int64 = 0
+	cmd := &cobra.Command{
+		Use:           msg.EdgeFunctionsInstancesListUsage,
+		Short:         msg.EdgeFunctionsInstancesListShortDescription,
+		Long:          msg.EdgeFunctionsInstancesListLongDescription,
+		SilenceUsage:  true,
+		SilenceErrors: true, Example: heredoc.Doc(`
+		$ azion list edge-function-instance -a 1234123423
+		$ azion list edge-function-instance --application-id 1234123423 --details
+		$ azion list edge-function-instance --application-id 1234123423 --order-by "id"
+		$ azion list edge-function-instance --application-id 1234123423 --page 1
+		$ azion list edge-function-instance --application-id 1234123423 --page-size 5
+		$ azion list edge-function-instance -a 1234123423 --sort "asc"
+		$ azion list edge-function-instance -a 1234123423 --format yaml
+		`),
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := output.Validate(); err != nil {
+				return err
+			}
+
+			if !cmd.Flags().Changed("application-id") {
+				return msg.ErrorMandatoryListFlags
+			}
+
+			if err := PrintTable(cmd, f, opts, output, edgeApplicationID); err != nil {
+				return fmt.Errorf(msg.ErrorGetFunctions.Error(), err)
+			}
+			return nil
+		},
+	}
+
+	cmdutil.AddAzionApiFlags(cmd, opts)
+	cmd.Flags().Int64VarP(&edgeApplicationID, "application-id", "a", 0, msg.EdgeApplicationFlagId)
+	cmd.Flags().BoolP("help", "h", false, msg.EdgeFunctionsInstancesListHelpFlag)
+	cmdutil.AddOutputFlags(f, cmd, output)
+	cmdutil.AddFilterFlags(cmd, output)
+	return cmd
+}
+
+var columns = []printer.Column{
+	{Header: "ID", Path: "id"},
+	{Header: "EDGE FUNCTIONS ID", Path: "edge_function_id", Details: true},
+	{Header: "NAME", Path: "name"},
+	{Header: "ARGS", Path: "args", Details: true},
+}
+
+func PrintTable(cmd *cobra.Command, f *cmdutil.Factory, opts *contracts.ListOptions, output *printer.Options, edgeApplicationID int64) error {
+	client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
+	ctx := context.Background()
+	var instances []sdk.ApplicationInstancesResults
+
+	// lists that start past the first page are continuations, printed without the header
+	if opts.Page > 1 {
+		output.NoHeaders = true
+	}
+
+	for {
+		resp, err := client.EdgeFuncInstancesList(ctx, opts, edgeApplicationID)
+		if err != nil {
+			return err
+		}
+
+		instances = append(instances, resp.Results...)
+
+		if opts.Page >= resp.TotalPages {
+			break
+		}
+
+		if cmd.Flags().Changed("page") || cmd.Flags().Changed("page-size") {
+			break
+		}
+
+		opts.Page++
+	}
+
+	return printer.PrintList(f.IOStreams.Out, output, columns, instances, opts.Details)
+}
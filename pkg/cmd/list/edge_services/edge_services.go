@@ -1,13 +1,12 @@
-package list
+package edgeservices
 
 import (
 	"context"
 	"fmt"
-	"io"
 
 	"github.com/MakeNowJust/heredoc"
 	msg "github.com/aziontech/azion-cli/messages/edge_services"
-	"github.com/aziontech/azion-cli/pkg/cmd/edge_services/requests"
+	api "github.com/aziontech/azion-cli/pkg/api/edge_services"
 	"github.com/aziontech/azion-cli/pkg/cmdutil"
 	"github.com/aziontech/azion-cli/pkg/contracts"
 	"github.com/aziontech/azion-cli/pkg/printer"
@@ -18,6 +17,7 @@ import (
 
 func NewCmd(f *cmdutil.Factory) *cobra.Command {
 	opts := &contracts.ListOptions{}
+	output := &printer.Options{}
 
 	// listCmd represents the list command
 	listCmd := &cobra.Command{
@@ -27,19 +27,21 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		SilenceUsage:  true,
 		SilenceErrors: true,
 		Example: heredoc.Doc(`
-		$ azion edge_services list [--details]
-		$ azion edge_services list --order_by "id"
-		$ azion edge_services list --page 1  
-		$ azion edge_services list --page_size 5
-		$ azion edge_services list --sort "asc" 
+		$ azion list edge-service [--details]
+		$ azion list edge-service --order-by "id"
+		$ azion list edge-service --page 1
+		$ azion list edge-service --page-size 5
+		$ azion list edge-service --sort "asc"
+		$ azion list edge-service --format json
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			client, err := requests.CreateClient(f)
-			if err != nil {
+			if err := output.Validate(); err != nil {
 				return err
 			}
 
-			if err := listAllServices(client, f.IOStreams.Out, opts); err != nil {
+			client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
+
+			if err := listAllServices(client, f, opts, output); err != nil {
 				return err
 			}
 			return nil
@@ -48,23 +50,31 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 
 	cmdutil.AddAzionApiFlags(listCmd, opts)
 	listCmd.Flags().BoolP("help", "h", false, msg.EdgeServiceListFlagHelp)
+	cmdutil.AddOutputFlags(f, listCmd, output)
+	cmdutil.AddFilterFlags(listCmd, output)
 
 	return listCmd
 }
 
-func listAllServices(client *sdk.APIClient, out io.Writer, opts *contracts.ListOptions) error {
+var columns = []printer.Column{
+	{Header: "ID", Path: "id"},
+	{Header: "NAME", Path: "name"},
+	{Header: "LAST EDITOR", Path: "last_editor", Details: true},
+	{Header: "LAST MODIFIED", Path: "updated_at", Details: true},
+	{Header: "ACTIVE", Path: "active", Details: true},
+	{Header: "BOUND NODES", Path: "bound_nodes", Details: true},
+}
+
+func listAllServices(client *sdk.APIClient, f *cmdutil.Factory, opts *contracts.ListOptions, output *printer.Options) error {
 	c := context.Background()
 	api := client.DefaultApi
 
-	fields := []string{"Id", "Name"}
-	headers := []string{"ID", "NAME"}
-
 	resp, httpResp, err := api.GetServices(c).
 		Page(opts.Page).
 		PageSize(opts.PageSize).
 		Sort(opts.Sort).
 		OrderBy(opts.OrderBy).
-		Filter(opts.Filter).
+		Filter(printer.NameFilter(output.Filters)).
 		Execute()
 
 	if err != nil {
@@ -73,15 +83,5 @@ func listAllServices(client *sdk.APIClient, out io.Writer, opts *contracts.ListO
 		return fmt.Errorf(msg.ErrorGetServices.Error(), message)
 	}
 
-	services := resp.Services
-
-	tp := printer.NewTab(out)
-	if opts.Details {
-		fields = append(fields, "LastEditor", "UpdatedAt", "Active", "BoundNodes")
-		headers = append(headers, "LAST EDITOR", "LAST MODIFIED", "ACTIVE", "BOUND NODES")
-	}
-
-	tp.PrintWithHeaders(services, fields, headers)
-
-	return nil
+	return printer.PrintList(f.IOStreams.Out, output, columns, resp.Services, opts.Details)
 }
@@ -0,0 +1,109 @@
+package edgeservices
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"testing"
+
+	table "github.com/MaxwelMazur/tablecli"
+	"github.com/aziontech/azion-cli/pkg/httpmock"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestList(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+	t.Run("more than one service", func(t *testing.T) {
+		// tablecli keeps the widths of the previous table
+		table.WidthPersist = nil
+		mock := &httpmock.Registry{}
+
+		mock.Register(
+			httpmock.REST("GET", "edge_services/"),
+			httpmock.JSONFromFile("./fixtures/services.json"),
+		)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+
+		cmd := NewCmd(f)
+
+		cmd.SetArgs([]string{})
+		cmd.SetIn(&bytes.Buffer{})
+		cmd.SetOut(io.Discard)
+		cmd.SetErr(io.Discard)
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			"ID    NAME                    \n"+
+				"1718  batata                  \n"+
+				"1209  ApeService              \n"+
+				"1752  ApeService              \n"+
+				"1751  Testando CLI            \n"+
+				"1750  testing new code cli    \n"+
+				"26    Service Henrique Teste  \n"+
+				"1746  jagaimo                 \n"+
+				"1717  potato                  \n"+
+				"1716  tst-flag                \n"+
+				"1715  tst-flag                \n",
+			stdout.String(),
+		)
+	})
+
+	t.Run("no services", func(t *testing.T) {
+		table.WidthPersist = nil
+		mock := &httpmock.Registry{}
+
+		mock.Register(
+			httpmock.REST("GET", "edge_services/"),
+			httpmock.JSONFromFile("./fixtures/noservices.json"),
+		)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+
+		cmd := NewCmd(f)
+
+		cmd.SetArgs([]string{})
+		cmd.SetIn(&bytes.Buffer{})
+		cmd.SetOut(io.Discard)
+		cmd.SetErr(io.Discard)
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+
+		assert.Equal(t, "ID  NAME  \n", stdout.String())
+	})
+
+	t.Run("filter by name on the API", func(t *testing.T) {
+		table.WidthPersist = nil
+		mock := &httpmock.Registry{}
+
+		mock.Register(
+			func(req *http.Request) bool {
+				return httpmock.REST("GET", "edge_services/")(req) && req.URL.Query().Get("filter") == "potato"
+			},
+			httpmock.JSONFromFile("./fixtures/services.json"),
+		)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+
+		cmd := NewCmd(f)
+
+		cmd.SetArgs([]string{"--filter", "potato", "--filter", "id!=1"})
+		cmd.SetIn(&bytes.Buffer{})
+		cmd.SetOut(io.Discard)
+		cmd.SetErr(io.Discard)
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		mock.Verify(t)
+
+		assert.Contains(t, stdout.String(), "1717  potato")
+		assert.NotContains(t, stdout.String(), "jagaimo")
+	})
+}
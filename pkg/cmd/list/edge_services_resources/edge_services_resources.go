@@ -1,13 +1,12 @@
-package list
+package edgeservicesresources
 
 import (
 	"context"
 	"fmt"
-	"io"
 
 	"github.com/MakeNowJust/heredoc"
 	msg "github.com/aziontech/azion-cli/messages/edge_services"
-	"github.com/aziontech/azion-cli/pkg/cmd/edge_services/requests"
+	api "github.com/aziontech/azion-cli/pkg/api/edge_services"
 	"github.com/aziontech/azion-cli/pkg/cmdutil"
 	"github.com/aziontech/azion-cli/pkg/contracts"
 	"github.com/aziontech/azion-cli/pkg/printer"
@@ -18,6 +17,7 @@ import (
 
 func NewCmd(f *cmdutil.Factory) *cobra.Command {
 	opts := &contracts.ListOptions{}
+	output := &printer.Options{}
 	var service_id int64
 
 	// listCmd represents the list command
@@ -28,19 +28,22 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		SilenceUsage:  true,
 		SilenceErrors: true,
 		Example: heredoc.Doc(`
-        $ azion edge_services resources list --service-id 1234 [--details]
-        `),
+		$ azion list edge-service-resource --service-id 1234 [--details]
+		$ azion list edge-service-resource --service-id 1234 --filter trigger=Install
+		$ azion list edge-service-resource --service-id 1234 --format json
+		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := output.Validate(); err != nil {
+				return err
+			}
+
 			if !cmd.Flags().Changed("service-id") {
 				return msg.ErrorMissingServiceIdArgument
 			}
 
-			client, err := requests.CreateClient(f)
-			if err != nil {
-				return err
-			}
+			client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
 
-			if err := listAllResources(client, f.IOStreams.Out, opts, service_id); err != nil {
+			if err := listAllResources(client, f, opts, output, service_id); err != nil {
 				return err
 			}
 			return nil
@@ -48,25 +51,33 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 	}
 
 	cmdutil.AddAzionApiFlags(listCmd, opts)
-	listCmd.Flags().Int64VarP(&service_id, "service-id", "s", 0, "Unique identifier of the Edge Service")
+	listCmd.Flags().Int64Var(&service_id, "service-id", 0, msg.EdgeServiceFlagId)
 	listCmd.Flags().BoolP("help", "h", false, msg.EdgeServiceResourceListFlagHelp)
+	cmdutil.AddOutputFlags(f, listCmd, output)
+	cmdutil.AddFilterFlags(listCmd, output)
 
 	return listCmd
 }
 
-func listAllResources(client *sdk.APIClient, out io.Writer, opts *contracts.ListOptions, service_id int64) error {
+var columns = []printer.Column{
+	{Header: "ID", Path: "id"},
+	{Header: "NAME", Path: "name"},
+	{Header: "LAST EDITOR", Path: "last_editor", Details: true},
+	{Header: "LAST MODIFIED", Path: "updated_at", Details: true},
+	{Header: "CONTENT TYPE", Path: "content_type", Details: true},
+	{Header: "TRIGGER", Path: "trigger", Details: true},
+}
+
+func listAllResources(client *sdk.APIClient, f *cmdutil.Factory, opts *contracts.ListOptions, output *printer.Options, service_id int64) error {
 	c := context.Background()
 	api := client.DefaultApi
 
-	fields := []string{"Id", "Name"}
-	headers := []string{"ID", "NAME"}
-
 	resp, httpResp, err := api.GetResources(c, service_id).
 		Page(opts.Page).
 		PageSize(opts.PageSize).
 		Sort(opts.Sort).
 		OrderBy(opts.OrderBy).
-		Filter(opts.Filter).
+		Filter(printer.NameFilter(output.Filters)).
 		Execute()
 
 	if err != nil {
@@ -75,15 +86,5 @@ func listAllResources(client *sdk.APIClient, out io.Writer, opts *contracts.List
 		return fmt.Errorf(msg.ErrorGetResources.Error(), message)
 	}
 
-	resources := resp.Resources
-
-	tp := printer.NewTab(out)
-	if opts.Details {
-		fields = append(fields, "LastEditor", "UpdatedAt", "ContentType", "Trigger")
-		headers = append(headers, "LAST EDITOR", "LAST MODIFIED", "CONTENT TYPE", "TRIGGER")
-	}
-
-	tp.PrintWithHeaders(resources, fields, headers)
-
-	return nil
+	return printer.PrintList(f.IOStreams.Out, output, columns, resp.Resources, opts.Details)
 }
@@ -1,4 +1,4 @@
-package list
+package edgeservicesresources
 
 import (
 	"bytes"
@@ -6,15 +6,21 @@ import (
 	"net/http"
 	"testing"
 
+	table "github.com/MaxwelMazur/tablecli"
 	errmsg "github.com/aziontech/azion-cli/messages/edge_services"
 	"github.com/aziontech/azion-cli/pkg/httpmock"
+	"github.com/aziontech/azion-cli/pkg/logger"
 	"github.com/aziontech/azion-cli/pkg/testutils"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
 )
 
 func TestList(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
 	t.Run("more than one resource", func(t *testing.T) {
+		// tablecli keeps the widths of the previous table
+		table.WidthPersist = nil
 		mock := &httpmock.Registry{}
 
 		mock.Register(
@@ -35,19 +41,19 @@ func TestList(t *testing.T) {
 		require.NoError(t, err)
 
 		assert.Equal(t,
-			`ID       NAME
-82587    /tmp/abacatito
-82588    /tmp/abacatito
-82592    /tmp/test/asasa
-82603    /tmp/namechanged
-82606    /tmp/abacatito
-82611    /tmp/test/assssas
-`,
+			"ID     NAME               \n"+
+				"82587  /tmp/abacatito     \n"+
+				"82588  /tmp/abacatito     \n"+
+				"82592  /tmp/test/asasa    \n"+
+				"82603  /tmp/namechanged   \n"+
+				"82606  /tmp/abacatito     \n"+
+				"82611  /tmp/test/assssas  \n",
 			stdout.String(),
 		)
 	})
 
 	t.Run("no resources", func(t *testing.T) {
+		table.WidthPersist = nil
 		mock := &httpmock.Registry{}
 
 		mock.Register(
@@ -59,7 +65,7 @@ func TestList(t *testing.T) {
 
 		cmd := NewCmd(f)
 
-		cmd.SetArgs([]string{"-s", "1234"})
+		cmd.SetArgs([]string{"--service-id", "1234"})
 		cmd.SetIn(&bytes.Buffer{})
 		cmd.SetOut(io.Discard)
 		cmd.SetErr(io.Discard)
@@ -67,11 +73,11 @@ func TestList(t *testing.T) {
 		_, err := cmd.ExecuteC()
 		require.NoError(t, err)
 
-		assert.Equal(t, `ID    NAME
-`, stdout.String())
+		assert.Equal(t, "ID  NAME  \n", stdout.String())
 	})
 
 	t.Run("no resource_id sent", func(t *testing.T) {
+		table.WidthPersist = nil
 		mock := &httpmock.Registry{}
 
 		mock.Register(
@@ -92,6 +98,7 @@ func TestList(t *testing.T) {
 	})
 
 	t.Run("invalid resource_id", func(t *testing.T) {
+		table.WidthPersist = nil
 		mock := &httpmock.Registry{}
 
 		mock.Register(
@@ -4,13 +4,28 @@ import (
 	"github.com/MakeNowJust/heredoc"
 	msg "github.com/aziontech/azion-cli/messages/list"
 	cache "github.com/aziontech/azion-cli/pkg/cmd/list/cache_setting"
+	dataStreaming "github.com/aziontech/azion-cli/pkg/cmd/list/data_streaming"
+	dataStreamingDomain "github.com/aziontech/azion-cli/pkg/cmd/list/data_streaming_domain"
+	dataStreamingTemplate "github.com/aziontech/azion-cli/pkg/cmd/list/data_streaming_template"
+	deviceGroups "github.com/aziontech/azion-cli/pkg/cmd/list/device_groups"
+	digitalCertificate "github.com/aziontech/azion-cli/pkg/cmd/list/digital_certificate"
+	dnsRecord "github.com/aziontech/azion-cli/pkg/cmd/list/dns_record"
+	dnsZone "github.com/aziontech/azion-cli/pkg/cmd/list/dns_zone"
 	domain "github.com/aziontech/azion-cli/pkg/cmd/list/domain"
 	edgeApplications "github.com/aziontech/azion-cli/pkg/cmd/list/edge_applications"
+	edgeFirewall "github.com/aziontech/azion-cli/pkg/cmd/list/edge_firewall"
+	edgeFirewallRule "github.com/aziontech/azion-cli/pkg/cmd/list/edge_firewall_rule"
 	function "github.com/aziontech/azion-cli/pkg/cmd/list/edge_function"
+	edgeFunctionsInstances "github.com/aziontech/azion-cli/pkg/cmd/list/edge_functions_instances"
+	edgeServices "github.com/aziontech/azion-cli/pkg/cmd/list/edge_services"
+	edgeServicesResources "github.com/aziontech/azion-cli/pkg/cmd/list/edge_services_resources"
+	networkList "github.com/aziontech/azion-cli/pkg/cmd/list/network_list"
 	origin "github.com/aziontech/azion-cli/pkg/cmd/list/origin"
 	token "github.com/aziontech/azion-cli/pkg/cmd/list/personal_token"
 	rule "github.com/aziontech/azion-cli/pkg/cmd/list/rule_engine"
 	"github.com/aziontech/azion-cli/pkg/cmd/list/variables"
+	wafAllowedRule "github.com/aziontech/azion-cli/pkg/cmd/list/waf_allowed_rule"
+	wafRuleSet "github.com/aziontech/azion-cli/pkg/cmd/list/waf_rule_set"
 
 	"github.com/aziontech/azion-cli/pkg/cmdutil"
 	"github.com/spf13/cobra"
@@ -37,6 +52,21 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 	cmd.AddCommand(cache.NewCmd(f))
 	cmd.AddCommand(function.NewCmd(f))
 	cmd.AddCommand(variables.NewCmd(f))
+	cmd.AddCommand(deviceGroups.NewCmd(f))
+	cmd.AddCommand(edgeFunctionsInstances.NewCmd(f))
+	cmd.AddCommand(edgeServices.NewCmd(f))
+	cmd.AddCommand(edgeServicesResources.NewCmd(f))
+	cmd.AddCommand(digitalCertificate.NewCmd(f))
+	cmd.AddCommand(edgeFirewall.NewCmd(f))
+	cmd.AddCommand(edgeFirewallRule.NewCmd(f))
+	cmd.AddCommand(wafRuleSet.NewCmd(f))
+	cmd.AddCommand(wafAllowedRule.NewCmd(f))
+	cmd.AddCommand(networkList.NewCmd(f))
+	cmd.AddCommand(dnsZone.NewCmd(f))
+	cmd.AddCommand(dnsRecord.NewCmd(f))
+	cmd.AddCommand(dataStreaming.NewCmd(f))
+	cmd.AddCommand(dataStreamingTemplate.NewCmd(f))
+	cmd.AddCommand(dataStreamingDomain.NewCmd(f))
 
 	cmd.Flags().BoolP("help", "h", false, msg.FlagHelp)
 	return cmd
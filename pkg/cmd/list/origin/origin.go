@@ -6,17 +6,18 @@ import (
 	"strconv"
 	"strings"
 
-	"github.com/fatih/color"
+	"github.com/tidwall/gjson"
 	"go.uber.org/zap"
 
 	"github.com/MakeNowJust/heredoc"
-	table "github.com/MaxwelMazur/tablecli"
 	msg "github.com/aziontech/azion-cli/messages/origin"
 	api "github.com/aziontech/azion-cli/pkg/api/origin"
 	"github.com/aziontech/azion-cli/pkg/cmdutil"
 	"github.com/aziontech/azion-cli/pkg/contracts"
 	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/printer"
 	"github.com/aziontech/azion-cli/utils"
+	sdk "github.com/aziontech/azionapi-go-sdk/edgeapplications"
 	"github.com/spf13/cobra"
 )
 
@@ -24,6 +25,7 @@ var edgeApplicationID int64 = 0
 
 func NewCmd(f *cmdutil.Factory) *cobra.Command {
 	opts := &contracts.ListOptions{}
+	output := &printer.Options{}
 	cmd := &cobra.Command{
 		Use:           msg.Usage,
 		Short:         msg.ListShortDescription,
@@ -32,8 +34,13 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		SilenceErrors: true, Example: heredoc.Doc(`
         $ azion list origin  --application-id 16736354321
         $ azion list origin  --application-id 16736354321 --details
+        $ azion list origin  --application-id 16736354321 --format csv --columns origin_key,name
         `),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := output.Validate(); err != nil {
+				return err
+			}
+
 			if !cmd.Flags().Changed("application-id") {
 
 				answer, err := utils.AskInput(msg.AskAppID)
@@ -52,7 +59,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 
 			client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
 
-			if err := PrintTable(client, f, opts); err != nil {
+			if err := PrintTable(client, f, opts, output); err != nil {
 				return fmt.Errorf(msg.ErrorGetOrigins.Error(), err)
 			}
 			return nil
@@ -63,11 +70,38 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 	flags := cmd.Flags()
 	flags.Int64Var(&edgeApplicationID, "application-id", 0, msg.FlagEdgeApplicationID)
 	flags.BoolP("help", "h", false, msg.ListHelpFlag)
+	cmdutil.AddOutputFlags(f, cmd, output)
+	cmdutil.AddFilterFlags(cmd, output)
+	cmdutil.MarkPrompted(cmd, "application-id")
 	return cmd
 }
 
-func PrintTable(client *api.Client, f *cmdutil.Factory, opts *contracts.ListOptions) error {
+var columns = []printer.Column{
+	{Header: "ORIGIN KEY", Path: "origin_key"},
+	{Header: "NAME", Path: "name", Truncate: true},
+	{Header: "ID", Path: "origin_id", Details: true},
+	{Header: "ORIGIN TYPE", Path: "origin_type", Details: true},
+	{Header: "ORIGIN PATH", Path: "origin_path", Details: true},
+	{Header: "ADDRESSES", Path: "addresses", Details: true, Format: addresses},
+	{Header: "CONNECTION TIMEOUT", Path: "connection_timeout", Details: true},
+}
+
+func addresses(value gjson.Result) string {
+	var list []string
+	for _, address := range value.Array() {
+		list = append(list, address.Get("address").String())
+	}
+	return strings.Join(list, ", ")
+}
+
+func PrintTable(client *api.Client, f *cmdutil.Factory, opts *contracts.ListOptions, output *printer.Options) error {
 	c := context.Background()
+	var origins []sdk.OriginsResultResponse
+
+	// lists that start past the first page are continuations, printed without the header
+	if opts.Page > 1 {
+		output.NoHeaders = true
+	}
 
 	for {
 		resp, err := client.ListOrigins(c, opts, edgeApplicationID)
@@ -75,39 +109,7 @@ func PrintTable(client *api.Client, f *cmdutil.Factory, opts *contracts.ListOpti
 			return err
 		}
 
-		tbl := table.New("ORIGIN KEY", "NAME")
-		tbl.WithWriter(f.IOStreams.Out)
-		if opts.Details {
-			tbl = table.New("ORIGIN KEY", "NAME", "ID", "ORIGIN TYPE", "ORIGIN PATH", "ADDRESSES", "CONNECTION TIMEOUT")
-		}
-
-		headerFmt := color.New(color.FgBlue, color.Underline).SprintfFunc()
-		columnFmt := color.New(color.FgGreen).SprintfFunc()
-		tbl.WithHeaderFormatter(headerFmt).WithFirstColumnFormatter(columnFmt)
-
-		for _, v := range resp.Results {
-			tbl.AddRow(
-				*v.OriginKey,
-				utils.TruncateString(v.Name),
-				*v.OriginId,
-				*v.OriginType,
-				*v.OriginPath,
-				v.Addresses,
-				*v.ConnectionTimeout,
-			)
-		}
-
-		format := strings.Repeat("%s", len(tbl.GetHeader())) + "\n"
-		tbl.CalculateWidths([]string{})
-
-		// print the header only in the first flow
-		if opts.Page == 1 {
-			logger.PrintHeader(tbl, format)
-		}
-
-		for _, row := range tbl.GetRows() {
-			logger.PrintRow(tbl, format, row)
-		}
+		origins = append(origins, resp.Results...)
 
 		if opts.Page >= resp.TotalPages {
 			break
@@ -115,5 +117,5 @@ func PrintTable(client *api.Client, f *cmdutil.Factory, opts *contracts.ListOpti
 		opts.Page++
 	}
 
-	return nil
+	return printer.PrintList(f.IOStreams.Out, output, columns, origins, opts.Details)
 }
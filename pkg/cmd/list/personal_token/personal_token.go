@@ -3,24 +3,23 @@ package personaltoken
 import (
 	"context"
 	"fmt"
-	"strings"
-
-	"github.com/fatih/color"
+	"time"
 
 	"github.com/MakeNowJust/heredoc"
-	table "github.com/MaxwelMazur/tablecli"
 	"github.com/aziontech/azion-cli/messages/general"
 	msg "github.com/aziontech/azion-cli/messages/list/personal_token"
 	api "github.com/aziontech/azion-cli/pkg/api/personal_token"
 	"github.com/aziontech/azion-cli/pkg/cmdutil"
 	"github.com/aziontech/azion-cli/pkg/constants"
-	"github.com/aziontech/azion-cli/pkg/logger"
-	"github.com/aziontech/azion-cli/utils"
+	"github.com/aziontech/azion-cli/pkg/printer"
+	"github.com/aziontech/azion-cli/pkg/token"
 	"github.com/spf13/cobra"
+	"github.com/tidwall/gjson"
 )
 
 func NewCmd(f *cmdutil.Factory) *cobra.Command {
 	var details bool
+	output := &printer.Options{}
 
 	cmd := &cobra.Command{
 		Use:           msg.Usage,
@@ -30,14 +29,19 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		SilenceErrors: true, Example: heredoc.Doc(`
         $ azion list personal-token  
         $ azion list personal-token --details
+        $ azion list personal-token --format yaml
         `),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := output.Validate(); err != nil {
+				return err
+			}
+
 			client := api.NewClient(f.HttpClient,
 				f.Config.GetString("api_url"),
 				f.Config.GetString("token"),
 			)
 
-			if err := PrintTable(client, f, details); err != nil {
+			if err := PrintTable(client, f, details, output); err != nil {
 				return fmt.Errorf(msg.ErrorList.Error(), err)
 			}
 			return nil
@@ -47,50 +51,36 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 	flags := cmd.Flags()
 	flags.BoolVar(&details, "details", false, general.ApiListFlagDetails)
 	flags.BoolP("help", "h", false, msg.HelpFlag)
+	cmdutil.AddOutputFlags(f, cmd, output)
+	cmdutil.AddFilterFlags(cmd, output)
 	return cmd
 }
 
-func PrintTable(client *api.Client, f *cmdutil.Factory, details bool) error {
-	c := context.Background()
+var columns = []printer.Column{
+	{Header: "ID", Path: "uuid"},
+	{Header: "NAME", Path: "name", Truncate: true},
+	{Header: "EXPIRES AT", Path: "expires_at", Format: printer.FormatTime(constants.FORMAT_DATE)},
+	{Header: "EXPIRES IN", Path: "expires_at", Format: expiresIn},
+	{Header: "CREATED AT", Path: "created", Details: true, Format: printer.FormatTime(constants.FORMAT_DATE)},
+	{Header: "DESCRIPTION", Path: "description", Details: true, Truncate: true},
+}
 
-	resp, err := client.List(c)
+// expiresIn shows how long a token has left, so that the ones about to expire stand out
+func expiresIn(value gjson.Result) string {
+	expiresAt, err := time.Parse(time.RFC3339Nano, value.String())
 	if err != nil {
-		return err
-	}
-
-	tbl := table.New("ID", "NAME", "EXPIRES AT")
-	tbl.WithWriter(f.IOStreams.Out)
-
-	if details {
-		tbl = table.New("ID", "NAME", "EXPIRES AT", "CREATED AT", "DESCRIPTION")
-	}
-
-	headerFmt := color.New(color.FgBlue, color.Underline).SprintfFunc()
-	columnFmt := color.New(color.FgGreen).SprintfFunc()
-	tbl.WithHeaderFormatter(headerFmt).WithFirstColumnFormatter(columnFmt)
-
-	for _, v := range resp {
-		var description string
-		if v.Description.Get() != nil {
-			description = *v.Description.Get()
-		}
-
-		tbl.AddRow(
-			*v.Uuid,
-			utils.TruncateString(*v.Name),
-			v.ExpiresAt.Format(constants.FORMAT_DATE),
-			*v.Created,
-			utils.TruncateString(description),
-		)
+		return ""
 	}
+	return token.ExpiresIn(time.Until(expiresAt))
+}
 
-	format := strings.Repeat("%s", len(tbl.GetHeader())) + "\n"
-	tbl.CalculateWidths([]string{})
-	logger.PrintHeader(tbl, format)
+func PrintTable(client *api.Client, f *cmdutil.Factory, details bool, output *printer.Options) error {
+	c := context.Background()
 
-	for _, row := range tbl.GetRows() {
-		logger.PrintRow(tbl, format, row)
+	resp, err := client.List(c)
+	if err != nil {
+		return err
 	}
 
-	return nil
+	return printer.PrintList(f.IOStreams.Out, output, columns, resp, details)
 }
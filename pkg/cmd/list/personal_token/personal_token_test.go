@@ -4,6 +4,8 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/zap/zapcore"
 
 	"github.com/aziontech/azion-cli/pkg/httpmock"
@@ -72,3 +74,22 @@ func TestNewCmd(t *testing.T) {
 		})
 	}
 }
+
+func TestExpiresIn(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+
+	mock := &httpmock.Registry{}
+	mock.Register(
+		httpmock.REST("GET", "iam/personal_tokens"),
+		httpmock.JSONFromFile("./fixtures/expiring.json"),
+	)
+
+	f, stdout, _ := testutils.NewFactory(mock)
+	cmd := NewCmd(f)
+	cmd.SetArgs([]string{})
+
+	_, err := cmd.ExecuteC()
+	require.NoError(t, err)
+	assert.Regexp(t, `expired token\s+.+\s+expired`, stdout.String())
+	assert.Regexp(t, `long lived token\s+.+\s+in \d+ days`, stdout.String())
+}
@@ -4,18 +4,16 @@ import (
 	"context"
 	"fmt"
 	"strconv"
-	"strings"
 
-	"github.com/fatih/color"
 	"go.uber.org/zap"
 
 	"github.com/MakeNowJust/heredoc"
-	table "github.com/MaxwelMazur/tablecli"
 	msg "github.com/aziontech/azion-cli/messages/list/rules_engine"
 	api "github.com/aziontech/azion-cli/pkg/api/edge_applications"
 	"github.com/aziontech/azion-cli/pkg/cmdutil"
 	"github.com/aziontech/azion-cli/pkg/contracts"
 	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/printer"
 	"github.com/aziontech/azion-cli/utils"
 	"github.com/spf13/cobra"
 )
@@ -25,6 +23,7 @@ var phase string
 
 func NewCmd(f *cmdutil.Factory) *cobra.Command {
 	opts := &contracts.ListOptions{}
+	output := &printer.Options{}
 	cmd := &cobra.Command{
 		Use:           msg.RulesEngineListUsage,
 		Short:         msg.RulesEngineListShortDescription,
@@ -33,8 +32,12 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		SilenceErrors: true, Example: heredoc.Doc(`
 		$ azion list rules-engine --application-id 1673635839 --phase request
 		$ azion list rules-engine --application-id 1673635839 --phase response --details
+		$ azion list rules-engine --application-id 1673635839 --phase request --format yaml
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := output.Validate(); err != nil {
+				return err
+			}
 
 			if !cmd.Flags().Changed("application-id") {
 
@@ -62,7 +65,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 				phase = answer
 			}
 
-			if err := PrintTable(cmd, f, opts); err != nil {
+			if err := PrintTable(cmd, f, opts, output); err != nil {
 				return fmt.Errorf(msg.ErrorGetRulesEngines.Error(), err)
 			}
 			return nil
@@ -73,10 +76,21 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 	cmd.Flags().BoolP("help", "h", false, msg.RulesEngineListHelpFlag)
 	cmd.Flags().Int64Var(&edgeApplicationID, "application-id", 0, msg.ApplicationFlagId)
 	cmd.Flags().StringVar(&phase, "phase", "request", msg.RulesEnginePhase)
+	cmdutil.AddOutputFlags(f, cmd, output)
+	cmdutil.AddFilterFlags(cmd, output)
+	cmdutil.MarkPrompted(cmd, "application-id", "phase")
 	return cmd
 }
 
-func PrintTable(cmd *cobra.Command, f *cmdutil.Factory, opts *contracts.ListOptions) error {
+var columns = []printer.Column{
+	{Header: "ID", Path: "id"},
+	{Header: "NAME", Path: "name"},
+	{Header: "ORDER", Path: "order", Details: true},
+	{Header: "PHASE", Path: "phase", Details: true},
+	{Header: "ACTIVE", Path: "is_active", Details: true},
+}
+
+func PrintTable(cmd *cobra.Command, f *cmdutil.Factory, opts *contracts.ListOptions, output *printer.Options) error {
 	client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
 	ctx := context.Background()
 
@@ -85,27 +99,5 @@ func PrintTable(cmd *cobra.Command, f *cmdutil.Factory, opts *contracts.ListOpti
 		return err
 	}
 
-	tbl := table.New("ID", "NAME")
-	table.DefaultWriter = f.IOStreams.Out
-	if cmd.Flags().Changed("details") {
-		tbl = table.New("ID", "NAME", "ORDER", "PHASE", "ACTIVE")
-	}
-
-	headerFmt := color.New(color.FgBlue, color.Underline).SprintfFunc()
-	columnFmt := color.New(color.FgGreen).SprintfFunc()
-	tbl.WithHeaderFormatter(headerFmt).WithFirstColumnFormatter(columnFmt)
-
-	for _, v := range rules.Results {
-		tbl.AddRow(v.Id, v.Name, v.Order, v.Phase, v.IsActive)
-	}
-
-	format := strings.Repeat("%s", len(tbl.GetHeader())) + "\n"
-	tbl.CalculateWidths([]string{})
-	logger.PrintHeader(tbl, format)
-	for _, row := range tbl.GetRows() {
-		logger.PrintRow(tbl, format, row)
-	}
-
-	f.IOStreams.Out = table.DefaultWriter
-	return nil
+	return printer.PrintList(f.IOStreams.Out, output, columns, rules.Results, cmd.Flags().Changed("details"))
 }
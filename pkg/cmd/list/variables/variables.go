@@ -2,23 +2,20 @@ package variables
 
 import (
 	"context"
-	"strings"
-
-	"github.com/aziontech/azion-cli/utils"
 
 	"github.com/MakeNowJust/heredoc"
-	table "github.com/MaxwelMazur/tablecli"
 	"github.com/aziontech/azion-cli/messages/general"
 	msg "github.com/aziontech/azion-cli/messages/variables"
 	api "github.com/aziontech/azion-cli/pkg/api/variables"
 	"github.com/aziontech/azion-cli/pkg/cmdutil"
 	"github.com/aziontech/azion-cli/pkg/contracts"
-	"github.com/fatih/color"
+	"github.com/aziontech/azion-cli/pkg/printer"
 	"github.com/spf13/cobra"
 )
 
 func NewCmd(f *cmdutil.Factory) *cobra.Command {
 	opts := &contracts.ListOptions{}
+	output := &printer.Options{}
 
 	listCmd := &cobra.Command{
 		Use:           msg.Usage,
@@ -30,11 +27,16 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		$ azion list variables -h
 		$ azion list variables --details
 		$ azion list variables
+		$ azion list variables --format json
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := output.Validate(); err != nil {
+				return err
+			}
+
 			client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
 
-			if err := listAllVariables(client, f, opts); err != nil {
+			if err := listAllVariables(client, f, opts, output); err != nil {
 				return err
 			}
 			return nil
@@ -43,10 +45,20 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 
 	listCmd.Flags().BoolVar(&opts.Details, "details", false, general.ApiListFlagDetails)
 	listCmd.Flags().BoolP("help", "h", false, msg.VariablesListHelpFlag)
+	cmdutil.AddOutputFlags(f, listCmd, output)
+	cmdutil.AddFilterFlags(listCmd, output)
 	return listCmd
 }
 
-func listAllVariables(client *api.Client, f *cmdutil.Factory, opts *contracts.ListOptions) error {
+var columns = []printer.Column{
+	{Header: "ID", Path: "uuid"},
+	{Header: "KEY", Path: "key"},
+	{Header: "VALUE", Path: "value", Truncate: true},
+	{Header: "SECRET", Path: "secret", Details: true},
+	{Header: "LAST EDITOR", Path: "last_editor", Details: true},
+}
+
+func listAllVariables(client *api.Client, f *cmdutil.Factory, opts *contracts.ListOptions, output *printer.Options) error {
 	c := context.Background()
 
 	resp, err := client.List(c)
@@ -54,28 +66,5 @@ func listAllVariables(client *api.Client, f *cmdutil.Factory, opts *contracts.Li
 		return err
 	}
 
-	tbl := table.New("ID", "KEY", "VALUE")
-	tbl.WithWriter(f.IOStreams.Out)
-
-	if opts.Details {
-		tbl = table.New("ID", "KEY", "VALUE", "SECRET", "LAST EDITOR")
-	}
-
-	headerFmt := color.New(color.FgBlue, color.Underline).SprintfFunc()
-	columnFmt := color.New(color.FgGreen).SprintfFunc()
-	tbl.WithHeaderFormatter(headerFmt).WithFirstColumnFormatter(columnFmt)
-
-	for _, v := range resp {
-		tbl.AddRow(v.GetUuid(), v.GetKey(), utils.TruncateString(v.GetValue()), v.GetSecret(), v.GetLastEditor())
-	}
-
-	format := strings.Repeat("%s", len(tbl.GetHeader())) + "\n"
-	tbl.CalculateWidths([]string{})
-	tbl.PrintHeader(format)
-
-	for _, row := range tbl.GetRows() {
-		tbl.PrintRow(format, row)
-	}
-
-	return nil
+	return printer.PrintList(f.IOStreams.Out, output, columns, resp, opts.Details)
 }
@@ -0,0 +1,100 @@
+package wafallowedrule
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+
+	msg "github.com/aziontech/azion-cli/messages/waf_allowed_rule"
+	api "github.com/aziontech/azion-cli/pkg/api/waf"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/contracts"
+	"github.com/aziontech/azion-cli/pkg/printer"
+	"github.com/spf13/cobra"
+)
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	var wafRuleSetID int64
+	opts := &contracts.ListOptions{}
+	output := &printer.Options{}
+
+	cmd := &cobra.Command{
+		Use:           msg.Usage,
+		Aliases:       []string{"waf-exception"},
+		Short:         msg.ListShortDescription,
+		Long:          msg.ListLongDescription,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Example: heredoc.Doc(`
+		$ azion list waf-allowed-rule --waf-rule-set-id 1337
+		$ azion list waf-allowed-rule --waf-rule-set-id 1337 --details
+		$ azion list waf-exception --waf-rule-set-id 1337 --format json
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := output.Validate(); err != nil {
+				return err
+			}
+
+			if !cmd.Flags().Changed("waf-rule-set-id") {
+				return msg.ErrorMissingWafRuleSetID
+			}
+
+			if err := PrintTable(cmd, f, opts, output, wafRuleSetID); err != nil {
+				return fmt.Errorf(msg.ErrorList.Error(), err)
+			}
+			return nil
+		},
+	}
+
+	cmdutil.AddAzionApiFlags(cmd, opts)
+	cmd.Flags().Int64Var(&wafRuleSetID, "waf-rule-set-id", 0, msg.FlagWafRuleSetID)
+	cmd.Flags().BoolP("help", "h", false, msg.ListHelpFlag)
+	cmdutil.AddOutputFlags(f, cmd, output)
+	cmdutil.AddFilterFlags(cmd, output)
+	return cmd
+}
+
+var columns = []printer.Column{
+	{Header: "ID", Path: "id"},
+	{Header: "RULE ID", Path: "rule_id"},
+	{Header: "NAME", Path: "name", Truncate: true},
+	{Header: "PATH", Path: "path", Truncate: true},
+	{Header: "ACTIVE", Path: "is_active"},
+	{Header: "REASON", Path: "reason", Details: true, Truncate: true},
+	{Header: "USE REGEX", Path: "use_regex", Details: true},
+	{Header: "LAST EDITOR", Path: "last_editor", Details: true},
+	{Header: "LAST MODIFIED", Path: "last_modified", Details: true},
+}
+
+func PrintTable(cmd *cobra.Command, f *cmdutil.Factory, opts *contracts.ListOptions, output *printer.Options, wafRuleSetID int64) error {
+	client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
+	ctx := context.Background()
+	var allowedRules []api.AllowedRule
+
+	// lists that start past the first page are continuations, printed without the header
+	if opts.Page > 1 {
+		output.NoHeaders = true
+	}
+
+	for {
+		resp, err := client.ListAllowedRules(ctx, wafRuleSetID, opts)
+		if err != nil {
+			return err
+		}
+
+		allowedRules = append(allowedRules, resp.Results...)
+
+		if opts.Page >= resp.TotalPages {
+			break
+		}
+
+		if cmd.Flags().Changed("page") || cmd.Flags().Changed("page-size") {
+			break
+		}
+
+		opts.Page++
+	}
+
+	return printer.PrintList(f.IOStreams.Out, output, columns, allowedRules, opts.Details)
+}
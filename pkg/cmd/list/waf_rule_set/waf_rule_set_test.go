@@ -0,0 +1,68 @@
+package wafruleset
+
+import (
+	"testing"
+
+	"github.com/aziontech/azion-cli/pkg/httpmock"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestList(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+	t.Run("list waf rule sets", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("GET", "waf/rulesets"),
+			httpmock.JSONFromFile("./fixtures/waf_rule_sets.json"),
+		)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		assert.Equal(t,
+			"ID    NAME  MODE      ACTIVE  \n"+
+				"1337  shop  blocking  true    \n"+
+				"1338  blog  counting  false   \n",
+			stdout.String(),
+		)
+	})
+
+	t.Run("list waf rule sets with details and a filter", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("GET", "waf/rulesets"),
+			httpmock.JSONFromFile("./fixtures/waf_rule_sets.json"),
+		)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--details", "--filter", "mode=blocking"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		assert.Contains(t, stdout.String(), "192.0.2.10, 198.51.100.0/24")
+		assert.NotContains(t, stdout.String(), "blog")
+	})
+
+	t.Run("list waf rule sets with an error", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("GET", "waf/rulesets"),
+			httpmock.StatusStringResponse(500, "Internal Server Error"),
+		)
+
+		f, _, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{})
+
+		_, err := cmd.ExecuteC()
+		require.Error(t, err)
+	})
+}
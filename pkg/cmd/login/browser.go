@@ -2,62 +2,171 @@ package login
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
 	"io"
+	"net"
 	"net/http"
+	"net/url"
+	"os"
+	"runtime"
+	"strings"
+	"time"
 
 	msg "github.com/aziontech/azion-cli/messages/login"
 	"github.com/aziontech/azion-cli/pkg/cmdutil"
 	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/utils"
 	"github.com/skratchdot/open-golang/open"
 	"go.uber.org/zap"
 )
 
 const (
-	urlSsoNext = "https://sso.azion.com/login?next=cli"
+	urlSso       = "https://sso.azion.com/login"
+	callbackPath = "/callback"
 )
 
-func browserLogin(f *cmdutil.Factory) error {
-
-	ctx, cancel := context.WithCancel(context.Background())
+var (
+	// replaced by the tests
+	openURL        = open.Run
+	askCode        = utils.AskPassword
+	browserTimeout = 5 * time.Minute
+)
 
-	http.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
-		paramValue := r.URL.Query().Get("c")
-		_, _ = io.WriteString(w, msg.BrowserMsg)
-		if paramValue != "" {
-			tokenValue = paramValue
-		}
-		cancel()
-	})
+// browserLogin logs in through the browser. The CLI asks the SSO to send the token to a server
+// listening on a random port of the loopback interface, together with the state the CLI sent, so
+// that other pages can't hand it a token. Headless machines, such as SSH sessions and containers,
+// log in with a token the user copies from the browser of another device instead.
+//
+// There is no PKCE verifier: the SSO hands over the token itself rather than a code the CLI
+// exchanges, so there is no exchange for a verifier to protect. The token only travels from the
+// browser to the loopback interface, and the state, random for each login and compared in constant
+// time, keeps any page or local process from planting a token of its own
+func browserLogin(f *cmdutil.Factory, noBrowser bool) error {
+	if noBrowser || headless() {
+		return codeLogin(f)
+	}
 
-	srv := &http.Server{Addr: ":8080"}
-	err := openBrowser(f)
+	state, err := newState()
 	if err != nil {
 		return err
 	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		logger.Debug("Error while listening for the browser login, falling back to a code", zap.Error(err))
+		return codeLogin(f)
+	}
+
+	tokens := make(chan string, 1)
+	defer serve(listener, callbackHandler(state, tokens))()
+
+	callback := fmt.Sprintf("http://%s%s", listener.Addr().String(), callbackPath)
+	loginURL := ssoURL(url.Values{"redirect_uri": {callback}, "state": {state}})
+	logger.FInfo(f.IOStreams.Out, fmt.Sprintf(msg.VisitMsg, loginURL))
+	if err := openURL(loginURL); err != nil {
+		logger.Debug("Error while opening the browser", zap.Error(err))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), browserTimeout)
+	defer cancel()
+	select {
+	case tokenValue = <-tokens:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf(msg.ErrorBrowserTimeout.Error(), browserTimeout)
+	}
+}
+
+// serve handles the browser login callbacks on the listener, and returns the function that stops it
+func serve(listener net.Listener, handler http.Handler) func() {
+	srv := &http.Server{Handler: handler, ReadHeaderTimeout: 10 * time.Second}
 	go func() {
-		err := srv.ListenAndServe()
+		err := srv.Serve(listener)
 		if err != http.ErrServerClosed {
 			logger.Error(msg.ErrorServerClosed.Error(), zap.Error(err))
 		}
 	}()
+	return func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = srv.Shutdown(ctx)
+	}
+}
+
+// callbackHandler accepts the token sent along with the expected state. Other requests are
+// rejected without ending the login, as anything running on the machine may send them
+func callbackHandler(state string, tokens chan<- string) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc(callbackPath, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+
+		query := r.URL.Query()
+		if subtle.ConstantTimeCompare([]byte(query.Get("state")), []byte(state)) != 1 || query.Get("c") == "" {
+			logger.Debug("Rejected a browser login callback with an invalid state or without a token")
+			http.Error(w, msg.BrowserInvalidMsg, http.StatusBadRequest)
+			return
+		}
+
+		sendToken(w, tokens, query.Get("c"))
+	})
+	return mux
+}
 
-	<-ctx.Done() // wait for the signal to gracefully shutdown the server
+// sendToken hands the token to the pending login, which takes only the first one
+func sendToken(w http.ResponseWriter, tokens chan<- string, token string) {
+	select {
+	case tokens <- token:
+		_, _ = io.WriteString(w, msg.BrowserMsg)
+	default:
+		http.Error(w, msg.BrowserInvalidMsg, http.StatusConflict)
+	}
+}
 
-	// gracefully shutdown the server:
-	// waiting indefinitely for connections to return to idle and then shut down.
-	err = srv.Shutdown(context.Background())
+// codeLogin asks for the token the SSO sends to the CLI once the user logs in on any browser. On
+// a device that doesn't run the CLI, the redirect to localhost fails, but the token is still shown
+// in the c parameter of the address the browser was sent to
+func codeLogin(f *cmdutil.Factory) error {
+	logger.FInfo(f.IOStreams.Out, fmt.Sprintf(msg.CodeVisitMsg, ssoURL(url.Values{})))
+	code, err := askCode(msg.AskCode)
 	if err != nil {
 		return err
 	}
 
+	tokenValue = strings.TrimSpace(code)
 	return nil
 }
 
-func openBrowser(f *cmdutil.Factory) error {
-	logger.FInfo(f.IOStreams.Out, msg.VisitMsg)
-	err := open.Run(urlSsoNext)
-	if err != nil {
-		return err
+func ssoURL(params url.Values) string {
+	params.Set("next", "cli")
+	return urlSso + "?" + params.Encode()
+}
+
+// newState returns a random value the SSO sends back with the token
+func newState() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
 	}
-	return nil
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// headless tells whether the CLI runs where a browser can't be opened, as in SSH sessions or
+// in containers without a display
+func headless() bool {
+	for _, env := range []string{"SSH_CONNECTION", "SSH_CLIENT", "SSH_TTY"} {
+		if os.Getenv(env) != "" {
+			return true
+		}
+	}
+	switch runtime.GOOS {
+	case "windows", "darwin":
+		return false
+	}
+	return os.Getenv("DISPLAY") == "" && os.Getenv("WAYLAND_DISPLAY") == ""
 }
@@ -0,0 +1,123 @@
+package login
+
+import (
+	"io"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+
+	msg "github.com/aziontech/azion-cli/messages/login"
+	"github.com/aziontech/azion-cli/pkg/httpmock"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/testutils"
+)
+
+// withDisplay makes the tests run as on a machine that can open a browser
+func withDisplay(t *testing.T) {
+	t.Setenv("DISPLAY", ":0")
+	for _, env := range []string{"SSH_CONNECTION", "SSH_CLIENT", "SSH_TTY"} {
+		t.Setenv(env, "")
+	}
+	original := openURL
+	t.Cleanup(func() { openURL = original })
+}
+
+func callback(t *testing.T, redirect, state, token string) (int, string) {
+	resp, err := http.Get(redirect + "?" + url.Values{"state": {state}, "c": {token}}.Encode())
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return resp.StatusCode, string(body)
+}
+
+func TestBrowserLogin(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+
+	t.Run("token sent with the state", func(t *testing.T) {
+		withDisplay(t)
+		tokenValue = ""
+
+		openURL = func(loginURL string) error {
+			parsed, err := url.Parse(loginURL)
+			require.NoError(t, err)
+			assert.Equal(t, "sso.azion.com", parsed.Host)
+			assert.Equal(t, "cli", parsed.Query().Get("next"))
+
+			redirect, err := url.Parse(parsed.Query().Get("redirect_uri"))
+			require.NoError(t, err)
+			assert.Equal(t, "127.0.0.1", redirect.Hostname())
+			assert.NotEqual(t, "8080", redirect.Port())
+
+			state := parsed.Query().Get("state")
+			require.NotEmpty(t, state)
+
+			// run after the command waits for the callback
+			go func() {
+				status, _ := callback(t, redirect.String(), "forged", "attackertoken")
+				assert.Equal(t, http.StatusBadRequest, status)
+
+				resp, err := http.Get(redirect.String() + "?c=attackertoken")
+				if assert.NoError(t, err) {
+					resp.Body.Close()
+					assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+				}
+
+				status, body := callback(t, redirect.String(), state, "azionusertoken")
+				assert.Equal(t, http.StatusOK, status)
+				assert.Equal(t, msg.BrowserMsg, body)
+			}()
+			return nil
+		}
+
+		f, stdout, _ := testutils.NewFactory(&httpmock.Registry{})
+		require.NoError(t, browserLogin(f, false))
+		assert.Equal(t, "azionusertoken", tokenValue)
+		assert.Contains(t, stdout.String(), "Please visit https://sso.azion.com/login?")
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		withDisplay(t)
+		openURL = func(string) error { return nil }
+		original := browserTimeout
+		browserTimeout = 50 * time.Millisecond
+		t.Cleanup(func() { browserTimeout = original })
+
+		f, _, _ := testutils.NewFactory(&httpmock.Registry{})
+		err := browserLogin(f, false)
+		require.EqualError(t, err, "The browser login wasn't completed within 50ms. Run the command again, or use the --no-browser flag to log in with a copied token")
+	})
+
+	t.Run("code on headless machines", func(t *testing.T) {
+		withDisplay(t)
+		t.Setenv("SSH_CONNECTION", "10.0.0.2 52000 10.0.0.1 22")
+		openURL = func(string) error {
+			t.Error("the browser must not be opened")
+			return nil
+		}
+		original := askCode
+		askCode = func(string) (string, error) { return " azioncodetoken\n", nil }
+		t.Cleanup(func() { askCode = original })
+
+		f, stdout, _ := testutils.NewFactory(&httpmock.Registry{})
+		require.NoError(t, browserLogin(f, false))
+		assert.Equal(t, "azioncodetoken", tokenValue)
+		assert.Contains(t, stdout.String(), "https://sso.azion.com/login?next=cli ")
+	})
+
+	t.Run("code with --no-browser", func(t *testing.T) {
+		withDisplay(t)
+		original := askCode
+		askCode = func(string) (string, error) { return "azionflagtoken", nil }
+		t.Cleanup(func() { askCode = original })
+
+		f, _, _ := testutils.NewFactory(&httpmock.Registry{})
+		require.NoError(t, browserLogin(f, true))
+		assert.Equal(t, "azionflagtoken", tokenValue)
+	})
+}
@@ -2,6 +2,7 @@ package login
 
 import (
 	"strings"
+	"time"
 
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/MakeNowJust/heredoc"
@@ -9,12 +10,14 @@ import (
 	"github.com/aziontech/azion-cli/pkg/cmdutil"
 	"github.com/aziontech/azion-cli/pkg/logger"
 	"github.com/aziontech/azion-cli/pkg/token"
-	"github.com/pelletier/go-toml/v2"
+	"github.com/aziontech/azion-cli/utils"
 	"github.com/spf13/cobra"
 	"go.uber.org/zap"
 )
 
 var username, password, tokenValue, uuid string
+var noBrowser bool
+var expiresAt time.Time
 var userInfo token.UserInfo
 
 func NewCmd(f *cmdutil.Factory) *cobra.Command {
@@ -26,17 +29,18 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		Example: heredoc.Doc(`
 		$ azion login --help
 		$ azion login --username fulanodasilva@gmail.com --password "senhasecreta"
+		$ azion login --no-browser
         `),
 		RunE: func(cmd *cobra.Command, args []string) error {
 
-			answer, err := selectLoginMode()
+			answer, err := selectLoginMode(cmd)
 			if err != nil {
 				return err
 			}
 
 			switch {
 			case strings.Contains(answer, "browser"):
-				err := browserLogin(f)
+				err := browserLogin(f, noBrowser)
 				if err != nil {
 					return err
 				}
@@ -59,7 +63,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 				return err
 			}
 
-			err = saveSettings(client)
+			err = saveSettings()
 			if err != nil {
 				return err
 			}
@@ -72,17 +76,26 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 	flags := cmd.Flags()
 	flags.StringVar(&username, "username", "", msg.FlagUsername)
 	flags.StringVar(&password, "password", "", msg.FlagPassword)
+	flags.BoolVar(&noBrowser, "no-browser", false, msg.FlagNoBrowser)
 	flags.BoolP("help", "h", false, msg.FlagHelp)
+	cmdutil.MarkPrompted(cmd, "username", "password")
 
 	return cmd
 }
 
-func selectLoginMode() (string, error) {
+func selectLoginMode(cmd *cobra.Command) (string, error) {
 	answer := ""
 	prompt := &survey.Select{
 		Message: "Choose a login method:",
 		Options: []string{"Log in via browser", "Log in via terminal"},
 	}
+	if !utils.Interactive() {
+		// the browser login needs someone to use it, so only the credentials can be given
+		if cmd.Flags().Changed("username") && cmd.Flags().Changed("password") {
+			return prompt.Options[1], nil
+		}
+		return "", utils.PromptError(prompt.Message)
+	}
 	err := survey.AskOne(prompt, &answer)
 	if err != nil {
 		return "", err
@@ -90,21 +103,19 @@ func selectLoginMode() (string, error) {
 	return answer, nil
 }
 
-func saveSettings(client *token.Token) error {
-	settings := token.Settings{
-		UUID:     uuid,
-		Token:    tokenValue,
-		ClientId: userInfo.Results.ClientID,
-		Email:    userInfo.Results.Email,
-	}
-
-	byteSettings, err := toml.Marshal(settings)
+func saveSettings() error {
+	settings, err := token.ReadSettings()
 	if err != nil {
-		logger.Debug("Error while marshalling toml file", zap.Error(err))
 		return err
 	}
 
-	_, err = client.Save(byteSettings)
+	settings.UUID = uuid
+	settings.Token = tokenValue
+	settings.ExpiresAt = expiresAt
+	settings.ClientId = userInfo.Results.ClientID
+	settings.Email = userInfo.Results.Email
+
+	err = token.WriteSettings(settings)
 	if err != nil {
 		logger.Debug("Error while saving settings", zap.Error(err))
 		return err
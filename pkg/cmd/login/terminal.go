@@ -63,7 +63,7 @@ func terminalLogin(cmd *cobra.Command, f *cmdutil.Factory) error {
 	}
 
 	request := api.Request{}
-	request.SetName(username)
+	request.SetName(token.TagName(username))
 	request.SetExpiresAt(date)
 
 	clientPersonalToken := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
@@ -74,6 +74,7 @@ func terminalLogin(cmd *cobra.Command, f *cmdutil.Factory) error {
 
 	tokenValue = response.GetKey()
 	uuid = response.GetUuid()
+	expiresAt = response.GetExpiresAt()
 
 	return nil
 }
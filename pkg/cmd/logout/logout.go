@@ -2,52 +2,148 @@ package logout
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"os"
+	"strings"
 
 	"github.com/MakeNowJust/heredoc"
 	msg "github.com/aziontech/azion-cli/messages/logout"
 	api "github.com/aziontech/azion-cli/pkg/api/personal_token"
 	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/config"
+	"github.com/aziontech/azion-cli/pkg/constants"
 	"github.com/aziontech/azion-cli/pkg/logger"
 	"github.com/aziontech/azion-cli/pkg/token"
+	"github.com/aziontech/azion-cli/utils"
 	"github.com/spf13/cobra"
 )
 
+type LogoutCmd struct {
+	AllProfiles bool
+	Revoke      bool
+}
+
 func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	logout := &LogoutCmd{}
 	cmd := &cobra.Command{
-		Use:   msg.Usage,
-		Short: msg.ShortDescription,
-		Long:  msg.LongDescription,
+		Use:           msg.Usage,
+		Short:         msg.ShortDescription,
+		Long:          msg.LongDescription,
+		SilenceUsage:  true,
+		SilenceErrors: true,
 		Example: heredoc.Doc(`
 		$ azion logout --help
+		$ azion logout
+		$ azion logout --all-profiles
+		$ azion logout --revoke
         `),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			settings, err := token.ReadSettings()
-			if err != nil {
-				return err
-			}
+			return logout.Run(f)
+		},
+	}
 
-			if settings.UUID != "" {
-				client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
-				err = client.Delete(context.Background(), settings.UUID)
-				if err != nil {
-					return fmt.Errorf(msg.ErrorLogout, err.Error())
-				}
+	flags := cmd.Flags()
+	flags.BoolVar(&logout.AllProfiles, "all-profiles", false, msg.FlagAllProfiles)
+	flags.BoolVar(&logout.Revoke, "revoke", false, msg.FlagRevoke)
+	flags.BoolP("help", "h", false, msg.FlagHelp)
+	return cmd
+}
+
+// Run removes the personal tokens of the profiles with the rest of their credentials, revoking
+// them first when asked to. Profiles whose token fails to be revoked are kept logged in, so that
+// the command can be run again
+func (l *LogoutCmd) Run(f *cmdutil.Factory) error {
+	settings, err := token.ReadSettings()
+	if err != nil {
+		return err
+	}
+
+	profiles := []string{settings.ActiveProfile()}
+	if l.AllProfiles {
+		profiles = settings.ProfileNames()
+	}
+
+	var removed, failures []string
+	for _, name := range profiles {
+		profile, _ := settings.Profile(name)
+		key, err := settings.ProfileToken(name)
+		if err != nil {
+			return err
+		}
+		if key == "" && profile.UUID == "" {
+			logger.FInfo(f.IOStreams.Out, fmt.Sprintf(msg.NotLoggedIn, name))
+			continue
+		}
+
+		if l.Revoke {
+			if err := l.revoke(f, settings, name, profile, key); err != nil {
+				failures = append(failures, fmt.Sprintf(msg.ProfileFailure, name, err.Error()))
+				continue
 			}
+		}
+		settings.ClearCredentials(name)
+		if key != "" {
+			removed = append(removed, name)
+		}
+	}
 
-			settings.UUID = ""
-			settings.Token = ""
-			err = token.WriteSettings(settings)
-			if err != nil {
+	if err := token.WriteSettings(settings); err != nil {
+		return err
+	}
+	for _, name := range removed {
+		if name != settings.ActiveProfile() {
+			if err := settings.EraseToken(name); err != nil {
 				return err
 			}
+		}
+		logger.FInfo(f.IOStreams.Out, fmt.Sprintf(msg.Removed, name, settings.HelperName()))
+	}
 
-			logger.LogSuccessBad(f.IOStreams.Out, msg.Success)
-			return nil
-		},
+	if len(failures) > 0 {
+		return fmt.Errorf(msg.ErrorLogout, strings.Join(failures, "; "))
 	}
+	logger.LogSuccessBad(f.IOStreams.Out, msg.Success)
+	return nil
+}
 
-	flags := cmd.Flags()
-	flags.BoolP("help", "h", false, msg.FlagHelp)
-	return cmd
+// revoke deletes the personal token of the profile through the API, authenticated by the token
+// itself. Tokens that already expired or were deleted are reported, but don't fail the logout
+func (l *LogoutCmd) revoke(f *cmdutil.Factory, settings token.Settings, name string, profile token.Profile, key string) error {
+	if profile.UUID == "" {
+		logger.FInfo(f.IOStreams.Out, fmt.Sprintf(msg.NotRevokedUnknown, name))
+		return nil
+	}
+
+	apiURL := profileApiURL(profile)
+	if name == settings.ActiveProfile() {
+		apiURL = f.Config.GetString("api_url")
+		if key == "" {
+			key = f.Config.GetString("token")
+		}
+	}
+
+	client := api.NewClient(f.HttpClient, apiURL, key)
+	err := client.Delete(context.Background(), profile.UUID)
+	switch {
+	case err == nil:
+		logger.FInfo(f.IOStreams.Out, fmt.Sprintf(msg.Revoked, profile.UUID, name))
+	case errors.Is(err, utils.ErrorToken401), errors.Is(err, utils.ErrorNotFound404):
+		logger.FInfo(f.IOStreams.Out, fmt.Sprintf(msg.AlreadyInvalid, profile.UUID, name))
+	default:
+		return err
+	}
+	return nil
+}
+
+// profileApiURL is the API URL of a profile other than the one in use, whose settings the
+// configuration doesn't hold
+func profileApiURL(profile token.Profile) string {
+	if apiURL := os.Getenv(config.EnvName("api_url")); apiURL != "" {
+		return apiURL
+	}
+	if profile.ApiURL != "" {
+		return profile.ApiURL
+	}
+	return constants.ApiURL
 }
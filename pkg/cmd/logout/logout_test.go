@@ -0,0 +1,182 @@
+package logout
+
+import (
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/aziontech/azion-cli/pkg/config"
+	"github.com/aziontech/azion-cli/pkg/credential"
+	"github.com/aziontech/azion-cli/pkg/httpmock"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/testutils"
+	"github.com/aziontech/azion-cli/pkg/token"
+)
+
+const (
+	defaultUUID = "5b8934cf-3561-4b48-aceb-7ba52a227b6c"
+	stagingUUID = "0c7a1f9e-2d4b-4f61-9a83-5e2b7d6c1f00"
+)
+
+// withToken matches the requests authorized by the given token
+func withToken(key string, matcher httpmock.Matcher) httpmock.Matcher {
+	return func(req *http.Request) bool {
+		return matcher(req) && req.Header.Get("Authorization") == "token "+key
+	}
+}
+
+// useSettings saves a default and a staging profile, both logged in, in a temporary directory
+func useSettings(t *testing.T) {
+	require.NoError(t, config.SetPath(filepath.Join(t.TempDir(), "settings.toml")))
+	t.Cleanup(func() {
+		_ = config.SetPath(filepath.Join(config.DEFAULT_DIR, config.DEFAULT_SETTINGS))
+	})
+	require.NoError(t, token.WriteSettings(token.Settings{
+		Token: "defaulttoken",
+		UUID:  defaultUUID,
+		Email: "dev@example.com",
+		Profiles: map[string]token.Profile{
+			"staging": {Token: "stagingtoken", UUID: stagingUUID, Email: "dev@example.com"},
+		},
+	}))
+}
+
+func storedToken(t *testing.T, profile string) string {
+	stored, err := credential.New("").Get(profile)
+	require.NoError(t, err)
+	return stored
+}
+
+func TestLogout(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+
+	t.Run("log out of the profile in use", func(t *testing.T) {
+		useSettings(t)
+
+		mock := &httpmock.Registry{}
+		mock.Register(
+			withToken("defaulttoken", httpmock.REST("DELETE", "iam/personal_tokens/"+defaultUUID)),
+			httpmock.StatusStringResponse(204, ""),
+		)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		v := viper.New()
+		v.Set("token", "defaulttoken")
+		f.Config = v
+
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--revoke"})
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		mock.Verify(t)
+
+		assert.Contains(t, stdout.String(), "Revoked personal token "+defaultUUID+" of profile default\n")
+		assert.Contains(t, stdout.String(), "Removed the personal token of profile default from the file credential helper\n")
+		assert.Contains(t, stdout.String(), "successfully logged out")
+
+		settings, err := token.ReadSettings()
+		require.NoError(t, err)
+		assert.Empty(t, settings.Token)
+		assert.Empty(t, settings.UUID)
+		assert.Empty(t, settings.Email)
+		assert.Empty(t, storedToken(t, "default"))
+		assert.Equal(t, "stagingtoken", storedToken(t, "staging"))
+	})
+
+	t.Run("log out of every profile", func(t *testing.T) {
+		useSettings(t)
+
+		mock := &httpmock.Registry{}
+		mock.Register(
+			withToken("defaulttoken", httpmock.REST("DELETE", "iam/personal_tokens/"+defaultUUID)),
+			httpmock.StatusStringResponse(204, ""),
+		)
+		mock.Register(
+			withToken("stagingtoken", httpmock.REST("DELETE", "iam/personal_tokens/"+stagingUUID)),
+			httpmock.StatusStringResponse(401, "Unauthorized"),
+		)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--all-profiles", "--revoke"})
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		mock.Verify(t)
+
+		assert.Contains(t, stdout.String(), "Revoked personal token "+defaultUUID+" of profile default\n")
+		assert.Contains(t, stdout.String(), "Personal token "+stagingUUID+" of profile staging had already expired or been revoked\n")
+		assert.Contains(t, stdout.String(), "Removed the personal token of profile staging from the file credential helper\n")
+
+		settings, err := token.ReadSettings()
+		require.NoError(t, err)
+		staging, _ := settings.Profile("staging")
+		assert.Empty(t, staging.UUID)
+		assert.Empty(t, staging.Email)
+		assert.Empty(t, storedToken(t, "default"))
+		assert.Empty(t, storedToken(t, "staging"))
+	})
+
+	t.Run("keep the tokens that fail to be revoked", func(t *testing.T) {
+		useSettings(t)
+
+		mock := &httpmock.Registry{}
+		mock.Register(
+			withToken("defaulttoken", httpmock.REST("DELETE", "iam/personal_tokens/"+defaultUUID)),
+			httpmock.StatusStringResponse(204, ""),
+		)
+		mock.Register(
+			withToken("stagingtoken", httpmock.REST("DELETE", "iam/personal_tokens/"+stagingUUID)),
+			httpmock.StatusStringResponse(500, "Internal Server Error"),
+		)
+
+		f, _, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--all-profiles", "--revoke"})
+		_, err := cmd.ExecuteC()
+		require.ErrorContains(t, err, "the personal token of profile staging wasn't revoked and was kept on this machine")
+
+		assert.Empty(t, storedToken(t, "default"))
+		assert.Equal(t, "stagingtoken", storedToken(t, "staging"))
+	})
+
+	t.Run("only remove the tokens by default", func(t *testing.T) {
+		useSettings(t)
+
+		mock := &httpmock.Registry{}
+		f, stdout, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--all-profiles"})
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+
+		assert.Empty(t, mock.Requests)
+		assert.NotContains(t, stdout.String(), "Revoked")
+		assert.Empty(t, storedToken(t, "default"))
+		assert.Empty(t, storedToken(t, "staging"))
+	})
+
+	t.Run("profile that isn't logged in", func(t *testing.T) {
+		useSettings(t)
+		token.SelectProfile("staging")
+		t.Cleanup(func() { token.SelectProfile("") })
+
+		f, _, _ := testutils.NewFactory(&httpmock.Registry{})
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{})
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+
+		f, stdout, _ := testutils.NewFactory(&httpmock.Registry{})
+		cmd = NewCmd(f)
+		cmd.SetArgs([]string{})
+		_, err = cmd.ExecuteC()
+		require.NoError(t, err)
+		assert.Contains(t, stdout.String(), "Profile staging isn't logged in\n")
+		assert.Equal(t, "defaulttoken", storedToken(t, "default"))
+	})
+}
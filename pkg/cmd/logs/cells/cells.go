@@ -1,7 +1,6 @@
 package cells
 
 import (
-	"fmt"
 	"time"
 
 	"github.com/MakeNowJust/heredoc"
@@ -9,18 +8,25 @@ import (
 	"github.com/aziontech/azion-cli/pkg/api/graphql/cells"
 	"github.com/aziontech/azion-cli/pkg/cmdutil"
 	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/logsink"
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
 )
 
 var (
-	functionId string
-	tail       bool
-	pretty     bool
-	startTime  = time.Now()
-	utcTime    = startTime.UTC()
-	logTime    time.Time
-	limit      string
+	functionId   string
+	functionName string
+	project      bool
+	levels       []string
+	showLevel    func(level string) bool
+	tail         bool
+	pretty       bool
+	startTime    = time.Now()
+	utcTime      = startTime.UTC()
+	logTime      time.Time
+	limit        string
+	sinkOpts     logsink.Options
+	sink         logsink.Sink
 )
 
 func NewCmd(f *cmdutil.Factory) *cobra.Command {
@@ -34,9 +40,27 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		$ azion logs cells
 		$ azion logs cells --tail
 		$ azion logs cells --function-id 1234 --limit 10
+		$ azion logs cells --tail --output-file ./console.log
+		$ azion logs cells --project --tail
+		$ azion logs cells --function-name my-func --level ERROR,WARN
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			err := printLogs(cmd, f)
+			var err error
+			functionId, err = resolveFunctionID(cmd, f)
+			if err != nil {
+				return err
+			}
+			showLevel = levelFilter(levels)
+
+			sink, err = logsink.New(sinkOpts, f.HttpClient)
+			if err != nil {
+				return err
+			}
+			if sink != nil {
+				defer sink.Close() // nolint:all
+			}
+
+			err = printLogs(cmd, f)
 			if err != nil {
 				return err
 			}
@@ -46,9 +70,13 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 
 	cmd.Flags().BoolP("help", "h", false, msg.FlagHelp)
 	cmd.Flags().StringVar(&functionId, "function-id", "", msg.FlagFunctionId)
+	cmd.Flags().StringVar(&functionName, "function-name", "", msg.FlagFunctionName)
+	cmd.Flags().BoolVar(&project, "project", false, msg.FlagProject)
+	cmd.Flags().StringSliceVar(&levels, "level", []string{}, msg.FlagLevel)
 	cmd.Flags().StringVar(&limit, "limit", "100", msg.LimitFlag)
 	cmd.Flags().BoolVar(&tail, "tail", false, msg.FlagTail)
 	cmd.Flags().BoolVar(&pretty, "pretty", false, msg.FlagPretty)
+	logsink.AddFlags(cmd, &sinkOpts)
 	return cmd
 }
 
@@ -59,21 +87,19 @@ func printLogs(cmd *cobra.Command, f *cmdutil.Factory) error {
 		return err
 	}
 
+	batch := make([]interface{}, 0, len(resp.CellsConsoleEvents))
 	for _, event := range resp.CellsConsoleEvents {
 		if tail && logTime.After(event.Ts) {
 			continue
 		}
 
-		var colorLog color.Attribute
-
-		switch event.Level {
-		case "LOG":
-			colorLog = color.FgGreen
-		case "ERROR":
-			colorLog = color.FgRed
-		default:
-			colorLog = color.FgWhite
+		if !showLevel(event.Level) {
+			logTime = event.Ts
+			continue
 		}
+		batch = append(batch, event)
+
+		colorLog := levelColor(event.Level)
 
 		if pretty {
 			logger.FInfo(f.IOStreams.Out, "Function ID: ")
@@ -82,17 +108,25 @@ func printLogs(cmd *cobra.Command, f *cmdutil.Factory) error {
 			logger.FInfo(f.IOStreams.Out, "Timestamp: ")
 			logger.FInfo(f.IOStreams.Out, event.Ts.String())
 			logger.FInfo(f.IOStreams.Out, "\n")
+			logger.FInfo(f.IOStreams.Out, "Level: ")
+			color.New(colorLog).Fprintln(f.IOStreams.Out, event.Level)
 			logger.FInfo(f.IOStreams.Out, "Log: \n")
 			color.New(colorLog).Fprintln(f.IOStreams.Out, event.Line)
 			logger.FInfo(f.IOStreams.Out, "\n\n")
 		} else {
-			logger.FInfo(f.IOStreams.Out, fmt.Sprintf("Function ID: %s, Timestamp: %s, Log: %s \n", event.FunctionId, event.Ts.String(), event.Line))
+			logger.FInfo(f.IOStreams.Out, color.New(colorLog).Sprintf("Function ID: %s, Timestamp: %s, Level: %s, Log: %s \n", event.FunctionId, event.Ts.String(), event.Level, event.Line))
 		}
 
 		logTime = event.Ts
 
 	}
 
+	if sink != nil {
+		if err := sink.Write(batch); err != nil {
+			logger.LogWarning(f.IOStreams.Err, err.Error())
+		}
+	}
+
 	if tail {
 		logger.FInfo(f.IOStreams.Out, msg.NewLogs)
 		logger.FInfo(f.IOStreams.Out, "\n\n")
@@ -0,0 +1,83 @@
+package cells
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aziontech/azion-cli/pkg/httpmock"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/testutils"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+func graphqlWithFunction(id string) httpmock.Matcher {
+	return func(req *http.Request) bool {
+		if !httpmock.REST("POST", "events/graphql")(req) {
+			return false
+		}
+		body, _ := io.ReadAll(req.Body)
+		req.Body = io.NopCloser(bytes.NewReader(body))
+		return strings.Contains(string(body), `functionId: \"`+id+`\"`)
+	}
+}
+
+func TestNewCmd(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+
+	t.Run("resolves the function by name and filters by level", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(httpmock.REST("GET", "edge_functions"), httpmock.JSONFromFile("./fixtures/functions.json"))
+		mock.Register(graphqlWithFunction("3032"), httpmock.JSONFromFile("./fixtures/events.json"))
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--function-name", "my-func", "--level", "error"})
+
+		require.NoError(t, cmd.Execute())
+		mock.Verify(t)
+		require.Contains(t, stdout.String(), "boom")
+		require.NotContains(t, stdout.String(), "started")
+	})
+
+	t.Run("function name not found", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(httpmock.REST("GET", "edge_functions"), httpmock.JSONFromFile("./fixtures/functions.json"))
+
+		f, _, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--function-name", "unknown"})
+
+		require.ErrorContains(t, cmd.Execute(), "'unknown'")
+	})
+
+	t.Run("resolves the function of the project", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, "azion"), os.ModePerm))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "azion", "azion.json"), []byte(`{"function": {"id": 3032}}`), 0644))
+
+		wd, _ := os.Getwd()
+		require.NoError(t, os.Chdir(dir))
+		defer os.Chdir(wd) // nolint:all
+
+		mock := &httpmock.Registry{}
+		mock.Register(graphqlWithFunction("3032"), httpmock.JSONFromFile(filepath.Join(wd, "fixtures", "events.json")))
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--project", "--function-id", "1234"})
+
+		require.ErrorContains(t, cmd.Execute(), "only one of")
+
+		cmd = NewCmd(f)
+		cmd.SetArgs([]string{"--project"})
+		require.NoError(t, cmd.Execute())
+		mock.Verify(t)
+		require.Contains(t, stdout.String(), "started")
+	})
+}
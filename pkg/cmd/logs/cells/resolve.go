@@ -0,0 +1,104 @@
+package cells
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	msg "github.com/aziontech/azion-cli/messages/logs/cells"
+	api "github.com/aziontech/azion-cli/pkg/api/edge_function"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/contracts"
+	"github.com/aziontech/azion-cli/utils"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// resolveFunctionID returns the ID of the function whose logs are shown, taken from
+// --function-id, --project or --function-name; an empty ID means all functions
+func resolveFunctionID(cmd *cobra.Command, f *cmdutil.Factory) (string, error) {
+	changed := 0
+	for _, name := range []string{"function-id", "function-name", "project"} {
+		if cmd.Flags().Changed(name) {
+			changed++
+		}
+	}
+	if changed > 1 {
+		return "", msg.ErrorMultipleFunctions
+	}
+
+	switch {
+	case project:
+		conf, err := utils.GetAzionJsonContent()
+		if err != nil {
+			return "", err
+		}
+		if conf.Function.ID == 0 {
+			return "", msg.ErrorProjectNoFunction
+		}
+		return strconv.FormatInt(conf.Function.ID, 10), nil
+	case functionName != "":
+		return findFunctionByName(f, functionName)
+	default:
+		return functionId, nil
+	}
+}
+
+func findFunctionByName(f *cmdutil.Factory, name string) (string, error) {
+	client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
+	opts := &contracts.ListOptions{Page: 1, PageSize: 100}
+
+	for {
+		resp, err := client.List(context.Background(), opts)
+		if err != nil {
+			return "", fmt.Errorf(msg.ErrorListFunctions.Error(), err)
+		}
+
+		for _, function := range resp.GetResults() {
+			if function.GetName() == name {
+				return strconv.FormatInt(function.GetId(), 10), nil
+			}
+		}
+
+		if opts.Page >= resp.GetTotalPages() {
+			break
+		}
+		opts.Page++
+	}
+
+	return "", fmt.Errorf(msg.ErrorFunctionNotFound.Error(), name)
+}
+
+// levelFilter reports whether an event of the given level should be displayed
+func levelFilter(levels []string) func(level string) bool {
+	if len(levels) == 0 {
+		return func(string) bool { return true }
+	}
+
+	allowed := make(map[string]bool, len(levels))
+	for _, l := range levels {
+		allowed[strings.ToUpper(strings.TrimSpace(l))] = true
+	}
+
+	return func(level string) bool {
+		return allowed[strings.ToUpper(level)]
+	}
+}
+
+func levelColor(level string) color.Attribute {
+	switch strings.ToUpper(level) {
+	case "ERROR":
+		return color.FgRed
+	case "WARN", "WARNING":
+		return color.FgYellow
+	case "INFO":
+		return color.FgCyan
+	case "DEBUG":
+		return color.FgMagenta
+	case "LOG":
+		return color.FgGreen
+	default:
+		return color.FgWhite
+	}
+}
@@ -9,17 +9,24 @@ import (
 	"github.com/aziontech/azion-cli/pkg/api/graphql/http"
 	"github.com/aziontech/azion-cli/pkg/cmdutil"
 	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/logsink"
+	"github.com/aziontech/azion-cli/pkg/printer"
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
 )
 
 var (
-	tail      bool
-	pretty    bool
-	startTime = time.Now()
-	utcTime   = startTime.UTC()
-	logTime   time.Time
-	limit     string
+	tail        bool
+	pretty      bool
+	startTime   = time.Now()
+	utcTime     = startTime.UTC()
+	logTime     time.Time
+	limit       string
+	sinkOpts    logsink.Options
+	sink        logsink.Sink
+	summaryFlag bool
+	since       string
+	top         int
 )
 
 func NewCmd(f *cmdutil.Factory) *cobra.Command {
@@ -32,9 +39,26 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		SilenceErrors: true, Example: heredoc.Doc(`
 		$ azion logs http
 		$ azion logs http --tail
+		$ azion logs http --summary --since 15m --top 5
+		$ azion logs http --summary --format json
+		$ azion logs http --tail --output-file ./events.log --max-file-size 50
+		$ azion logs http --tail --forward-udp 127.0.0.1:5140 --forward-http https://collector.example.com/events
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			err := printLogs(cmd, f)
+			if summaryFlag {
+				return printLogsSummary(f)
+			}
+
+			var err error
+			sink, err = logsink.New(sinkOpts, f.HttpClient)
+			if err != nil {
+				return err
+			}
+			if sink != nil {
+				defer sink.Close() // nolint:all
+			}
+
+			err = printLogs(cmd, f)
 			if err != nil {
 				return err
 			}
@@ -46,9 +70,47 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 	cmd.Flags().StringVar(&limit, "limit", "100", msg.LimitFlag)
 	cmd.Flags().BoolVar(&tail, "tail", false, msg.FlagTail)
 	cmd.Flags().BoolVar(&pretty, "pretty", false, msg.FlagPretty)
+	logsink.AddFlags(cmd, &sinkOpts)
+	cmd.Flags().BoolVar(&summaryFlag, "summary", false, msg.FlagSummary)
+	cmd.Flags().StringVar(&since, "since", "5m", msg.FlagSince)
+	cmd.Flags().IntVar(&top, "top", 10, msg.FlagTop)
 	return cmd
 }
 
+func printLogsSummary(f *cmdutil.Factory) error {
+	if tail {
+		return msg.ErrorSummaryTail
+	}
+
+	window, err := time.ParseDuration(since)
+	if err != nil || window <= 0 {
+		return msg.ErrorInvalidSince
+	}
+
+	if top <= 0 {
+		return msg.ErrorInvalidTop
+	}
+
+	switch f.Format {
+	case "", printer.FormatTable, printer.FormatJSON, printer.FormatYAML:
+	default:
+		return msg.ErrorSummaryFormat
+	}
+
+	end := time.Now().UTC()
+	begin := end.Add(-window)
+
+	events, err := fetchWindow(f, begin, end, limit)
+	if err != nil {
+		return err
+	}
+
+	s := summarize(events, top)
+	s.Begin = begin
+	s.End = end
+	return printSummary(f, s)
+}
+
 func printLogs(cmd *cobra.Command, f *cmdutil.Factory) error {
 
 	resp, err := http.HttpEvents(f, logTime, limit)
@@ -56,10 +118,12 @@ func printLogs(cmd *cobra.Command, f *cmdutil.Factory) error {
 		return err
 	}
 
+	batch := make([]interface{}, 0, len(resp.HTTPEvents))
 	for _, event := range resp.HTTPEvents {
 		if tail && logTime.After(event.Ts) {
 			continue
 		}
+		batch = append(batch, event)
 
 		colorLog := color.FgGreen
 
@@ -100,6 +164,12 @@ func printLogs(cmd *cobra.Command, f *cmdutil.Factory) error {
 
 	}
 
+	if sink != nil {
+		if err := sink.Write(batch); err != nil {
+			logger.LogWarning(f.IOStreams.Err, err.Error())
+		}
+	}
+
 	if tail {
 		logger.FInfo(f.IOStreams.Out, msg.NewLogs)
 		logger.FInfo(f.IOStreams.Out, "\n\n")
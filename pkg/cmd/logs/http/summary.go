@@ -0,0 +1,187 @@
+package http
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/MaxwelMazur/tablecli"
+	msg "github.com/aziontech/azion-cli/messages/logs/http"
+	"github.com/aziontech/azion-cli/pkg/api/graphql/http"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/printer"
+	"github.com/fatih/color"
+)
+
+type entry struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+type summary struct {
+	Events     int       `json:"events"`
+	Status     []entry   `json:"status"`
+	URIs       []entry   `json:"uris"`
+	UserAgents []entry   `json:"user_agents"`
+	Regions    []entry   `json:"regions"`
+	P50        float64   `json:"request_time_p50"`
+	P95        float64   `json:"request_time_p95"`
+	P99        float64   `json:"request_time_p99"`
+	Begin      time.Time `json:"begin"`
+	End        time.Time `json:"end"`
+}
+
+// maxSummaryEvents limits the events a summary reads, as all of them are held in memory. Tests
+// lower it
+var maxSummaryEvents = 10000
+
+// fetchWindow pulls the events between begin and end a page at a time. The pages are taken by
+// offset, as many events share the same second and the timestamps of the filter have no fraction
+func fetchWindow(f *cmdutil.Factory, begin, end time.Time, limitFlag string) ([]http.HTTPEvent, error) {
+	pageSize, err := strconv.Atoi(limitFlag)
+	if err != nil || pageSize <= 0 {
+		pageSize = 100
+	}
+
+	var events []http.HTTPEvent
+	for {
+		resp, err := http.HttpEventsWindow(f, begin, end, pageSize, len(events))
+		if err != nil {
+			return nil, err
+		}
+
+		events = append(events, resp.HTTPEvents...)
+		if len(resp.HTTPEvents) < pageSize {
+			return events, nil
+		}
+		if len(events) >= maxSummaryEvents {
+			return events, warnTruncated(f, begin, end, len(events))
+		}
+	}
+}
+
+// warnTruncated warns that the summary leaves events out, once the API shows there's one after
+// the last event read. The API doesn't tell whether a full page is the last one
+func warnTruncated(f *cmdutil.Factory, begin, end time.Time, read int) error {
+	next, err := http.HttpEventsWindow(f, begin, end, 1, read)
+	if err != nil {
+		return err
+	}
+	if len(next.HTTPEvents) > 0 {
+		logger.LogWarning(f.IOStreams.Err, fmt.Sprintf(msg.WarningSummaryTruncated, read))
+	}
+	return nil
+}
+
+func summarize(events []http.HTTPEvent, top int) summary {
+	status := map[string]int{}
+	uris := map[string]int{}
+	agents := map[string]int{}
+	regions := map[string]int{}
+	times := make([]float64, 0, len(events))
+
+	for _, event := range events {
+		status[strconv.Itoa(event.Status)]++
+		uris[event.RequestURI]++
+		agents[event.HTTPUserAgent]++
+		regions[event.GeolocRegion]++
+
+		if t, err := strconv.ParseFloat(event.RequestTime, 64); err == nil {
+			times = append(times, t)
+		}
+	}
+	sort.Float64s(times)
+
+	statusHistogram := ranking(status, 0)
+	sort.Slice(statusHistogram, func(i, j int) bool { return statusHistogram[i].Name < statusHistogram[j].Name })
+
+	return summary{
+		Events:     len(events),
+		Status:     statusHistogram,
+		URIs:       ranking(uris, top),
+		UserAgents: ranking(agents, top),
+		Regions:    ranking(regions, top),
+		P50:        percentile(times, 0.50),
+		P95:        percentile(times, 0.95),
+		P99:        percentile(times, 0.99),
+	}
+}
+
+// ranking sorts the counters by count, then name, and keeps the first top entries; top <= 0 keeps all of them
+func ranking(counters map[string]int, top int) []entry {
+	entries := make([]entry, 0, len(counters))
+	for name, count := range counters {
+		entries = append(entries, entry{Name: name, Count: count})
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Count != entries[j].Count {
+			return entries[i].Count > entries[j].Count
+		}
+		return entries[i].Name < entries[j].Name
+	})
+
+	if top > 0 && len(entries) > top {
+		entries = entries[:top]
+	}
+	return entries
+}
+
+// percentile returns the nearest-rank percentile of an already sorted slice
+func percentile(sorted []float64, p float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(p*float64(len(sorted)))) - 1
+	if rank < 0 {
+		rank = 0
+	}
+	return sorted[rank]
+}
+
+func printSummary(f *cmdutil.Factory, s summary) error {
+	if f.Format == printer.FormatJSON || f.Format == printer.FormatYAML {
+		out, err := printer.Marshal(f.Format, s)
+		if err != nil {
+			return err
+		}
+		if f.Format == printer.FormatJSON {
+			out = append(out, '\n')
+		}
+		_, err = f.IOStreams.Out.Write(out)
+		return err
+	}
+
+	tbl := tablecli.New("", "")
+	tbl.WithFirstColumnFormatter(color.New(color.FgGreen).SprintfFunc())
+	tbl.AddRow(msg.SummaryWindow, fmt.Sprintf("%s - %s", s.Begin.Format(time.RFC3339), s.End.Format(time.RFC3339)))
+	tbl.AddRow(msg.SummaryEvents, s.Events)
+	tbl.AddRow(msg.SummaryRequestTime, fmt.Sprintf("%.3fs / %.3fs / %.3fs", s.P50, s.P95, s.P99))
+	if _, err := f.IOStreams.Out.Write(tbl.GetByteFormat()); err != nil {
+		return err
+	}
+
+	printRanking(f, msg.SummaryStatus, s.Status)
+	printRanking(f, msg.SummaryURIs, s.URIs)
+	printRanking(f, msg.SummaryUserAgents, s.UserAgents)
+	printRanking(f, msg.SummaryRegions, s.Regions)
+	return nil
+}
+
+func printRanking(f *cmdutil.Factory, title string, entries []entry) {
+	if len(entries) == 0 {
+		return
+	}
+
+	logger.FInfo(f.IOStreams.Out, "\n")
+	tbl := tablecli.New(title, msg.SummaryCount)
+	tbl.WithWriter(f.IOStreams.Out)
+	tbl.WithHeaderFormatter(color.New(color.FgBlue, color.Underline).SprintfFunc())
+	for _, e := range entries {
+		tbl.AddRow(e.Name, e.Count)
+	}
+	tbl.Print()
+}
@@ -0,0 +1,157 @@
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	nethttp "net/http"
+	"strings"
+	"testing"
+	"time"
+
+	msg "github.com/aziontech/azion-cli/messages/logs/http"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/httpmock"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/testutils"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+const firstPage = `{"data": {"httpEvents": [
+	{"host": "a.com", "httpUserAgent": "curl", "geolocRegionName": "Sao Paulo", "requestUri": "/", "status": 200, "ts": "%[1]s", "requestTime": "0.010"},
+	{"host": "a.com", "httpUserAgent": "curl", "geolocRegionName": "Sao Paulo", "requestUri": "/", "status": 200, "ts": "%[2]s", "requestTime": "0.020"}
+]}}`
+
+const secondPage = `{"data": {"httpEvents": [
+	{"host": "a.com", "httpUserAgent": "firefox", "geolocRegionName": "Parana", "requestUri": "/missing", "status": 404, "ts": "%[3]s", "requestTime": "0.500"}
+]}}`
+
+// query matches the graphql request holding all the fragments
+func query(fragments ...string) httpmock.Matcher {
+	return func(req *nethttp.Request) bool {
+		if !httpmock.REST("POST", "events/graphql")(req) {
+			return false
+		}
+		body, _ := io.ReadAll(req.Body)
+		req.Body = io.NopCloser(bytes.NewReader(body))
+		for _, fragment := range fragments {
+			if !strings.Contains(string(body), fragment) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+func TestSummary(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	// the events of the first page share the same second, which must not lose the ones after them
+	ts := []interface{}{
+		now.Add(-2 * time.Second).Format(time.RFC3339),
+		now.Add(-2 * time.Second).Format(time.RFC3339),
+		now.Add(-2 * time.Second).Format(time.RFC3339),
+	}
+
+	t.Run("pages of the window", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(query("limit: 2", "offset: 0", "tsRange"), httpmock.JSONFromString(fmt.Sprintf(firstPage, ts...)))
+		mock.Register(query("limit: 2", "offset: 2", "tsRange"), httpmock.JSONFromString(fmt.Sprintf(secondPage, ts...)))
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--summary", "--limit", "2", "--top", "1"})
+
+		err := cmd.Execute()
+		require.NoError(t, err)
+		mock.Verify(t)
+
+		out := stdout.String()
+		require.Regexp(t, `Events:\s+3 `, out)
+		require.Contains(t, out, "0.020s / 0.500s / 0.500s")
+		require.Contains(t, out, "404")
+		require.Contains(t, out, "Sao Paulo")
+		require.NotContains(t, out, "Parana")
+		require.NotContains(t, out, "first")
+	})
+
+	t.Run("summary as json", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(query("limit: 2", "offset: 0"), httpmock.JSONFromString(fmt.Sprintf(firstPage, ts...)))
+		mock.Register(query("limit: 2", "offset: 2"), httpmock.JSONFromString(fmt.Sprintf(secondPage, ts...)))
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmdutil.AddFormatFlag(cmd, f)
+		cmd.SetArgs([]string{"--summary", "--limit", "2", "--top", "1", "--format", "json"})
+
+		err := cmd.Execute()
+		require.NoError(t, err)
+
+		var s summary
+		require.NoError(t, json.Unmarshal(stdout.Bytes(), &s))
+		require.Equal(t, 3, s.Events)
+		require.Equal(t, []entry{{Name: "Sao Paulo", Count: 2}}, s.Regions)
+		require.Equal(t, 0.5, s.P99)
+	})
+
+	t.Run("invalid format", func(t *testing.T) {
+		f, _, _ := testutils.NewFactory(&httpmock.Registry{})
+		cmd := NewCmd(f)
+		cmdutil.AddFormatFlag(cmd, f)
+		cmd.SetArgs([]string{"--summary", "--format", "csv"})
+
+		err := cmd.Execute()
+		require.ErrorIs(t, err, msg.ErrorSummaryFormat)
+	})
+
+	t.Run("warn about the events left out", func(t *testing.T) {
+		original := maxSummaryEvents
+		maxSummaryEvents = 2
+		t.Cleanup(func() { maxSummaryEvents = original })
+
+		mock := &httpmock.Registry{}
+		mock.Register(query("limit: 2", "offset: 0"), httpmock.JSONFromString(fmt.Sprintf(firstPage, ts...)))
+		mock.Register(query("limit: 1", "offset: 2"), httpmock.JSONFromString(fmt.Sprintf(secondPage, ts...)))
+
+		f, stdout, stderr := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--summary", "--limit", "2"})
+
+		err := cmd.Execute()
+		require.NoError(t, err)
+		mock.Verify(t)
+		require.Contains(t, stderr.String(), "The summary covers only the first 2 events of the window")
+		require.NotContains(t, stdout.String(), "The summary covers only")
+	})
+
+	t.Run("no warning when the window ends with a full page", func(t *testing.T) {
+		original := maxSummaryEvents
+		maxSummaryEvents = 2
+		t.Cleanup(func() { maxSummaryEvents = original })
+
+		mock := &httpmock.Registry{}
+		mock.Register(query("limit: 2", "offset: 0"), httpmock.JSONFromString(fmt.Sprintf(firstPage, ts...)))
+		mock.Register(query("limit: 1", "offset: 2"), httpmock.JSONFromString(`{"data": {"httpEvents": []}}`))
+
+		f, _, stderr := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--summary", "--limit", "2"})
+
+		err := cmd.Execute()
+		require.NoError(t, err)
+		mock.Verify(t)
+		require.NotContains(t, stderr.String(), "The summary covers only")
+	})
+}
+
+func TestPercentile(t *testing.T) {
+	require.Equal(t, float64(0), percentile(nil, 0.5))
+	values := []float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	require.Equal(t, float64(5), percentile(values, 0.50))
+	require.Equal(t, float64(10), percentile(values, 0.95))
+	require.Equal(t, float64(1), percentile(values, 0))
+}
@@ -0,0 +1,161 @@
+package metrics
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/MaxwelMazur/tablecli"
+	msg "github.com/aziontech/azion-cli/messages/metrics"
+	api "github.com/aziontech/azion-cli/pkg/api/graphql/metrics"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/printer"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// formatSparkline is the value the metrics command accepts for --format besides the ones of pkg/printer
+const formatSparkline = "sparkline"
+
+type Fields struct {
+	ApplicationID int64
+	Domain        string
+	Since         string
+	Top           int
+}
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	fields := &Fields{}
+
+	cmd := &cobra.Command{
+		Use:           msg.Usage,
+		Short:         msg.ShortDescription,
+		Long:          msg.LongDescription,
+		SilenceUsage:  true,
+		SilenceErrors: true, Example: heredoc.Doc(`
+		$ azion metrics
+		$ azion metrics --application-id 1673635839 --since 24h
+		$ azion metrics --domain www.example.com --top 10 --format json
+		$ azion metrics --application-id 1673635839 --format sparkline
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			since, err := time.ParseDuration(fields.Since)
+			if err != nil || since <= 0 {
+				return msg.ErrorInvalidSince
+			}
+
+			if fields.Top <= 0 {
+				return msg.ErrorInvalidTop
+			}
+
+			switch f.Format {
+			case "", printer.FormatTable, printer.FormatJSON, printer.FormatYAML, formatSparkline:
+			default:
+				return msg.ErrorInvalidFormat
+			}
+
+			end := time.Now().UTC()
+			filter := api.Filter{
+				Begin:         end.Add(-since),
+				End:           end,
+				ApplicationID: fields.ApplicationID,
+				Domain:        fields.Domain,
+				Top:           fields.Top,
+			}
+
+			resp, err := api.HttpMetrics(f, filter)
+			if err != nil {
+				return err
+			}
+
+			return printSummary(f, f.Format, summarize(resp, filter))
+		},
+	}
+
+	cmd.Flags().BoolP("help", "h", false, msg.FlagHelp)
+	cmd.Flags().Int64Var(&fields.ApplicationID, "application-id", 0, msg.FlagApplicationID)
+	cmd.Flags().StringVar(&fields.Domain, "domain", "", msg.FlagDomain)
+	cmd.Flags().StringVar(&fields.Since, "since", "1h", msg.FlagSince)
+	cmd.Flags().IntVar(&fields.Top, "top", 5, msg.FlagTop)
+	return cmd
+}
+
+func printSummary(f *cmdutil.Factory, format string, summary Summary) error {
+	switch format {
+	case printer.FormatJSON, printer.FormatYAML:
+		out, err := printer.Marshal(format, summary)
+		if err != nil {
+			return err
+		}
+		if format == printer.FormatJSON {
+			out = append(out, '\n')
+		}
+		_, err = f.IOStreams.Out.Write(out)
+		return err
+	case formatSparkline:
+		requests := make([]float64, 0, len(summary.Series))
+		bandwidth := make([]float64, 0, len(summary.Series))
+		for _, p := range summary.Series {
+			requests = append(requests, float64(p.Requests))
+			bandwidth = append(bandwidth, p.BytesSent)
+		}
+		logger.FInfo(f.IOStreams.Out, fmt.Sprintf("%s%s %d\n", msg.SparkRequests, sparkline(requests), summary.Requests))
+		logger.FInfo(f.IOStreams.Out, fmt.Sprintf("%s%s %s\n", msg.SparkBandwidth, sparkline(bandwidth), humanBytes(summary.BytesSent)))
+	default:
+		return printTable(f, summary)
+	}
+
+	return nil
+}
+
+func printTable(f *cmdutil.Factory, summary Summary) error {
+	tbl := tablecli.New("", "")
+	tbl.WithFirstColumnFormatter(color.New(color.FgGreen).SprintfFunc())
+	tbl.AddRow(msg.TitleWindow, fmt.Sprintf("%s - %s", summary.Begin.Format(time.RFC3339), summary.End.Format(time.RFC3339)))
+	tbl.AddRow(msg.TitleRequests, summary.Requests)
+
+	classes := make([]string, 0, len(summary.StatusClasses))
+	for class := range summary.StatusClasses {
+		classes = append(classes, class)
+	}
+	sort.Strings(classes)
+	for _, class := range classes {
+		count := summary.StatusClasses[class]
+		tbl.AddRow(fmt.Sprintf(msg.TitleStatus, class), fmt.Sprintf("%d (%.2f%%)", count, ratio(count, summary.Requests)*100))
+	}
+
+	tbl.AddRow(msg.TitleBandwidth, humanBytes(summary.BytesSent))
+	tbl.AddRow(msg.TitleCacheHit, fmt.Sprintf("%.2f%%", summary.CacheHitRatio*100))
+	tbl.AddRow(msg.TitleRequestTime, fmt.Sprintf("%.3fs / %.3fs / %.3fs", summary.RequestTime.P50, summary.RequestTime.P95, summary.RequestTime.P99))
+	if _, err := f.IOStreams.Out.Write(tbl.GetByteFormat()); err != nil {
+		return err
+	}
+
+	printRanking(f, msg.TitleTopURIs, summary.TopURIs)
+	printRanking(f, msg.TitleTopCountries, summary.TopCountries)
+	return nil
+}
+
+func printRanking(f *cmdutil.Factory, title string, entries []Entry) {
+	if len(entries) == 0 {
+		return
+	}
+
+	logger.FInfo(f.IOStreams.Out, "\n")
+	tbl := tablecli.New(title, "REQUESTS")
+	tbl.WithWriter(f.IOStreams.Out)
+	tbl.WithHeaderFormatter(color.New(color.FgBlue, color.Underline).SprintfFunc())
+	for _, e := range entries {
+		tbl.AddRow(e.Name, e.Count)
+	}
+	tbl.Print()
+}
+
+func ratio(part, total int64) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(part) / float64(total)
+}
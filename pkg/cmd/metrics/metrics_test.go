@@ -0,0 +1,146 @@
+package metrics
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/httpmock"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/testutils"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestNewCmd(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+
+	t.Run("aggregates metrics as json", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("POST", "metrics/graphql"),
+			httpmock.JSONFromFile("./fixtures/response.json"),
+		)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmdutil.AddFormatFlag(cmd, f)
+		cmd.SetArgs([]string{"--application-id", "1234", "--format", "json"})
+
+		err := cmd.Execute()
+		require.NoError(t, err)
+
+		var summary Summary
+		require.NoError(t, json.Unmarshal(stdout.Bytes(), &summary))
+		require.Equal(t, int64(100), summary.Requests)
+		require.Equal(t, int64(90), summary.StatusClasses["2xx"])
+		require.Equal(t, int64(6), summary.StatusClasses["4xx"])
+		require.Equal(t, int64(4), summary.StatusClasses["5xx"])
+		require.Equal(t, float64(4096), summary.BytesSent)
+		require.Equal(t, 0.75, summary.CacheHitRatio)
+		require.Equal(t, Percentiles{P50: 0.01, P95: 0.1, P99: 1.5}, summary.RequestTime)
+		require.Len(t, summary.Series, 2)
+		require.Equal(t, []Entry{{Name: "/", Count: 70}, {Name: "/index.html", Count: 30}}, summary.TopURIs)
+	})
+
+	t.Run("aggregates metrics as yaml", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("POST", "metrics/graphql"),
+			httpmock.JSONFromFile("./fixtures/response.json"),
+		)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmdutil.AddFormatFlag(cmd, f)
+		cmd.SetArgs([]string{"--application-id", "1234", "--format", "yaml"})
+
+		err := cmd.Execute()
+		require.NoError(t, err)
+		require.Contains(t, stdout.String(), "requests: 100\n")
+		require.Contains(t, stdout.String(), "cache_hit_ratio: 0.75\n")
+	})
+
+	t.Run("prints a table", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("POST", "metrics/graphql"),
+			httpmock.JSONFromFile("./fixtures/response.json"),
+		)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmdutil.AddFormatFlag(cmd, f)
+		cmd.SetArgs([]string{"--domain", "www.example.com"})
+
+		err := cmd.Execute()
+		require.NoError(t, err)
+		require.Contains(t, stdout.String(), "75.00%")
+		require.Contains(t, stdout.String(), "/index.html")
+	})
+
+	t.Run("quote the domain in the query", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			func(req *http.Request) bool {
+				if !httpmock.REST("POST", "metrics/graphql")(req) {
+					return false
+				}
+				var body struct {
+					Query string `json:"query"`
+				}
+				require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+				require.Contains(t, body.Query, `host: "a.com\" } evil: { \"b"`)
+				require.Contains(t, body.Query, `configurationId: "1234"`)
+				return true
+			},
+			httpmock.JSONFromFile("./fixtures/response.json"),
+		)
+
+		f, _, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmdutil.AddFormatFlag(cmd, f)
+		cmd.SetArgs([]string{"--application-id", "1234", "--domain", `a.com" } evil: { "b`, "--format", "json"})
+
+		err := cmd.Execute()
+		require.NoError(t, err)
+		mock.Verify(t)
+	})
+
+	t.Run("invalid application id", func(t *testing.T) {
+		f, _, _ := testutils.NewFactory(&httpmock.Registry{})
+		cmd := NewCmd(f)
+		cmdutil.AddFormatFlag(cmd, f)
+		cmd.SetArgs([]string{"--application-id", `1" } host: { "x`})
+
+		err := cmd.Execute()
+		require.ErrorContains(t, err, "--application-id")
+	})
+
+	t.Run("invalid since", func(t *testing.T) {
+		f, _, _ := testutils.NewFactory(&httpmock.Registry{})
+		cmd := NewCmd(f)
+		cmdutil.AddFormatFlag(cmd, f)
+		cmd.SetArgs([]string{"--since", "yesterday"})
+
+		err := cmd.Execute()
+		require.ErrorContains(t, err, "--since")
+	})
+
+	t.Run("invalid format", func(t *testing.T) {
+		f, _, _ := testutils.NewFactory(&httpmock.Registry{})
+		cmd := NewCmd(f)
+		cmdutil.AddFormatFlag(cmd, f)
+		cmd.SetArgs([]string{"--format", "xml"})
+
+		err := cmd.Execute()
+		require.ErrorContains(t, err, "--format")
+	})
+}
+
+func TestSparkline(t *testing.T) {
+	require.Equal(t, "", sparkline(nil))
+	require.Equal(t, "▁▁▁", sparkline([]float64{3, 3, 3}))
+	require.Equal(t, "▁▄█", sparkline([]float64{0, 5, 10}))
+}
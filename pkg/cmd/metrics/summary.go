@@ -0,0 +1,189 @@
+package metrics
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	api "github.com/aziontech/azion-cli/pkg/api/graphql/metrics"
+)
+
+var sparkTicks = []rune("▁▂▃▄▅▆▇█")
+
+type Entry struct {
+	Name  string `json:"name"`
+	Count int64  `json:"count"`
+}
+
+type Percentiles struct {
+	P50 float64 `json:"p50"`
+	P95 float64 `json:"p95"`
+	P99 float64 `json:"p99"`
+}
+
+type Point struct {
+	Ts        time.Time `json:"ts"`
+	Requests  int64     `json:"requests"`
+	BytesSent float64   `json:"bytes_sent"`
+}
+
+type Summary struct {
+	Begin         time.Time        `json:"begin"`
+	End           time.Time        `json:"end"`
+	Requests      int64            `json:"requests"`
+	StatusClasses map[string]int64 `json:"status_classes"`
+	BytesSent     float64          `json:"bytes_sent"`
+	CacheHitRatio float64          `json:"cache_hit_ratio"`
+	TopURIs       []Entry          `json:"top_uris"`
+	TopCountries  []Entry          `json:"top_countries"`
+	RequestTime   Percentiles      `json:"request_time"`
+	Series        []Point          `json:"series"`
+}
+
+// summarize turns the raw aggregated rows returned by the metrics API into the
+// numbers displayed by the command
+func summarize(resp api.HTTPMetricsResponse, filter api.Filter) Summary {
+	summary := Summary{
+		Begin:         filter.Begin,
+		End:           filter.End,
+		StatusClasses: map[string]int64{},
+		TopURIs:       []Entry{},
+		TopCountries:  []Entry{},
+		Series:        []Point{},
+	}
+
+	for _, row := range resp.ByStatus {
+		summary.Requests += row.Count
+		summary.StatusClasses[statusClass(row.Status)] += row.Count
+	}
+
+	points := map[time.Time]*Point{}
+	for _, row := range resp.ByTime {
+		points[row.Ts] = &Point{Ts: row.Ts, Requests: row.Count}
+	}
+	for _, row := range resp.Bandwidth {
+		summary.BytesSent += row.Sum
+		if p, ok := points[row.Ts]; ok {
+			p.BytesSent = row.Sum
+			continue
+		}
+		points[row.Ts] = &Point{Ts: row.Ts, BytesSent: row.Sum}
+	}
+	for _, p := range points {
+		summary.Series = append(summary.Series, *p)
+	}
+	sort.Slice(summary.Series, func(i, j int) bool {
+		return summary.Series[i].Ts.Before(summary.Series[j].Ts)
+	})
+
+	var hits, cacheTotal int64
+	for _, row := range resp.ByCache {
+		cacheTotal += row.Count
+		if strings.EqualFold(row.UpstreamCacheStatus, "HIT") {
+			hits += row.Count
+		}
+	}
+	if cacheTotal > 0 {
+		summary.CacheHitRatio = float64(hits) / float64(cacheTotal)
+	}
+
+	for _, row := range resp.TopURIs {
+		summary.TopURIs = append(summary.TopURIs, Entry{Name: row.RequestURI, Count: row.Count})
+	}
+	for _, row := range resp.TopCountries {
+		summary.TopCountries = append(summary.TopCountries, Entry{Name: row.GeolocCountryName, Count: row.Count})
+	}
+
+	summary.RequestTime = percentiles(resp.ByRequestTime)
+
+	return summary
+}
+
+func statusClass(status int) string {
+	if status < 100 || status > 599 {
+		return "other"
+	}
+	return fmt.Sprintf("%dxx", status/100)
+}
+
+// percentiles computes the nearest-rank percentiles from a histogram of request times
+func percentiles(rows []api.Row) Percentiles {
+	type bucket struct {
+		value float64
+		count int64
+	}
+
+	var (
+		buckets []bucket
+		total   int64
+	)
+	for _, row := range rows {
+		value, err := row.RequestTime.Float64()
+		if err != nil || row.Count <= 0 {
+			continue
+		}
+		buckets = append(buckets, bucket{value: value, count: row.Count})
+		total += row.Count
+	}
+
+	if total == 0 {
+		return Percentiles{}
+	}
+
+	sort.Slice(buckets, func(i, j int) bool { return buckets[i].value < buckets[j].value })
+
+	rank := func(p float64) float64 {
+		target := int64(math.Ceil(p * float64(total)))
+		var cumulative int64
+		for _, b := range buckets {
+			cumulative += b.count
+			if cumulative >= target {
+				return b.value
+			}
+		}
+		return buckets[len(buckets)-1].value
+	}
+
+	return Percentiles{
+		P50: rank(0.50),
+		P95: rank(0.95),
+		P99: rank(0.99),
+	}
+}
+
+// sparkline renders the values as a single line of block characters scaled to the max value
+func sparkline(values []float64) string {
+	if len(values) == 0 {
+		return ""
+	}
+
+	min, max := values[0], values[0]
+	for _, v := range values {
+		min = math.Min(min, v)
+		max = math.Max(max, v)
+	}
+
+	var b strings.Builder
+	for _, v := range values {
+		idx := 0
+		if max > min {
+			idx = int((v - min) / (max - min) * float64(len(sparkTicks)-1))
+		}
+		b.WriteRune(sparkTicks[idx])
+	}
+
+	return b.String()
+}
+
+// humanBytes formats a byte count using binary units
+func humanBytes(bytes float64) string {
+	units := []string{"B", "KiB", "MiB", "GiB", "TiB"}
+	i := 0
+	for bytes >= 1024 && i < len(units)-1 {
+		bytes /= 1024
+		i++
+	}
+	return fmt.Sprintf("%.2f %s", bytes, units[i])
+}
@@ -0,0 +1,30 @@
+package personaltoken
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	msg "github.com/aziontech/azion-cli/messages/personal_token"
+	"github.com/aziontech/azion-cli/pkg/cmd/personal_token/revoke"
+	"github.com/aziontech/azion-cli/pkg/cmd/personal_token/rotate"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   msg.Usage,
+		Short: msg.ShortDescription,
+		Long:  msg.LongDescription, Example: heredoc.Doc(`
+		$ azion personal-token rotate
+		$ azion personal-token rotate --expiration 90d --delete-old
+		$ azion personal-token revoke --machine laptop
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+	}
+
+	cmd.AddCommand(rotate.NewCmd(f))
+	cmd.AddCommand(revoke.NewCmd(f))
+	cmd.Flags().BoolP("help", "h", false, msg.FlagHelp)
+	return cmd
+}
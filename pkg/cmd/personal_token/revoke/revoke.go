@@ -0,0 +1,101 @@
+package revoke
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+
+	msg "github.com/aziontech/azion-cli/messages/personal_token"
+	api "github.com/aziontech/azion-cli/pkg/api/personal_token"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/token"
+	"github.com/aziontech/azion-cli/utils"
+	sdk "github.com/aziontech/azionapi-go-sdk/personal_tokens"
+)
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	var machine string
+
+	cmd := &cobra.Command{
+		Use:           msg.RevokeUsage,
+		Short:         msg.RevokeShortDescription,
+		Long:          msg.RevokeLongDescription,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Example: heredoc.Doc(`
+		$ azion personal-token revoke --machine laptop
+		$ azion personal-token revoke --machine laptop --yes
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+
+			if !cmd.Flags().Changed("machine") {
+				answer, err := utils.AskInput(msg.RevokeAskMachine)
+				if err != nil {
+					return err
+				}
+				machine = answer
+			}
+			machine = strings.TrimSpace(machine)
+			if utils.IsEmpty(machine) {
+				return utils.ErrorArgumentIsEmpty
+			}
+
+			client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
+			tokens, err := client.List(ctx)
+			if err != nil {
+				return fmt.Errorf(msg.ErrorListTokens.Error(), err)
+			}
+
+			out := f.IOStreams.Out
+			var issued []sdk.PersonalTokenResponseGet
+			for _, t := range tokens {
+				if token.IssuedFor(t.GetName(), machine) {
+					issued = append(issued, t)
+				}
+			}
+			if len(issued) == 0 {
+				logger.FInfo(out, fmt.Sprintf(msg.RevokeNoTokens, machine))
+				return nil
+			}
+
+			logger.FInfo(out, fmt.Sprintf(msg.RevokeFound, machine))
+			for _, t := range issued {
+				logger.FInfo(out, fmt.Sprintf(msg.RevokeFoundItem, t.GetUuid(), t.GetName()))
+			}
+
+			confirmed, err := utils.Confirm(f.GlobalFlagAll, fmt.Sprintf(msg.RevokeAsk, len(issued)), false)
+			if err != nil {
+				return err
+			}
+			if !confirmed {
+				logger.FInfo(out, msg.RevokeCanceled)
+				return nil
+			}
+
+			// every token is tried, so one failure doesn't leave the others usable
+			var failures []string
+			for _, t := range issued {
+				uuid := t.GetUuid()
+				if err := client.Delete(ctx, uuid); err != nil {
+					failures = append(failures, fmt.Sprintf("%s: %s", uuid, err))
+					continue
+				}
+				logger.LogSuccess(out, fmt.Sprintf(msg.RevokeOutputSuccess, uuid))
+			}
+			if len(failures) > 0 {
+				return fmt.Errorf(msg.ErrorRevoke.Error(), len(failures), machine, strings.Join(failures, "; "))
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&machine, "machine", "", msg.RevokeFlagMachine)
+	cmd.Flags().BoolP("help", "h", false, msg.RevokeHelpFlag)
+	cmdutil.MarkPrompted(cmd, "machine")
+	return cmd
+}
@@ -0,0 +1,92 @@
+package revoke
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/aziontech/azion-cli/pkg/httpmock"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/testutils"
+)
+
+func TestRevoke(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+
+	t.Run("revoke the tokens of a machine", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("GET", "iam/personal_tokens"),
+			httpmock.JSONFromFile("./fixtures/tokens.json"),
+		)
+		mock.Register(
+			httpmock.REST("DELETE", "iam/personal_tokens/5b8934cf-3561-4b48-aceb-7ba52a227b6c"),
+			httpmock.StatusStringResponse(204, ""),
+		)
+		mock.Register(
+			httpmock.REST("DELETE", "iam/personal_tokens/5b8934cf-3561-4b48-aceb-7ba52a227b6d"),
+			httpmock.StatusStringResponse(204, ""),
+		)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		f.GlobalFlagAll = true
+
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--machine", "lost-laptop"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		assert.Contains(t, stdout.String(), "Revoked personal token 5b8934cf-3561-4b48-aceb-7ba52a227b6c")
+		assert.Contains(t, stdout.String(), "Revoked personal token 5b8934cf-3561-4b48-aceb-7ba52a227b6d")
+		assert.NotContains(t, stdout.String(), "5b8934cf-3561-4b48-aceb-7ba52a227b6e")
+		mock.Verify(t)
+	})
+
+	t.Run("report the tokens that failed", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("GET", "iam/personal_tokens"),
+			httpmock.JSONFromFile("./fixtures/tokens.json"),
+		)
+		mock.Register(
+			httpmock.REST("DELETE", "iam/personal_tokens/5b8934cf-3561-4b48-aceb-7ba52a227b6c"),
+			httpmock.StatusStringResponse(500, "Internal Server Error"),
+		)
+		mock.Register(
+			httpmock.REST("DELETE", "iam/personal_tokens/5b8934cf-3561-4b48-aceb-7ba52a227b6d"),
+			httpmock.StatusStringResponse(204, ""),
+		)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		f.GlobalFlagAll = true
+
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--machine", "lost-laptop"})
+
+		_, err := cmd.ExecuteC()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Failed to revoke 1 of the personal tokens issued to lost-laptop")
+		assert.Contains(t, stdout.String(), "Revoked personal token 5b8934cf-3561-4b48-aceb-7ba52a227b6d")
+		mock.Verify(t)
+	})
+
+	t.Run("no token issued to the machine", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("GET", "iam/personal_tokens"),
+			httpmock.JSONFromFile("./fixtures/tokens.json"),
+		)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--machine", "laptop"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		assert.Equal(t, "No personal token issued to laptop was found\n", stdout.String())
+		mock.Verify(t)
+	})
+}
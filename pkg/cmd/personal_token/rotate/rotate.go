@@ -0,0 +1,167 @@
+package rotate
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"go.uber.org/zap"
+
+	msg "github.com/aziontech/azion-cli/messages/personal_token"
+	api "github.com/aziontech/azion-cli/pkg/api/personal_token"
+	createToken "github.com/aziontech/azion-cli/pkg/cmd/create/personal_token"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/config"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/token"
+	"github.com/spf13/cobra"
+)
+
+// defaultName names the new token when the replaced one isn't known
+const defaultName = "Azion CLI"
+
+type Fields struct {
+	Name        string
+	Expiration  string
+	Description string
+	DeleteOld   bool
+	PrintKey    bool
+}
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	fields := &Fields{}
+
+	cmd := &cobra.Command{
+		Use:           msg.RotateUsage,
+		Short:         msg.RotateShortDescription,
+		Long:          msg.RotateLongDescription,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Example: heredoc.Doc(`
+		$ azion personal-token rotate
+		$ azion personal-token rotate --expiration 90d --delete-old
+		$ azion personal-token rotate --name "ci deploys" --expiration 2025-12-31 --print-key
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+
+			settings, err := token.ReadSettings()
+			if err != nil {
+				return err
+			}
+
+			current := f.Config.GetString("token")
+			if current == "" {
+				return msg.ErrorNotLoggedIn
+			}
+
+			// the saved token's ID is only known when it's the one in use. A token given through
+			// AZIONCLI_TOKEN or --token isn't the saved one, which must then be left alone
+			saved := settings.Token == current
+			oldUUID := ""
+			if saved {
+				oldUUID = settings.UUID
+			}
+
+			client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), current)
+			request, err := newRequest(ctx, cmd, client, fields, oldUUID)
+			if err != nil {
+				return err
+			}
+
+			response, err := client.Create(ctx, request)
+			if err != nil {
+				return fmt.Errorf(msg.ErrorRotate.Error(), err)
+			}
+
+			// the new token is only saved once it's known to work
+			newClient := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), response.GetKey())
+			created, err := newClient.Find(ctx, response.GetUuid())
+			if err != nil || created == nil {
+				logger.Debug("Error while validating the new personal token", zap.Error(err))
+				return fmt.Errorf(msg.ErrorInvalidKey.Error(), response.GetUuid())
+			}
+
+			out := f.IOStreams.Out
+			expiresAt := response.GetExpiresAt().Local().Format(time.DateOnly)
+			if saved {
+				settings.Token = response.GetKey()
+				settings.UUID = response.GetUuid()
+				settings.ExpiresAt = response.GetExpiresAt()
+				if err := token.WriteSettings(settings); err != nil {
+					return err
+				}
+
+				dir, err := config.Dir()
+				if err != nil {
+					return err
+				}
+				logger.LogSuccess(out, fmt.Sprintf(msg.RotateOutputSuccess, expiresAt, filepath.Join(dir.Dir, dir.Settings)))
+			} else {
+				// the new token is only displayed, so that it replaces the one given where it came from
+				logger.LogSuccess(out, fmt.Sprintf(msg.RotateOutputNotSaved, expiresAt))
+			}
+			if fields.PrintKey || !saved {
+				fmt.Fprintf(out, msg.RotateKeyOutput, response.GetKey())
+			}
+
+			if !fields.DeleteOld {
+				return nil
+			}
+			if oldUUID == "" {
+				logger.LogWarning(f.IOStreams.Err, msg.RotateWarningUnknown)
+				return nil
+			}
+			if err := newClient.Delete(ctx, oldUUID); err != nil {
+				return fmt.Errorf(msg.ErrorDeleteOld.Error(), oldUUID, err)
+			}
+			logger.FInfo(out, fmt.Sprintf(msg.RotateDeletedOld, oldUUID))
+			return nil
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&fields.Name, "name", "", msg.RotateFlagName)
+	flags.StringVar(&fields.Expiration, "expiration", "1m", msg.RotateFlagExpiration)
+	flags.StringVar(&fields.Description, "description", "", msg.RotateFlagDescription)
+	flags.BoolVar(&fields.DeleteOld, "delete-old", false, msg.RotateFlagDeleteOld)
+	flags.BoolVar(&fields.PrintKey, "print-key", false, msg.RotateFlagPrintKey)
+	flags.BoolP("help", "h", false, msg.RotateHelpFlag)
+	return cmd
+}
+
+// newRequest describes the new token, which takes the name and description of the one it
+// replaces unless the flags tell otherwise. The name is tagged with this machine either way
+func newRequest(ctx context.Context, cmd *cobra.Command, client *api.Client, fields *Fields, oldUUID string) (*api.Request, error) {
+	name, description := defaultName, ""
+	if oldUUID != "" {
+		old, err := client.Find(ctx, oldUUID)
+		if err != nil {
+			logger.Debug("Error while reading the replaced personal token", zap.Error(err))
+		}
+		if old != nil {
+			name, description = old.GetName(), old.GetDescription()
+		}
+	}
+	if cmd.Flags().Changed("name") {
+		name = fields.Name
+	}
+	if cmd.Flags().Changed("description") {
+		description = fields.Description
+	}
+
+	date, err := createToken.ParseExpirationDate(time.Now(), fields.Expiration)
+	if err != nil {
+		return nil, err
+	}
+
+	request := &api.Request{}
+	request.SetName(token.TagName(name))
+	request.SetExpiresAt(date)
+	if description != "" {
+		request.SetDescription(description)
+	}
+	return request, nil
+}
@@ -0,0 +1,210 @@
+package rotate
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+
+	msg "github.com/aziontech/azion-cli/messages/personal_token"
+	"github.com/aziontech/azion-cli/pkg/config"
+	"github.com/aziontech/azion-cli/pkg/httpmock"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/testutils"
+	"github.com/aziontech/azion-cli/pkg/token"
+)
+
+const oldUUID = "5b8934cf-3561-4b48-aceb-7ba52a227b6c"
+
+// withToken matches the requests authorized by the given token
+func withToken(key string, matcher httpmock.Matcher) httpmock.Matcher {
+	return func(req *http.Request) bool {
+		return matcher(req) && req.Header.Get("Authorization") == "token "+key
+	}
+}
+
+// useSettings saves settings in a temporary directory for the test
+func useSettings(t *testing.T, settings token.Settings) {
+	require.NoError(t, config.SetPath(filepath.Join(t.TempDir(), "settings.toml")))
+	t.Cleanup(func() {
+		_ = config.SetPath(filepath.Join(config.DEFAULT_DIR, config.DEFAULT_SETTINGS))
+	})
+	require.NoError(t, token.WriteSettings(settings))
+}
+
+func TestRotate(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+	token.Hostname = func() (string, error) { return "new-laptop", nil }
+	t.Cleanup(func() { token.Hostname = os.Hostname })
+
+	t.Run("rotate the saved token", func(t *testing.T) {
+		useSettings(t, token.Settings{Token: "oldtoken", UUID: oldUUID, Email: "dev@example.com"})
+
+		mock := &httpmock.Registry{}
+		mock.Register(
+			withToken("oldtoken", httpmock.REST("GET", "iam/personal_tokens")),
+			httpmock.JSONFromFile("./fixtures/old.json"),
+		)
+		mock.Register(
+			withToken("oldtoken", httpmock.REST("POST", "iam/personal_tokens")),
+			func(req *http.Request) (*http.Response, error) {
+				var payload map[string]interface{}
+				require.NoError(t, json.NewDecoder(req.Body).Decode(&payload))
+				assert.Equal(t, "ci deploys (azion-cli@new-laptop)", payload["name"])
+				assert.Equal(t, "pipeline token", payload["description"])
+				return httpmock.JSONFromFile("./fixtures/created.json")(req)
+			},
+		)
+		mock.Register(
+			withToken("newtokenazion", httpmock.REST("GET", "iam/personal_tokens")),
+			httpmock.JSONFromFile("./fixtures/new.json"),
+		)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		v := viper.New()
+		v.Set("token", "oldtoken")
+		f.Config = v
+
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--expiration", "90d"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		assert.Contains(t, stdout.String(), "Personal token rotated. The new token expires on 2024-10-1")
+		assert.NotContains(t, stdout.String(), "newtokenazion")
+		mock.Verify(t)
+
+		settings, err := token.ReadSettings()
+		require.NoError(t, err)
+		assert.Equal(t, "newtokenazion", settings.Token)
+		assert.Equal(t, "0c7a1f9e-2d4b-4f61-9a83-5e2b7d6c1f00", settings.UUID)
+		assert.Equal(t, "dev@example.com", settings.Email)
+		assert.Equal(t, 2024, settings.ExpiresAt.Year())
+	})
+
+	t.Run("rotate and delete the old token", func(t *testing.T) {
+		useSettings(t, token.Settings{Token: "oldtoken", UUID: oldUUID})
+
+		mock := &httpmock.Registry{}
+		mock.Register(
+			withToken("oldtoken", httpmock.REST("GET", "iam/personal_tokens")),
+			httpmock.JSONFromFile("./fixtures/old.json"),
+		)
+		mock.Register(
+			withToken("oldtoken", httpmock.REST("POST", "iam/personal_tokens")),
+			httpmock.JSONFromFile("./fixtures/created.json"),
+		)
+		mock.Register(
+			withToken("newtokenazion", httpmock.REST("GET", "iam/personal_tokens")),
+			httpmock.JSONFromFile("./fixtures/new.json"),
+		)
+		mock.Register(
+			withToken("newtokenazion", httpmock.REST("DELETE", "iam/personal_tokens/"+oldUUID)),
+			httpmock.StatusStringResponse(204, ""),
+		)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		v := viper.New()
+		v.Set("token", "oldtoken")
+		f.Config = v
+
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--delete-old", "--print-key"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		assert.Contains(t, stdout.String(), "newtokenazion\n")
+		assert.Contains(t, stdout.String(), "Deleted the replaced personal token "+oldUUID)
+		mock.Verify(t)
+	})
+
+	t.Run("keep the saved token when the new one doesn't work", func(t *testing.T) {
+		useSettings(t, token.Settings{Token: "oldtoken", UUID: oldUUID})
+
+		mock := &httpmock.Registry{}
+		mock.Register(
+			withToken("oldtoken", httpmock.REST("GET", "iam/personal_tokens")),
+			httpmock.JSONFromFile("./fixtures/old.json"),
+		)
+		mock.Register(
+			withToken("oldtoken", httpmock.REST("POST", "iam/personal_tokens")),
+			httpmock.JSONFromFile("./fixtures/created.json"),
+		)
+		mock.Register(
+			withToken("newtokenazion", httpmock.REST("GET", "iam/personal_tokens")),
+			httpmock.StatusStringResponse(401, "Unauthorized"),
+		)
+
+		f, _, _ := testutils.NewFactory(mock)
+		v := viper.New()
+		v.Set("token", "oldtoken")
+		f.Config = v
+
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{})
+
+		_, err := cmd.ExecuteC()
+		require.Error(t, err)
+
+		settings, err := token.ReadSettings()
+		require.NoError(t, err)
+		assert.Equal(t, "oldtoken", settings.Token)
+	})
+
+	t.Run("leave the saved token alone when rotating the one given", func(t *testing.T) {
+		useSettings(t, token.Settings{Token: "savedtoken", UUID: oldUUID, Email: "dev@example.com"})
+
+		mock := &httpmock.Registry{}
+		mock.Register(
+			withToken("envtoken", httpmock.REST("POST", "iam/personal_tokens")),
+			func(req *http.Request) (*http.Response, error) {
+				var payload map[string]interface{}
+				require.NoError(t, json.NewDecoder(req.Body).Decode(&payload))
+				assert.Equal(t, "Azion CLI (azion-cli@new-laptop)", payload["name"])
+				return httpmock.JSONFromFile("./fixtures/created.json")(req)
+			},
+		)
+		mock.Register(
+			withToken("newtokenazion", httpmock.REST("GET", "iam/personal_tokens")),
+			httpmock.JSONFromFile("./fixtures/new.json"),
+		)
+
+		f, stdout, stderr := testutils.NewFactory(mock)
+		v := viper.New()
+		v.Set("token", "envtoken")
+		f.Config = v
+
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--delete-old"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		assert.Contains(t, stdout.String(), "It wasn't saved")
+		assert.Contains(t, stdout.String(), "newtokenazion\n")
+		assert.Contains(t, stderr.String(), "The replaced personal token wasn't deleted")
+		mock.Verify(t)
+
+		settings, err := token.ReadSettings()
+		require.NoError(t, err)
+		assert.Equal(t, "savedtoken", settings.Token)
+		assert.Equal(t, oldUUID, settings.UUID)
+		assert.Equal(t, "dev@example.com", settings.Email)
+	})
+
+	t.Run("no token to rotate", func(t *testing.T) {
+		useSettings(t, token.Settings{})
+
+		f, _, _ := testutils.NewFactory(&httpmock.Registry{})
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{})
+
+		_, err := cmd.ExecuteC()
+		require.ErrorIs(t, err, msg.ErrorNotLoggedIn)
+	})
+}
@@ -0,0 +1,91 @@
+package add
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+
+	msg "github.com/aziontech/azion-cli/messages/profile"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/token"
+	"github.com/aziontech/azion-cli/utils"
+)
+
+var validName = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+type Fields struct {
+	ApiURL     string
+	StorageURL string
+	Defaults   map[string]string
+	Use        bool
+}
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	fields := &Fields{}
+
+	cmd := &cobra.Command{
+		Use:           msg.AddUsage,
+		Short:         msg.AddShortDescription,
+		Long:          msg.AddLongDescription,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Args:          cobra.MaximumNArgs(1),
+		Example: heredoc.Doc(`
+		$ azion profile add production
+		$ azion profile add staging --api-url https://stage-api.azion.net --storage-url https://stage-api.azion.com --use
+		$ azion profile add sandbox --default log_level=debug
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var name string
+			if len(args) > 0 {
+				name = args[0]
+			} else {
+				answer, err := utils.AskInput(msg.AskName)
+				if err != nil {
+					return err
+				}
+				name = answer
+			}
+			if name == token.DefaultProfile || !validName.MatchString(name) {
+				return fmt.Errorf(msg.ErrorInvalidName.Error(), name)
+			}
+
+			settings, err := token.ReadSettings()
+			if err != nil {
+				return err
+			}
+			if _, ok := settings.Profiles[name]; ok {
+				return fmt.Errorf(msg.ErrorExists.Error(), name)
+			}
+
+			if settings.Profiles == nil {
+				settings.Profiles = map[string]token.Profile{}
+			}
+			settings.Profiles[name] = token.Profile{
+				ApiURL:     fields.ApiURL,
+				StorageURL: fields.StorageURL,
+				Defaults:   fields.Defaults,
+			}
+			if fields.Use {
+				settings.CurrentProfile = name
+			}
+			if err := token.WriteSettings(settings); err != nil {
+				return err
+			}
+
+			logger.LogSuccess(f.IOStreams.Out, fmt.Sprintf(msg.AddOutputSuccess, name, name))
+			return nil
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&fields.ApiURL, "api-url", "", msg.AddFlagApiURL)
+	flags.StringVar(&fields.StorageURL, "storage-url", "", msg.AddFlagStorageURL)
+	flags.StringToStringVar(&fields.Defaults, "default", nil, msg.AddFlagDefault)
+	flags.BoolVar(&fields.Use, "use", false, msg.AddFlagUse)
+	flags.BoolP("help", "h", false, msg.AddHelpFlag)
+	return cmd
+}
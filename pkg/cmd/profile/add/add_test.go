@@ -0,0 +1,101 @@
+package add
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/config"
+	"github.com/aziontech/azion-cli/pkg/httpmock"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/testutils"
+	"github.com/aziontech/azion-cli/pkg/token"
+	"github.com/aziontech/azion-cli/utils"
+)
+
+// useSettings copies the fixture to a temporary settings file for the test
+func useSettings(t *testing.T) {
+	content, err := os.ReadFile("./fixtures/settings.toml")
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "settings.toml")
+	require.NoError(t, os.WriteFile(path, content, 0600))
+	require.NoError(t, config.SetPath(path))
+	t.Cleanup(func() {
+		_ = config.SetPath(filepath.Join(config.DEFAULT_DIR, config.DEFAULT_SETTINGS))
+	})
+}
+
+func TestAdd(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+
+	t.Run("add a profile", func(t *testing.T) {
+		useSettings(t)
+
+		f, stdout, _ := testutils.NewFactory(&httpmock.Registry{})
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"sandbox", "--api-url", "https://sandbox-api.azion.net", "--default", "log_level=debug", "--use"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		assert.Equal(t, "🚀 Added profile sandbox. Run 'azion login --profile sandbox' to log in to it\n\n", stdout.String())
+
+		settings, err := token.ReadSettings()
+		require.NoError(t, err)
+		assert.Equal(t, "sandbox", settings.ActiveProfile())
+		assert.Equal(t, "", settings.Token)
+		assert.Equal(t, 2, settings.AuthorizeMetricsCollection)
+
+		profile, ok := settings.Profile("sandbox")
+		require.True(t, ok)
+		assert.Equal(t, "https://sandbox-api.azion.net", profile.ApiURL)
+		assert.Equal(t, map[string]string{"log_level": "debug"}, profile.Defaults)
+
+		staging, _ := settings.Profile("staging")
+		assert.Equal(t, "stage@example.com", staging.Email)
+	})
+
+	t.Run("profile exists", func(t *testing.T) {
+		useSettings(t)
+
+		f, _, _ := testutils.NewFactory(&httpmock.Registry{})
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"staging"})
+
+		_, err := cmd.ExecuteC()
+		require.EqualError(t, err, "The profile staging already exists. Choose another name and try again")
+	})
+
+	t.Run("invalid names", func(t *testing.T) {
+		useSettings(t)
+
+		for _, name := range []string{"default", "prod.eu", "my profile"} {
+			f, _, _ := testutils.NewFactory(&httpmock.Registry{})
+			cmd := NewCmd(f)
+			cmd.SetArgs([]string{name})
+
+			_, err := cmd.ExecuteC()
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "Invalid profile name provided")
+		}
+	})
+
+	t.Run("name is required without prompts", func(t *testing.T) {
+		useSettings(t)
+		utils.SetInteractive(false, nil)
+		t.Cleanup(func() { utils.SetInteractive(true, nil) })
+
+		f, _, _ := testutils.NewFactory(&httpmock.Registry{})
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{})
+
+		_, err := cmd.ExecuteC()
+		require.ErrorContains(t, err, "missing required input 'Enter the profile's name'. ")
+		var flagErr *cmdutil.FlagError
+		require.ErrorAs(t, err, &flagErr)
+	})
+}
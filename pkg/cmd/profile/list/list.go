@@ -0,0 +1,100 @@
+package list
+
+import (
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/tidwall/gjson"
+
+	"github.com/aziontech/azion-cli/messages/general"
+	msg "github.com/aziontech/azion-cli/messages/profile"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/constants"
+	"github.com/aziontech/azion-cli/pkg/printer"
+	"github.com/aziontech/azion-cli/pkg/token"
+)
+
+// item is how a profile is listed. Tokens are never listed, nor read from the credential helper
+// for profiles other than the one in use
+type item struct {
+	Name       string    `json:"name"`
+	Active     bool      `json:"active"`
+	Email      string    `json:"email"`
+	ApiURL     string    `json:"api_url"`
+	StorageURL string    `json:"storage_url"`
+	ExpiresAt  time.Time `json:"expires_at"`
+	LoggedIn   bool      `json:"logged_in"`
+}
+
+var columns = []printer.Column{
+	{Header: "", Path: "active", Format: active},
+	{Header: "NAME", Path: "name"},
+	{Header: "EMAIL", Path: "email"},
+	{Header: "API URL", Path: "api_url"},
+	{Header: "STORAGE URL", Path: "storage_url", Details: true},
+	{Header: "LOGGED IN", Path: "logged_in", Details: true},
+}
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	output := &printer.Options{}
+	var details bool
+
+	cmd := &cobra.Command{
+		Use:           "list",
+		Short:         msg.ListShortDescription,
+		Long:          msg.ListLongDescription,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Example: heredoc.Doc(`
+		$ azion profile list
+		$ azion profile list --details
+		$ azion profile list --format json
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := output.Validate(); err != nil {
+				return err
+			}
+
+			settings, err := token.ReadSettings()
+			if err != nil {
+				return err
+			}
+
+			var items []item
+			for _, name := range settings.ProfileNames() {
+				profile, _ := settings.Profile(name)
+				entry := item{
+					Name:       name,
+					Active:     name == settings.ActiveProfile(),
+					Email:      profile.Email,
+					ApiURL:     profile.ApiURL,
+					StorageURL: profile.StorageURL,
+					ExpiresAt:  profile.ExpiresAt,
+					LoggedIn:   profile.Email != "",
+				}
+				if entry.ApiURL == "" {
+					entry.ApiURL = constants.ApiURL
+				}
+				if entry.StorageURL == "" {
+					entry.StorageURL = constants.StorageApiURL
+				}
+				items = append(items, entry)
+			}
+			return printer.PrintList(f.IOStreams.Out, output, columns, items, details)
+		},
+	}
+
+	cmd.Flags().BoolVar(&details, "details", false, general.ApiListFlagDetails)
+	cmd.Flags().BoolP("help", "h", false, msg.ListHelpFlag)
+	cmdutil.AddOutputFlags(f, cmd, output)
+	cmdutil.AddFilterFlags(cmd, output)
+	return cmd
+}
+
+func active(value gjson.Result) string {
+	if value.Bool() {
+		return "*"
+	}
+	return ""
+}
@@ -0,0 +1,68 @@
+package list
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/config"
+	"github.com/aziontech/azion-cli/pkg/httpmock"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/testutils"
+	"github.com/aziontech/azion-cli/pkg/token"
+)
+
+// useSettings copies the fixture to a temporary settings file for the test
+func useSettings(t *testing.T) {
+	content, err := os.ReadFile("./fixtures/settings.toml")
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "settings.toml")
+	require.NoError(t, os.WriteFile(path, content, 0600))
+	require.NoError(t, config.SetPath(path))
+	t.Cleanup(func() {
+		token.SelectProfile("")
+		_ = config.SetPath(filepath.Join(config.DEFAULT_DIR, config.DEFAULT_SETTINGS))
+	})
+}
+
+func TestList(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+
+	t.Run("list profiles", func(t *testing.T) {
+		useSettings(t)
+		token.SelectProfile("staging")
+
+		f, stdout, _ := testutils.NewFactory(&httpmock.Registry{})
+		cmd := NewCmd(f)
+		cmdutil.AddFormatFlag(cmd, f)
+		cmd.SetArgs([]string{})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		lines := stdout.String()
+		assert.Contains(t, lines, "NAME")
+		assert.Regexp(t, `\n\s+default\s+prod@example.com\s`, lines)
+		assert.Regexp(t, `\n\*\s+staging\s+stage@example.com\s+https://stage-api.azion.net`, lines)
+		assert.NotContains(t, lines, "stagingtoken")
+	})
+
+	t.Run("list as json", func(t *testing.T) {
+		useSettings(t)
+
+		f, stdout, _ := testutils.NewFactory(&httpmock.Registry{})
+		cmd := NewCmd(f)
+		cmdutil.AddFormatFlag(cmd, f)
+		cmd.SetArgs([]string{"--format", "json"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		assert.Contains(t, stdout.String(), `"name": "default"`)
+		assert.Contains(t, stdout.String(), `"logged_in": true`)
+		assert.NotContains(t, stdout.String(), "token")
+	})
+}
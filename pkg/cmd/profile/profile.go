@@ -0,0 +1,36 @@
+package profile
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	msg "github.com/aziontech/azion-cli/messages/profile"
+	"github.com/aziontech/azion-cli/pkg/cmd/profile/add"
+	"github.com/aziontech/azion-cli/pkg/cmd/profile/list"
+	"github.com/aziontech/azion-cli/pkg/cmd/profile/remove"
+	"github.com/aziontech/azion-cli/pkg/cmd/profile/use"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   msg.Usage,
+		Short: msg.ShortDescription,
+		Long:  msg.LongDescription,
+		Example: heredoc.Doc(`
+		$ azion profile add staging --api-url https://stage-api.azion.net
+		$ azion login --profile staging
+		$ azion profile use staging
+		$ azion list edge-application --profile production
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+	}
+
+	cmd.AddCommand(add.NewCmd(f))
+	cmd.AddCommand(list.NewCmd(f))
+	cmd.AddCommand(use.NewCmd(f))
+	cmd.AddCommand(remove.NewCmd(f))
+	cmd.Flags().BoolP("help", "h", false, msg.FlagHelp)
+	return cmd
+}
@@ -0,0 +1,81 @@
+package remove
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+
+	msg "github.com/aziontech/azion-cli/messages/profile"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/token"
+	"github.com/aziontech/azion-cli/utils"
+)
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:           msg.RemoveUsage,
+		Short:         msg.RemoveShortDescription,
+		Long:          msg.RemoveLongDescription,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Args:          cobra.MaximumNArgs(1),
+		Example: heredoc.Doc(`
+		$ azion profile remove sandbox
+		$ azion profile remove sandbox --yes
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var name string
+			if len(args) > 0 {
+				name = args[0]
+			} else {
+				answer, err := utils.AskInput(msg.AskName)
+				if err != nil {
+					return err
+				}
+				name = answer
+			}
+
+			settings, err := token.ReadSettings()
+			if err != nil {
+				return err
+			}
+			if _, ok := settings.Profiles[name]; !ok {
+				return fmt.Errorf(msg.ErrorNotFound.Error(), name)
+			}
+
+			confirmed, err := utils.Confirm(f.GlobalFlagAll, fmt.Sprintf(msg.RemoveAsk, name), false)
+			if err != nil {
+				return err
+			}
+			if !confirmed {
+				logger.FInfo(f.IOStreams.Out, msg.RemoveCanceled)
+				return nil
+			}
+
+			profiles := make(map[string]token.Profile, len(settings.Profiles))
+			for key, profile := range settings.Profiles {
+				if key != name {
+					profiles[key] = profile
+				}
+			}
+			settings.Profiles = profiles
+			if settings.CurrentProfile == name {
+				settings.CurrentProfile = ""
+			}
+			if err := token.WriteSettings(settings); err != nil {
+				return err
+			}
+			if err := settings.EraseToken(name); err != nil {
+				return err
+			}
+
+			logger.LogSuccess(f.IOStreams.Out, fmt.Sprintf(msg.RemoveOutputSuccess, name))
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolP("help", "h", false, msg.RemoveHelpFlag)
+	return cmd
+}
@@ -0,0 +1,106 @@
+package remove
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/config"
+	"github.com/aziontech/azion-cli/pkg/credential"
+	"github.com/aziontech/azion-cli/pkg/httpmock"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/testutils"
+	"github.com/aziontech/azion-cli/pkg/token"
+	"github.com/aziontech/azion-cli/utils"
+)
+
+// useSettings copies the fixture to a temporary settings file for the test
+func useSettings(t *testing.T) {
+	content, err := os.ReadFile("./fixtures/settings.toml")
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "settings.toml")
+	require.NoError(t, os.WriteFile(path, content, 0600))
+	require.NoError(t, config.SetPath(path))
+	t.Cleanup(func() {
+		token.SelectProfile("")
+		_ = config.SetPath(filepath.Join(config.DEFAULT_DIR, config.DEFAULT_SETTINGS))
+	})
+}
+
+func TestRemove(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+
+	t.Run("remove the profile in use", func(t *testing.T) {
+		useSettings(t)
+		token.SelectProfile("staging")
+
+		f, stdout, _ := testutils.NewFactory(&httpmock.Registry{})
+		f.GlobalFlagAll = true
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"staging"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		assert.Equal(t, "🚀 Removed profile staging\n\n", stdout.String())
+
+		token.SelectProfile("")
+		settings, err := token.ReadSettings()
+		require.NoError(t, err)
+		assert.Equal(t, []string{"default"}, settings.ProfileNames())
+		assert.Equal(t, "defaulttoken", settings.Token)
+
+		stored, err := credential.New("").Get("staging")
+		require.NoError(t, err)
+		assert.Empty(t, stored)
+	})
+
+	t.Run("default profile can't be removed", func(t *testing.T) {
+		useSettings(t)
+
+		f, _, _ := testutils.NewFactory(&httpmock.Registry{})
+		f.GlobalFlagAll = true
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"default"})
+
+		_, err := cmd.ExecuteC()
+		require.EqualError(t, err, "The profile default doesn't exist. Run the command 'azion profile list' to check your profiles and try again")
+	})
+
+	t.Run("removal requires --yes without prompts", func(t *testing.T) {
+		useSettings(t)
+		utils.SetInteractive(false, nil)
+		t.Cleanup(func() { utils.SetInteractive(true, nil) })
+
+		f, stdout, _ := testutils.NewFactory(&httpmock.Registry{})
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"staging"})
+
+		_, err := cmd.ExecuteC()
+		require.ErrorContains(t, err, "missing required flag --yes. ")
+		assert.NotContains(t, stdout.String(), "canceled")
+
+		settings, err := token.ReadSettings()
+		require.NoError(t, err)
+		assert.Contains(t, settings.ProfileNames(), "staging")
+	})
+
+	t.Run("name is required without prompts", func(t *testing.T) {
+		useSettings(t)
+		utils.SetInteractive(false, nil)
+		t.Cleanup(func() { utils.SetInteractive(true, nil) })
+
+		f, _, _ := testutils.NewFactory(&httpmock.Registry{})
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{})
+
+		_, err := cmd.ExecuteC()
+		require.ErrorContains(t, err, "missing required input 'Enter the profile's name'. ")
+		var flagErr *cmdutil.FlagError
+		require.ErrorAs(t, err, &flagErr)
+	})
+}
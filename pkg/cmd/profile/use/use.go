@@ -0,0 +1,63 @@
+package use
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+
+	msg "github.com/aziontech/azion-cli/messages/profile"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/token"
+	"github.com/aziontech/azion-cli/utils"
+)
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:           msg.UseUsage,
+		Short:         msg.UseShortDescription,
+		Long:          msg.UseLongDescription,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Args:          cobra.MaximumNArgs(1),
+		Example: heredoc.Doc(`
+		$ azion profile use staging
+		$ azion profile use default
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var name string
+			if len(args) > 0 {
+				name = args[0]
+			} else {
+				answer, err := utils.AskInput(msg.AskName)
+				if err != nil {
+					return err
+				}
+				name = answer
+			}
+
+			settings, err := token.ReadSettings()
+			if err != nil {
+				return err
+			}
+			if _, ok := settings.Profile(name); !ok {
+				return fmt.Errorf(msg.ErrorNotFound.Error(), name)
+			}
+
+			settings.CurrentProfile = name
+			if name == token.DefaultProfile {
+				settings.CurrentProfile = ""
+			}
+			if err := token.WriteSettings(settings); err != nil {
+				return err
+			}
+
+			logger.LogSuccess(f.IOStreams.Out, fmt.Sprintf(msg.UseOutputSuccess, name))
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolP("help", "h", false, msg.UseHelpFlag)
+	return cmd
+}
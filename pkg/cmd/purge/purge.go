@@ -0,0 +1,237 @@
+package purge
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	msg "github.com/aziontech/azion-cli/messages/purge"
+	api "github.com/aziontech/azion-cli/pkg/api/realtime_purge"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/spf13/cobra"
+)
+
+// The most items the API takes in a single purge request
+const (
+	maxURLs      = 50
+	maxCacheKeys = 50
+)
+
+const (
+	typeURL      = "url"
+	typeWildcard = "wildcard"
+	typeCacheKey = "cache-key"
+)
+
+var layers = map[string]string{
+	"edge":   api.LayerEdge,
+	"tiered": api.LayerTiered,
+}
+
+type Fields struct {
+	URLs      []string
+	Wildcards []string
+	CacheKeys []string
+	Layer     string
+	FromFile  string
+	Type      string
+}
+
+// mode is how the items of a type are purged
+type mode struct {
+	name      string
+	batchSize int
+	purge     func(ctx context.Context, items []string) error
+}
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	fields := &Fields{}
+
+	cmd := &cobra.Command{
+		Use:           msg.Usage,
+		Short:         msg.ShortDescription,
+		Long:          msg.LongDescription,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Example: heredoc.Doc(`
+		$ azion purge --urls www.example.com/index.html,www.example.com/styles.css
+		$ azion purge --wildcard "www.example.com/images/*"
+		$ azion purge --cache-key "www.example.com/@@cookie_name=cookie_value" --layer tiered
+		$ azion purge --from-file "./urls.txt"
+		$ cat keys.txt | azion purge --from-file - --type cache-key
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			layer, ok := layers[fields.Layer]
+			if !ok {
+				return fmt.Errorf(msg.ErrorLayer.Error(), fields.Layer)
+			}
+
+			itemType, items, err := purgeType(cmd, fields)
+			if err != nil {
+				return err
+			}
+
+			if layer != api.LayerEdge && itemType != typeCacheKey {
+				return msg.ErrorLayerMode
+			}
+
+			if cmd.Flags().Changed("from-file") {
+				read, err := readItems(fields.FromFile, f.IOStreams.In)
+				if err != nil {
+					return err
+				}
+				items = append(items, read...)
+			}
+
+			items = cleanItems(itemType, items)
+			if len(items) == 0 {
+				return msg.ErrorMissingItems
+			}
+
+			if itemType == typeWildcard {
+				for _, expression := range items {
+					if !strings.Contains(expression, "*") {
+						return fmt.Errorf(msg.ErrorWildcard.Error(), expression)
+					}
+				}
+			}
+
+			client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
+			modes := map[string]mode{
+				typeURL: {name: msg.TypeURLs, batchSize: maxURLs, purge: client.PurgeURLs},
+				typeCacheKey: {name: msg.TypeCacheKeys, batchSize: maxCacheKeys, purge: func(ctx context.Context, keys []string) error {
+					return client.PurgeCacheKeys(ctx, keys, layer)
+				}},
+				typeWildcard: {name: msg.TypeWildcards, batchSize: 1, purge: func(ctx context.Context, expressions []string) error {
+					return client.PurgeWildcard(ctx, expressions[0])
+				}},
+			}
+
+			return purge(f.IOStreams.Out, modes[itemType], items)
+		},
+	}
+
+	cmd.Flags().StringSliceVar(&fields.URLs, "urls", []string{}, msg.FlagURLs)
+	cmd.Flags().StringSliceVar(&fields.Wildcards, "wildcard", []string{}, msg.FlagWildcard)
+	cmd.Flags().StringSliceVar(&fields.CacheKeys, "cache-key", []string{}, msg.FlagCacheKey)
+	cmd.Flags().StringVar(&fields.Layer, "layer", "edge", msg.FlagLayer)
+	cmd.Flags().StringVar(&fields.FromFile, "from-file", "", msg.FlagFromFile)
+	cmd.Flags().StringVar(&fields.Type, "type", typeURL, msg.FlagType)
+	cmd.Flags().BoolP("help", "h", false, msg.FlagHelp)
+	return cmd
+}
+
+// purgeType finds out what is purged from the flags, returning the items given in them
+func purgeType(cmd *cobra.Command, fields *Fields) (string, []string, error) {
+	itemType := ""
+	var items []string
+	for _, flag := range []struct {
+		name     string
+		itemType string
+		values   []string
+	}{
+		{"urls", typeURL, fields.URLs},
+		{"wildcard", typeWildcard, fields.Wildcards},
+		{"cache-key", typeCacheKey, fields.CacheKeys},
+	} {
+		if !cmd.Flags().Changed(flag.name) {
+			continue
+		}
+		if itemType != "" {
+			return "", nil, msg.ErrorModes
+		}
+		itemType = flag.itemType
+		items = flag.values
+	}
+
+	switch {
+	case itemType == "":
+		if fields.Type != typeURL && fields.Type != typeWildcard && fields.Type != typeCacheKey {
+			return "", nil, fmt.Errorf(msg.ErrorType.Error(), fields.Type)
+		}
+		return fields.Type, nil, nil
+	case cmd.Flags().Changed("type") && fields.Type != itemType:
+		return "", nil, msg.ErrorModes
+	}
+	return itemType, items, nil
+}
+
+// readItems reads one item per line from the file at path, or from in when path is -
+func readItems(path string, in io.Reader) ([]string, error) {
+	if path != "-" {
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, fmt.Errorf(msg.ErrorReadItems.Error(), path, err)
+		}
+		defer file.Close()
+		in = file
+	}
+
+	var items []string
+	scanner := bufio.NewScanner(in)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		items = append(items, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf(msg.ErrorReadItems.Error(), path, err)
+	}
+	return items, nil
+}
+
+// cleanItems removes empty and repeated items. URLs and Wildcard expressions are purged
+// without their protocol, so it is removed when given
+func cleanItems(itemType string, items []string) []string {
+	seen := make(map[string]bool, len(items))
+	cleaned := make([]string, 0, len(items))
+	for _, item := range items {
+		item = strings.TrimSpace(item)
+		if itemType != typeCacheKey {
+			item = strings.TrimPrefix(strings.TrimPrefix(item, "https://"), "http://")
+		}
+		if item == "" || seen[item] {
+			continue
+		}
+		seen[item] = true
+		cleaned = append(cleaned, item)
+	}
+	return cleaned
+}
+
+// purge sends the items in batches the API accepts, reporting how each of them went.
+// A failed batch doesn't stop the others
+func purge(out io.Writer, m mode, items []string) error {
+	batches := (len(items) + m.batchSize - 1) / m.batchSize
+	failed := 0
+
+	ctx := context.Background()
+	for i := 0; i < batches; i++ {
+		end := (i + 1) * m.batchSize
+		if end > len(items) {
+			end = len(items)
+		}
+		batch := items[i*m.batchSize : end]
+
+		if err := m.purge(ctx, batch); err != nil {
+			failed++
+			logger.FInfo(out, fmt.Sprintf(msg.BatchFailure, i+1, batches, err))
+			continue
+		}
+		logger.FInfo(out, fmt.Sprintf(msg.BatchSuccess, i+1, batches, len(batch), m.name))
+	}
+
+	if failed > 0 {
+		return fmt.Errorf(msg.ErrorBatches.Error(), failed, batches)
+	}
+
+	logger.LogSuccess(out, fmt.Sprintf(msg.OutputSuccess, len(items), m.name, batches))
+	return nil
+}
@@ -0,0 +1,173 @@
+package purge
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	msg "github.com/aziontech/azion-cli/messages/purge"
+	"github.com/aziontech/azion-cli/pkg/httpmock"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestPurge(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+
+	t.Run("urls from a file are purged in batches", func(t *testing.T) {
+		var sizes []int
+		mock := &httpmock.Registry{}
+		for i := 0; i < 3; i++ {
+			mock.Register(
+				nthRequest("purge/url", i, &sizes),
+				httpmock.RESTPayload(201, "", func(payload map[string]interface{}) {
+					urls := payload["urls"].([]interface{})
+					sizes = append(sizes, len(urls))
+					assert.Equal(t, "delete", payload["method"])
+					assert.False(t, strings.HasPrefix(urls[0].(string), "https://"))
+				}),
+			)
+		}
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--from-file", "./fixtures/urls.txt"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		assert.Equal(t, []int{50, 50, 20}, sizes)
+		assert.Equal(t,
+			"Batch 1/3: purged 50 URLs\n"+
+				"Batch 2/3: purged 50 URLs\n"+
+				"Batch 3/3: purged 20 URLs\n"+
+				"🚀 Purged 120 URLs in 3 requests\n",
+			stdout.String(),
+		)
+	})
+
+	t.Run("each wildcard expression is purged on its own request", func(t *testing.T) {
+		var expressions []interface{}
+		mock := &httpmock.Registry{}
+		for i := 0; i < 2; i++ {
+			mock.Register(
+				nthRequest("purge/wildcard", i, &expressions),
+				httpmock.RESTPayload(201, "", func(payload map[string]interface{}) {
+					expressions = append(expressions, payload["urls"].([]interface{})...)
+				}),
+			)
+		}
+
+		f, _, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--wildcard", "www.example.com/images/*,www.example.com/css/*"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		assert.Equal(t, []interface{}{"www.example.com/images/*", "www.example.com/css/*"}, expressions)
+	})
+
+	t.Run("cache keys from stdin on the tiered layer", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("POST", "purge/cachekey"),
+			httpmock.RESTPayload(201, "", func(payload map[string]interface{}) {
+				assert.Equal(t, "l2_caching", payload["layer"])
+				assert.Equal(t, []interface{}{"www.example.com/@@session=a", "www.example.com/@@session=b"}, payload["urls"])
+			}),
+		)
+
+		f, _, _ := testutils.NewFactory(mock)
+		f.IOStreams.In = io.NopCloser(strings.NewReader("www.example.com/@@session=a\nwww.example.com/@@session=b\n"))
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--from-file", "-", "--type", "cache-key", "--layer", "tiered"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		mock.Verify(t)
+	})
+
+	t.Run("a failed batch doesn't stop the others", func(t *testing.T) {
+		var calls []int
+		mock := &httpmock.Registry{}
+		mock.Register(
+			nthRequest("purge/wildcard", 0, &calls),
+			func(req *http.Request) (*http.Response, error) {
+				calls = append(calls, 429)
+				return httpmock.StatusStringResponse(429, "Too Many Requests")(req)
+			},
+		)
+		mock.Register(
+			nthRequest("purge/wildcard", 1, &calls),
+			func(req *http.Request) (*http.Response, error) {
+				calls = append(calls, 201)
+				return httpmock.StatusStringResponse(201, "")(req)
+			},
+		)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--wildcard", "www.example.com/a/*,www.example.com/b/*"})
+
+		_, err := cmd.ExecuteC()
+		require.ErrorContains(t, err, "1 of 2 purge requests failed")
+		assert.Equal(t, []int{429, 201}, calls)
+		assert.Contains(t, stdout.String(), "Batch 1/2 failed: ")
+		assert.Contains(t, stdout.String(), "Batch 2/2: purged 1 Wildcard expressions\n")
+	})
+
+	t.Run("tiered layer is only for cache keys", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+
+		f, _, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--urls", "www.example.com/", "--layer", "tiered"})
+
+		_, err := cmd.ExecuteC()
+		require.Equal(t, msg.ErrorLayerMode, err)
+	})
+
+	t.Run("one mode at a time", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+
+		f, _, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--urls", "www.example.com/", "--wildcard", "www.example.com/*"})
+
+		_, err := cmd.ExecuteC()
+		require.Equal(t, msg.ErrorModes, err)
+	})
+
+	t.Run("wildcard without a wildcard", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+
+		f, _, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--wildcard", "www.example.com/index.html"})
+
+		_, err := cmd.ExecuteC()
+		require.ErrorContains(t, err, "has no *")
+	})
+
+	t.Run("nothing to purge", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+
+		f, _, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{})
+
+		_, err := cmd.ExecuteC()
+		require.Equal(t, msg.ErrorMissingItems, err)
+	})
+}
+
+// nthRequest matches the purge request sent after as many others as the responses recorded,
+// since each stub answers a single request
+func nthRequest[T any](path string, n int, responses *[]T) httpmock.Matcher {
+	return func(req *http.Request) bool {
+		return httpmock.REST("POST", path)(req) && len(*responses) == n
+	}
+}
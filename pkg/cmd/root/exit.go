@@ -0,0 +1,152 @@
+package root
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+
+	"github.com/AlecAivazis/survey/v2/terminal"
+	"github.com/manifoldco/promptui"
+
+	msg "github.com/aziontech/azion-cli/messages/root"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/httpclient"
+	"github.com/aziontech/azion-cli/utils"
+)
+
+const (
+	errorFormatText = "text"
+	errorFormatJSON = "json"
+)
+
+// exitNames are the codes of the JSON errors, one for each exit code
+var exitNames = map[int]string{
+	cmdutil.ExitError:       "error",
+	cmdutil.ExitValidation:  "validation",
+	cmdutil.ExitAuth:        "auth",
+	cmdutil.ExitNotFound:    "not_found",
+	cmdutil.ExitConflict:    "conflict",
+	cmdutil.ExitRateLimited: "rate_limited",
+	cmdutil.ExitNetwork:     "network",
+	cmdutil.ExitServer:      "server",
+	cmdutil.ExitAborted:     "aborted",
+}
+
+// knownErrors are the errors with an exit code of their own. The messages of the commands wrap
+// them with %w, so they are found anywhere in the chain
+var knownErrors = []struct {
+	err  error
+	code int
+}{
+	{utils.ErrorCancelledContextInput, cmdutil.ExitAborted},
+	{utils.ErrorToken401, cmdutil.ExitAuth},
+	{utils.ErrorForbidden403, cmdutil.ExitAuth},
+	{utils.ErrorTokenNotProvided, cmdutil.ExitAuth},
+	{utils.ErrorInvalidToken, cmdutil.ExitAuth},
+	{utils.ErrorNotFound404, cmdutil.ExitNotFound},
+	{utils.ErrorNameInUse, cmdutil.ExitConflict},
+	{utils.ErrorTimeoutAPICall, cmdutil.ExitNetwork},
+	{utils.ErrorInternalServerError, cmdutil.ExitServer},
+	{utils.ErrorConvertingStringToBool, cmdutil.ExitValidation},
+	{utils.ErrorConvertingStringToInt, cmdutil.ExitValidation},
+	{utils.ErrorUpdateNoFlagsSent, cmdutil.ExitValidation},
+	{utils.ErrorInvalidOption, cmdutil.ExitValidation},
+	{utils.ErrorMinTlsVersion, cmdutil.ExitValidation},
+}
+
+// jsonError is what --error-format json writes to the standard error. The fields the CLI doesn't
+// know are null
+type jsonError struct {
+	Code       string  `json:"code"`
+	Message    string  `json:"message"`
+	HTTPStatus *int    `json:"http_status"`
+	RequestID  *string `json:"request_id"`
+}
+
+func checkErrorFormat(format string) error {
+	switch format {
+	case errorFormatText, errorFormatJSON:
+		return nil
+	}
+	return cmdutil.FlagErrorWrap(fmt.Errorf(msg.ErrorErrorFormat.Error(), format))
+}
+
+// printError writes the error of the command in the format given, and returns the exit code. The
+// failure is how the last request of the command failed, if it did
+func printError(out io.Writer, format string, err error, failure *httpclient.Failure) int {
+	code := exitCode(err, failure)
+
+	if format != errorFormatJSON {
+		fmt.Fprintln(out, "Error:", err)
+		return code
+	}
+
+	output := jsonError{Code: exitNames[code], Message: err.Error()}
+	if failure != nil && failure.StatusCode != 0 {
+		output.HTTPStatus = &failure.StatusCode
+		if failure.RequestID != "" {
+			output.RequestID = &failure.RequestID
+		}
+	}
+	data, errMarshal := json.Marshal(output)
+	if errMarshal != nil {
+		fmt.Fprintln(out, "Error:", err)
+		return code
+	}
+	fmt.Fprintln(out, string(data))
+	return code
+}
+
+// exitCode classifies the error of the command. The errors the CLI knows come first, then the
+// last request sent, as most commands turn the API errors into messages of their own
+func exitCode(err error, failure *httpclient.Failure) int {
+	if errors.Is(err, terminal.InterruptErr) || errors.Is(err, promptui.ErrInterrupt) {
+		return cmdutil.ExitAborted
+	}
+	var flagErr *cmdutil.FlagError
+	if errors.As(err, &flagErr) {
+		return cmdutil.ExitValidation
+	}
+	for _, known := range knownErrors {
+		if errors.Is(err, known.err) {
+			return known.code
+		}
+	}
+
+	if failure != nil {
+		if failure.Err != nil {
+			return cmdutil.ExitNetwork
+		}
+		if code := statusExitCode(failure.StatusCode); code != cmdutil.ExitError {
+			return code
+		}
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) || errors.Is(err, context.DeadlineExceeded) {
+		return cmdutil.ExitNetwork
+	}
+	return cmdutil.ExitError
+}
+
+func statusExitCode(status int) int {
+	switch {
+	case status == http.StatusUnauthorized, status == http.StatusForbidden:
+		return cmdutil.ExitAuth
+	case status == http.StatusNotFound:
+		return cmdutil.ExitNotFound
+	case status == http.StatusConflict:
+		return cmdutil.ExitConflict
+	case status == http.StatusTooManyRequests:
+		return cmdutil.ExitRateLimited
+	case status == http.StatusBadRequest, status == http.StatusUnprocessableEntity:
+		return cmdutil.ExitValidation
+	case status >= http.StatusInternalServerError:
+		return cmdutil.ExitServer
+	}
+	return cmdutil.ExitError
+}
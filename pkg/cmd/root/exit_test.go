@@ -0,0 +1,63 @@
+package root
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	msg "github.com/aziontech/azion-cli/messages/delete/edge_application"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/httpclient"
+	"github.com/aziontech/azion-cli/utils"
+)
+
+func TestExitCode(t *testing.T) {
+	tests := []struct {
+		name    string
+		err     error
+		failure *httpclient.Failure
+		want    int
+	}{
+		{name: "known error in a message", err: fmt.Errorf(msg.ErrorFailToDeleteApplication.Error(), utils.ErrorToken401), want: cmdutil.ExitAuth},
+		{name: "text of a known error", err: fmt.Errorf("Failed: %s", utils.ErrorToken401), want: cmdutil.ExitError},
+		{name: "wrapped known error", err: fmt.Errorf("%w: www", utils.ErrorNameInUse), want: cmdutil.ExitConflict},
+		{name: "missing flags", err: cmdutil.FlagErrorWrap(fmt.Errorf(utils.ErrorMissingFlags.Error(), "--name")), want: cmdutil.ExitValidation},
+		{name: "rate limited", err: fmt.Errorf("Failed to list the domains: too many requests"), failure: &httpclient.Failure{StatusCode: http.StatusTooManyRequests}, want: cmdutil.ExitRateLimited},
+		{name: "invalid request", err: fmt.Errorf("name: This field is required"), failure: &httpclient.Failure{StatusCode: http.StatusBadRequest}, want: cmdutil.ExitValidation},
+		{name: "server error", err: fmt.Errorf("Failed to list the domains"), failure: &httpclient.Failure{StatusCode: http.StatusBadGateway}, want: cmdutil.ExitServer},
+		{name: "no response", err: fmt.Errorf("Failed to list the domains"), failure: &httpclient.Failure{Err: fmt.Errorf("connection refused")}, want: cmdutil.ExitNetwork},
+		{name: "other errors", err: fmt.Errorf("Failed to write the file"), want: cmdutil.ExitError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, exitCode(tt.err, tt.failure))
+		})
+	}
+}
+
+func TestPrintError(t *testing.T) {
+	t.Run("text", func(t *testing.T) {
+		out := &bytes.Buffer{}
+		code := printError(out, errorFormatText, utils.ErrorUpdateNoFlagsSent, nil)
+		assert.Equal(t, cmdutil.ExitValidation, code)
+		assert.Equal(t, "Error: "+utils.ErrorUpdateNoFlagsSent.Error()+"\n", out.String())
+	})
+
+	t.Run("json", func(t *testing.T) {
+		out := &bytes.Buffer{}
+		failure := &httpclient.Failure{StatusCode: http.StatusNotFound, RequestID: "b1f3c2"}
+		code := printError(out, errorFormatJSON, fmt.Errorf("Failed to describe the domain"), failure)
+		assert.Equal(t, cmdutil.ExitNotFound, code)
+		assert.JSONEq(t, `{"code":"not_found","message":"Failed to describe the domain","http_status":404,"request_id":"b1f3c2"}`, out.String())
+	})
+
+	t.Run("json without a request", func(t *testing.T) {
+		out := &bytes.Buffer{}
+		code := printError(out, errorFormatJSON, fmt.Errorf("Failed to write the file"), nil)
+		assert.Equal(t, cmdutil.ExitError, code)
+		assert.JSONEq(t, `{"code":"error","message":"Failed to write the file","http_status":null,"request_id":null}`, out.String())
+	})
+}
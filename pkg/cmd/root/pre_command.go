@@ -2,6 +2,8 @@ package root
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"time"
@@ -13,17 +15,31 @@ import (
 	"github.com/aziontech/azion-cli/pkg/cmd/version"
 	"github.com/aziontech/azion-cli/pkg/cmdutil"
 	"github.com/aziontech/azion-cli/pkg/config"
+	"github.com/aziontech/azion-cli/pkg/httpclient"
 	"github.com/aziontech/azion-cli/pkg/logger"
 	"github.com/aziontech/azion-cli/pkg/metric"
 	"github.com/aziontech/azion-cli/pkg/token"
 	"github.com/aziontech/azion-cli/utils"
-	"github.com/pelletier/go-toml"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+)
+
+const (
+	// profileEnv selects the profile when the --profile flag isn't given
+	profileEnv = "AZIONCLI_PROFILE"
+	// noInteractiveEnv disables the prompts when the --no-interactive flag isn't given
+	noInteractiveEnv = "AZIONCLI_NO_INTERACTIVE"
 )
 
 type PreCmd struct {
-	token  string
-	config string
+	token         string
+	config        string
+	profile       string
+	debugHTTP     bool
+	debugHTTPBody bool
+	har           string
+	noInteractive bool
 }
 
 type OSInfo struct {
@@ -40,26 +56,52 @@ func doPreCommandCheck(cmd *cobra.Command, f *cmdutil.Factory, pre PreCmd) error
 	rewrittenCommand := strings.ReplaceAll(strings.TrimPrefix(commandName, "azion "), " ", "-")
 	commandName = rewrittenCommand
 
+	f.IOStreams.SetNeverPrompt(neverPrompt(cmd, pre))
+	utils.SetInteractive(f.IOStreams.CanPrompt(), func() []string {
+		return cmdutil.MissingPrompted(cmd)
+	})
+
 	if cmd.Flags().Changed("config") {
 		if err := config.SetPath(pre.config); err != nil {
 			return err
 		}
 	}
 
+	profile := os.Getenv(profileEnv)
+	if cmd.Flags().Changed("profile") {
+		profile = pre.profile
+	}
+	token.SelectProfile(profile)
+
 	settings, err := token.ReadSettings()
 	if err != nil {
 		return err
 	}
 	globalSettings = &settings
 
-	if err := checkTokenSent(cmd, f, pre.token, globalSettings); err != nil {
+	project, err := config.ReadProject()
+	if err != nil {
+		logger.Debug("Error while reading the project settings", zap.Error(err))
+	}
+
+	if err := configureHTTPClient(f, globalSettings, project, pre); err != nil {
 		return err
 	}
 
-	if err := checkAuthorizeMetricsCollection(cmd, f.GlobalFlagAll, globalSettings); err != nil {
+	if err := checkTokenSent(cmd, f, pre.token, globalSettings); err != nil {
 		return err
 	}
 
+	applyProfile(f, globalSettings, project)
+
+	checkTokenExpiry(cmd, f, globalSettings)
+
+	if f.IOStreams.CanPrompt() {
+		if err := checkAuthorizeMetricsCollection(cmd, f.GlobalFlagAll, globalSettings); err != nil {
+			return err
+		}
+	}
+
 	//both verifications occurs if 24 hours have passed since the last execution
 	if err := checkForUpdateAndMetrics(version.BinVersion, f, globalSettings); err != nil {
 		return err
@@ -68,6 +110,18 @@ func doPreCommandCheck(cmd *cobra.Command, f *cmdutil.Factory, pre PreCmd) error
 	return nil
 }
 
+// neverPrompt tells whether the prompts were disabled by the --no-interactive flag or, when it
+// isn't given, by the AZIONCLI_NO_INTERACTIVE environment variable. Any value other than a
+// false one enables it
+func neverPrompt(cmd *cobra.Command, pre PreCmd) bool {
+	if cmd.Flags().Changed("no-interactive") {
+		return pre.noInteractive
+	}
+	value := os.Getenv(noInteractiveEnv)
+	enabled, err := strconv.ParseBool(value)
+	return enabled || (err != nil && value != "")
+}
+
 func checkTokenSent(cmd *cobra.Command, f *cmdutil.Factory, configureToken string, settings *token.Settings) error {
 
 	// if global --token flag was sent, verify it and save it locally
@@ -93,24 +147,21 @@ func checkTokenSent(cmd *cobra.Command, f *cmdutil.Factory, configureToken strin
 			return utils.ErrorInvalidToken
 		}
 
-		strToken := token.Settings{
-			Token:                      configureToken,
-			ClientId:                   user.Results.ClientID,
-			Email:                      user.Results.Email,
-			AuthorizeMetricsCollection: settings.AuthorizeMetricsCollection,
-		}
-
-		bStrToken, err := toml.Marshal(strToken)
-		if err != nil {
+		// the token replaces the one of the profile in use, whose ID and expiration aren't known
+		settings.Token = configureToken
+		settings.UUID = ""
+		settings.ExpiresAt = time.Time{}
+		settings.ClientId = user.Results.ClientID
+		settings.Email = user.Results.Email
+		if err := token.WriteSettings(*settings); err != nil {
 			return err
 		}
 
-		filePath, err := t.Save(bStrToken)
+		dir, err := config.Dir()
 		if err != nil {
 			return err
 		}
-
-		globalSettings = &strToken
+		filePath := filepath.Join(dir.Dir, dir.Settings)
 
 		logger.LogSuccess(f.IOStreams.Out, fmt.Sprintf(msg.TokenSavedIn, filePath))
 		logger.FInfo(f.IOStreams.Out, msg.TokenUsedIn+"\n")
@@ -119,6 +170,77 @@ func checkTokenSent(cmd *cobra.Command, f *cmdutil.Factory, configureToken strin
 	return nil
 }
 
+// applyProfile makes the commands use the token, URLs and defaults of the project and the profile
+// in use. They're set as defaults, so the values given through the environment still take precedence
+func applyProfile(f *cmdutil.Factory, settings *token.Settings, project map[string]string) {
+	v, ok := f.Config.(*viper.Viper)
+	if !ok {
+		return
+	}
+
+	for _, key := range settings.Keys() {
+		v.SetDefault(key, settings.Resolve(key, nil, project).Value)
+	}
+}
+
+// configureHTTPClient applies the timeouts, retries, proxy and certificate authorities of the
+// settings to the client the commands share, and the tracing the flags ask for
+func configureHTTPClient(f *cmdutil.Factory, settings *token.Settings, project map[string]string, pre PreCmd) error {
+	if f.HttpClient == nil {
+		return nil
+	}
+
+	var opts httpclient.Options
+	var err error
+	timeout := settings.Resolve("timeout", nil, project)
+	if opts.Timeout, err = httpclient.ParseTimeout(timeout.Value); err != nil {
+		return fmt.Errorf(msg.ErrorHTTPSetting.Error(), timeout.Key, timeout.Origin, err)
+	}
+	uploadTimeout := settings.Resolve("upload_timeout", nil, project)
+	if opts.UploadTimeout, err = httpclient.ParseTimeout(uploadTimeout.Value); err != nil {
+		return fmt.Errorf(msg.ErrorHTTPSetting.Error(), uploadTimeout.Key, uploadTimeout.Origin, err)
+	}
+	retries := settings.Resolve("max_retries", nil, project)
+	if opts.MaxRetries, err = strconv.Atoi(retries.Value); err != nil || opts.MaxRetries < 0 {
+		return fmt.Errorf(msg.ErrorHTTPSetting.Error(), retries.Key, retries.Origin, "expected a number of retries such as 3")
+	}
+	retryMaxWait := settings.Resolve("retry_max_wait", nil, project)
+	if opts.RetryMaxWait, err = httpclient.ParseTimeout(retryMaxWait.Value); err != nil {
+		return fmt.Errorf(msg.ErrorHTTPSetting.Error(), retryMaxWait.Key, retryMaxWait.Origin, err)
+	}
+	opts.Proxy = settings.Resolve("proxy", nil, project).Value
+	opts.CABundle = settings.Resolve("ca_bundle", nil, project).Value
+
+	if pre.debugHTTP {
+		opts.Trace = f.IOStreams.Err
+		opts.TraceBodies = pre.debugHTTPBody
+	}
+	if pre.har != "" {
+		harLog = httpclient.NewHAR("Azion CLI", version.BinVersion)
+		opts.HAR = harLog
+	}
+
+	if err := httpclient.Configure(f.HttpClient, opts); err != nil {
+		return fmt.Errorf(msg.ErrorHTTPClient.Error(), err)
+	}
+	return nil
+}
+
+// checkTokenExpiry warns when the saved token is about to expire and the command uses it. The commands
+// that show, replace or discard the token are left out
+func checkTokenExpiry(cmd *cobra.Command, f *cmdutil.Factory, settings *token.Settings) {
+	switch cmd.Name() {
+	case "whoami", "login", "logout", "rotate", "completion":
+		return
+	}
+
+	// a token given through the environment replaces the saved one
+	if f.Config == nil || f.Config.GetString("token") != settings.Token {
+		return
+	}
+	token.WarnExpiry(f.IOStreams.Err, *settings, time.Now())
+}
+
 func checkForUpdateAndMetrics(cVersion string, f *cmdutil.Factory, settings *token.Settings) error {
 	logger.Debug("Verifying if an update is required")
 	// checks if 24 hours have passed since the last check
@@ -131,7 +253,7 @@ func checkForUpdateAndMetrics(cVersion string, f *cmdutil.Factory, settings *tok
 		metric.Send(settings)
 	}
 
-	tagName, err := github.GetVersionGitHub("azion")
+	tagName, err := github.GetVersionGitHub(f.HttpClient, "azion")
 	if err != nil {
 		return err
 	}
@@ -205,7 +327,10 @@ func checkAuthorizeMetricsCollection(cmd *cobra.Command, globalFlagAll bool, set
 		return nil
 	}
 
-	authorize := utils.Confirm(globalFlagAll, msg.AskCollectMetrics, true)
+	authorize, err := utils.Confirm(globalFlagAll, msg.AskCollectMetrics, true)
+	if err != nil {
+		return err
+	}
 	if authorize {
 		settings.AuthorizeMetricsCollection = 1
 	} else {
@@ -2,7 +2,7 @@ package root
 
 import (
 	"fmt"
-	"net/http"
+	"os"
 	"strings"
 	"time"
 
@@ -10,15 +10,22 @@ import (
 	msg "github.com/aziontech/azion-cli/messages/root"
 	buildCmd "github.com/aziontech/azion-cli/pkg/cmd/build"
 	"github.com/aziontech/azion-cli/pkg/cmd/completion"
+	configcmd "github.com/aziontech/azion-cli/pkg/cmd/config"
 	"github.com/aziontech/azion-cli/pkg/cmd/create"
 	"github.com/aziontech/azion-cli/pkg/cmd/delete"
 	"github.com/aziontech/azion-cli/pkg/cmd/describe"
+	dnscmd "github.com/aziontech/azion-cli/pkg/cmd/dns"
 	"github.com/aziontech/azion-cli/pkg/cmd/list"
 	"github.com/aziontech/azion-cli/pkg/cmd/login"
 	"github.com/aziontech/azion-cli/pkg/cmd/logout"
 	logcmd "github.com/aziontech/azion-cli/pkg/cmd/logs"
+	metricscmd "github.com/aziontech/azion-cli/pkg/cmd/metrics"
+	personaltoken "github.com/aziontech/azion-cli/pkg/cmd/personal_token"
+	"github.com/aziontech/azion-cli/pkg/cmd/profile"
+	"github.com/aziontech/azion-cli/pkg/cmd/purge"
 	"github.com/aziontech/azion-cli/pkg/cmd/unlink"
 	"github.com/aziontech/azion-cli/pkg/cmd/update"
+	variablescmd "github.com/aziontech/azion-cli/pkg/cmd/variables"
 	"github.com/aziontech/azion-cli/pkg/cmd/whoami"
 	"github.com/aziontech/azion-cli/pkg/metric"
 
@@ -29,6 +36,7 @@ import (
 	"github.com/aziontech/azion-cli/pkg/cmd/version"
 	"github.com/aziontech/azion-cli/pkg/cmdutil"
 	"github.com/aziontech/azion-cli/pkg/constants"
+	"github.com/aziontech/azion-cli/pkg/httpclient"
 	"github.com/aziontech/azion-cli/pkg/iostreams"
 	"github.com/aziontech/azion-cli/pkg/logger"
 	"github.com/aziontech/azion-cli/pkg/token"
@@ -53,6 +61,13 @@ func NewRootCmd(f *cmdutil.Factory) *RootCmd {
 var (
 	tokenFlag      string
 	configFlag     string
+	profileFlag    string
+	debugHTTPFlag  bool
+	debugHTTPBody  bool
+	harFlag        string
+	noInteractive  bool
+	errorFormat    string
+	harLog         *httpclient.HAR
 	commandName    string
 	globalSettings *token.Settings
 	startTime      time.Time
@@ -78,15 +93,28 @@ func NewCobraCmd(rootCmd *RootCmd, f *cmdutil.Factory) *cobra.Command {
 				return msg.ErrorPrefix
 			}
 
+			if err := checkErrorFormat(errorFormat); err != nil {
+				return err
+			}
+
 			return doPreCommandCheck(cmd, f, PreCmd{
-				config: configFlag,
-				token:  tokenFlag,
+				config:        configFlag,
+				token:         tokenFlag,
+				profile:       profileFlag,
+				debugHTTP:     debugHTTPFlag || debugHTTPBody,
+				debugHTTPBody: debugHTTPBody,
+				har:           harFlag,
+				noInteractive: noInteractive,
 			})
 		},
 		Example: heredoc.Doc(`
 		$ azion
 		$ azion -t azionb43a9554776zeg05b11cb1declkbabcc9la
 		$ azion --debug
+		$ azion list domain --debug-http
+		$ azion list domain --har support.har
+		$ azion create domain --name example --application-id 1234 --no-interactive
+		$ azion describe domain --domain-id 1234 --error-format json
 		$ azion -h
 		`),
 		RunE: func(cmd *cobra.Command, _ []string) error {
@@ -103,6 +131,11 @@ func NewCobraCmd(rootCmd *RootCmd, f *cmdutil.Factory) *cobra.Command {
 	cobraCmd.SetOut(f.IOStreams.Out)
 	cobraCmd.SetErr(f.IOStreams.Err)
 
+	// flag errors exit with the validation code
+	cobraCmd.SetFlagErrorFunc(func(_ *cobra.Command, err error) error {
+		return cmdutil.FlagErrorWrap(err)
+	})
+
 	cobraCmd.SetHelpFunc(func(cmd *cobra.Command, args []string) {
 		rootHelpFunc(cmd, args)
 	})
@@ -110,10 +143,17 @@ func NewCobraCmd(rootCmd *RootCmd, f *cmdutil.Factory) *cobra.Command {
 	// Global flags
 	cobraCmd.PersistentFlags().StringVarP(&tokenFlag, "token", "t", "", msg.RootTokenFlag)
 	cobraCmd.PersistentFlags().StringVarP(&configFlag, "config", "c", "", msg.RootConfigFlag)
+	cobraCmd.PersistentFlags().StringVar(&profileFlag, "profile", "", msg.RootProfileFlag)
 	cobraCmd.PersistentFlags().BoolVarP(&f.GlobalFlagAll, "yes", "y", false, msg.RootYesFlag)
 	cobraCmd.PersistentFlags().BoolVarP(&f.Debug, "debug", "d", false, msg.RootLogDebug)
 	cobraCmd.PersistentFlags().BoolVarP(&f.Silent, "silent", "s", false, msg.RootLogSilent)
 	cobraCmd.PersistentFlags().StringVarP(&f.LogLevel, "log-level", "l", "info", msg.RootLogLevel)
+	cobraCmd.PersistentFlags().BoolVar(&debugHTTPFlag, "debug-http", false, msg.RootDebugHTTPFlag)
+	cobraCmd.PersistentFlags().BoolVar(&debugHTTPBody, "debug-http-body", false, msg.RootDebugHTTPBodyFlag)
+	cobraCmd.PersistentFlags().StringVar(&harFlag, "har", "", msg.RootHARFlag)
+	cobraCmd.PersistentFlags().BoolVar(&noInteractive, "no-interactive", false, msg.RootNoInteractiveFlag)
+	cobraCmd.PersistentFlags().StringVar(&errorFormat, "error-format", errorFormatText, msg.RootErrorFormatFlag)
+	cmdutil.AddFormatFlag(cobraCmd, f)
 
 	// other flags
 	cobraCmd.Flags().BoolP("help", "h", false, msg.RootHelpFlag)
@@ -123,6 +163,13 @@ func NewCobraCmd(rootCmd *RootCmd, f *cmdutil.Factory) *cobra.Command {
 
 	cobraCmd.AddCommand(initcmd.NewCmd(f))
 	cobraCmd.AddCommand(logcmd.NewCmd(f))
+	cobraCmd.AddCommand(metricscmd.NewCmd(f))
+	cobraCmd.AddCommand(purge.NewCmd(f))
+	cobraCmd.AddCommand(dnscmd.NewCmd(f))
+	cobraCmd.AddCommand(personaltoken.NewCmd(f))
+	cobraCmd.AddCommand(variablescmd.NewCmd(f))
+	cobraCmd.AddCommand(profile.NewCmd(f))
+	cobraCmd.AddCommand(configcmd.NewCmd(f))
 	cobraCmd.AddCommand(deploycmd.NewCmd(f))
 	cobraCmd.AddCommand(buildCmd.NewCmd(f))
 	cobraCmd.AddCommand(devcmd.NewCmd(f))
@@ -144,14 +191,12 @@ func NewCobraCmd(rootCmd *RootCmd, f *cmdutil.Factory) *cobra.Command {
 
 func Execute() {
 	streams := iostreams.System()
-	httpClient := &http.Client{
-		Timeout: 10 * time.Second, // TODO: Configure this somewhere
-	}
+	// the timeouts, proxy and certificate authorities of the settings are applied before each command
+	httpClient := httpclient.New()
 
-	tok, _ := token.ReadSettings()
+	// the token is set once the settings of the selected profile are read, before each command
 	viper.SetEnvPrefix("AZIONCLI")
 	viper.AutomaticEnv()
-	viper.SetDefault("token", tok.Token)
 	viper.SetDefault("api_url", constants.ApiURL)
 	viper.SetDefault("storage_url", constants.StorageApiURL)
 
@@ -163,16 +208,29 @@ func Execute() {
 
 	cmd := NewCmd(factory)
 	err := cmd.Execute()
+	// the metrics below send requests of their own
+	failure := httpclient.LastFailure()
+	if harLog != nil {
+		if errHAR := harLog.WriteFile(harFlag); errHAR != nil {
+			if err == nil {
+				err = fmt.Errorf(msg.ErrorWriteHAR.Error(), harFlag, errHAR)
+			}
+		} else {
+			logger.FInfo(streams.Err, fmt.Sprintf(msg.HARSaved, harFlag))
+		}
+	}
 	executionTime := time.Since(startTime).Seconds()
 
 	// 1 = authorize; anything different than 1 means that the user did not authorize metrics collection, or did not answer the question yet
 	if globalSettings != nil {
 		if globalSettings.AuthorizeMetricsCollection == 1 {
-			errMetrics := metric.TotalCommandsCount(cmd, commandName, executionTime, err)
+			errMetrics := metric.TotalCommandsCount(httpClient, cmd, commandName, executionTime, err)
 			if errMetrics != nil {
 				logger.Debug("Error while saving metrics", zap.Error(err))
 			}
 		}
 	}
-	cobra.CheckErr(err)
+	if err != nil {
+		os.Exit(printError(streams.Err, errorFormat, err, failure))
+	}
 }
@@ -11,7 +11,7 @@ import (
 )
 
 type UnlinkCmd struct {
-	ShouldClean func(f *cmdutil.Factory) bool
+	ShouldClean func(f *cmdutil.Factory) (bool, error)
 	Clean       func(f *cmdutil.Factory, cmd *UnlinkCmd) error
 	IsDirEmpty  func(dirpath string) (bool, error)
 	CleanDir    func(dirpath string) error
@@ -53,7 +53,11 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 }
 
 func (cmd *UnlinkCmd) run() error {
-	if shouldClean(cmd.F) {
+	shouldUnlink, err := shouldClean(cmd.F)
+	if err != nil {
+		return err
+	}
+	if shouldUnlink {
 		err := cmd.Clean(cmd.F, cmd)
 		if err != nil {
 			return err
@@ -10,7 +10,7 @@ import (
 	"go.uber.org/zap"
 )
 
-func shouldClean(f *cmdutil.Factory) bool {
+func shouldClean(f *cmdutil.Factory) (bool, error) {
 	msg := "Do you want to unlink this project? (y/N)"
 	return helpers.Confirm(f.GlobalFlagAll, msg, false)
 }
@@ -22,7 +22,10 @@ func clean(f *cmdutil.Factory, cmd *UnlinkCmd) error {
 		if f.GlobalFlagAll {
 			shouldCascade = true
 		} else {
-			answer := helpers.Confirm(f.GlobalFlagAll, "Would you like to delete remote resources as well? (y/N)", false)
+			answer, err := helpers.Confirm(f.GlobalFlagAll, "Would you like to delete remote resources as well? (y/N)", false)
+			if err != nil {
+				return err
+			}
 			shouldCascade = answer
 		}
 
@@ -67,7 +67,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 
 				if err != nil {
 					logger.Debug("Error while parsing answer", zap.Error(err))
-					return utils.ErrorParseResponse
+					return err
 				}
 
 				applicationID, err := strconv.Atoi(answers)
@@ -84,7 +84,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 
 				if err != nil {
 					logger.Debug("Error while parsing answer", zap.Error(err))
-					return utils.ErrorParseResponse
+					return err
 				}
 
 				cacheSettingID, err := strconv.Atoi(answers)
@@ -125,6 +125,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 
 	flags := cmd.Flags()
 	addFlags(flags, fields)
+	cmdutil.MarkPrompted(cmd, "application-id", "cache-setting-id")
 	return cmd
 }
 
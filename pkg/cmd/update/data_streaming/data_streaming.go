@@ -0,0 +1,210 @@
+package datastreaming
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"go.uber.org/zap"
+
+	msg "github.com/aziontech/azion-cli/messages/data_streaming"
+	api "github.com/aziontech/azion-cli/pkg/api/data_streaming"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/utils"
+	"github.com/spf13/cobra"
+)
+
+type Fields struct {
+	ID                 int64
+	Name               string
+	TemplateID         int64
+	DataSource         string
+	Active             string
+	EndpointFile       string
+	EndpointURL        string
+	Domains            []int64
+	AllDomains         string
+	SamplingPercentage int64
+	NoSampling         bool
+	Path               string
+}
+
+// noSampling is the percentage of the events streamed when sampling is disabled
+const noSampling = 100
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	fields := &Fields{}
+
+	cmd := &cobra.Command{
+		Use:           msg.Usage,
+		Short:         msg.UpdateShortDescription,
+		Long:          msg.UpdateLongDescription,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Example: heredoc.Doc(`
+		$ azion update data-streaming --data-streaming-id 1337 --endpoint-file "./splunk.yaml"
+		$ azion update data-streaming --data-streaming-id 1337 --domains 1234,5678
+		$ azion update data-streaming --data-streaming-id 1337 --sampling-percentage 25
+		$ azion update data-streaming --data-streaming-id 1337 --no-sampling
+		$ azion update data-streaming --data-streaming-id 1337 --active false
+		$ azion update data-streaming --data-streaming-id 1337 --file "update.yaml"
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !cmd.Flags().Changed("data-streaming-id") {
+				answer, err := utils.AskInput(msg.AskDataStreamingID)
+				if err != nil {
+					return err
+				}
+
+				id, err := strconv.ParseInt(answer, 10, 64)
+				if err != nil {
+					logger.Debug("Error while converting answer to int64", zap.Error(err))
+					return msg.ErrorConvertID
+				}
+				fields.ID = id
+			}
+
+			request := api.Request{}
+			if cmd.Flags().Changed("file") {
+				err := utils.FlagFileUnmarshalJSON(fields.Path, &request)
+				if err != nil {
+					logger.Debug("Error while parsing <"+fields.Path+"> file", zap.Error(err))
+					return utils.ErrorUnmarshalReader
+				}
+			} else if err := updateRequest(cmd, fields, &request); err != nil {
+				return err
+			}
+
+			if err := checkRequest(&request); err != nil {
+				return err
+			}
+
+			client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
+			response, err := client.Update(context.Background(), fields.ID, &request)
+			if err != nil {
+				return fmt.Errorf(msg.ErrorUpdate.Error(), err)
+			}
+
+			logger.LogSuccess(f.IOStreams.Out, fmt.Sprintf(msg.UpdateOutputSuccess, response.Id))
+			return nil
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.Int64Var(&fields.ID, "data-streaming-id", 0, msg.FlagDataStreamingID)
+	flags.StringVar(&fields.Name, "name", "", msg.FlagName)
+	flags.Int64Var(&fields.TemplateID, "template-id", 0, msg.FlagTemplateID)
+	flags.StringVar(&fields.DataSource, "data-source", "", msg.FlagDataSource)
+	flags.StringVar(&fields.Active, "active", "", msg.FlagActive)
+	flags.StringVar(&fields.EndpointFile, "endpoint-file", "", msg.FlagEndpointFile)
+	flags.StringVar(&fields.EndpointURL, "endpoint-url", "", msg.FlagEndpointURL)
+	flags.Int64SliceVar(&fields.Domains, "domains", []int64{}, msg.FlagDomains)
+	flags.StringVar(&fields.AllDomains, "all-domains", "", msg.FlagAllDomains)
+	flags.Int64Var(&fields.SamplingPercentage, "sampling-percentage", 0, msg.FlagSamplingPercentage)
+	flags.BoolVar(&fields.NoSampling, "no-sampling", false, msg.FlagNoSampling)
+	flags.StringVar(&fields.Path, "file", "", msg.UpdateFlagFile)
+	flags.BoolP("help", "h", false, msg.UpdateHelpFlag)
+	cmdutil.MarkPrompted(cmd, "data-streaming-id")
+	return cmd
+}
+
+// updateRequest sets the attributes given by the flags, leaving the others out of the request
+// so that they keep their values
+func updateRequest(cmd *cobra.Command, fields *Fields, request *api.Request) error {
+	changed := cmd.Flags().Changed
+
+	if cmd.Flags().NFlag() == 0 || (cmd.Flags().NFlag() == 1 && changed("data-streaming-id")) {
+		return msg.ErrorNoUpdateFlags
+	}
+
+	if changed("name") {
+		request.Name = &fields.Name
+	}
+	if changed("template-id") {
+		request.TemplateId = &fields.TemplateID
+	}
+	if changed("data-source") {
+		request.DataSource = &fields.DataSource
+	}
+
+	if changed("active") {
+		active, err := strconv.ParseBool(fields.Active)
+		if err != nil {
+			return msg.ErrorActiveFlag
+		}
+		request.Active = &active
+	}
+
+	if changed("all-domains") {
+		allDomains, err := strconv.ParseBool(fields.AllDomains)
+		if err != nil {
+			return msg.ErrorAllDomainsFlag
+		}
+		request.AllDomains = &allDomains
+	}
+	if changed("domains") {
+		if request.AllDomains != nil && *request.AllDomains {
+			return msg.ErrorDomainsFlags
+		}
+		// streaming chosen domains stops streaming all of them
+		allDomains := false
+		request.AllDomains = &allDomains
+		request.DomainsIds = fields.Domains
+	}
+
+	if changed("sampling-percentage") && changed("no-sampling") {
+		return msg.ErrorSamplingFlags
+	}
+	if changed("sampling-percentage") {
+		request.SamplingPercentage = &fields.SamplingPercentage
+	}
+	if fields.NoSampling {
+		percentage := int64(noSampling)
+		request.SamplingPercentage = &percentage
+	}
+
+	if changed("endpoint-file") && changed("endpoint-url") {
+		return msg.ErrorEndpointFlags
+	}
+	if changed("endpoint-url") {
+		request.Endpoint = api.Endpoint{"endpoint_type": api.EndpointStandard, "url": fields.EndpointURL}
+	}
+	if changed("endpoint-file") {
+		endpoint := api.Endpoint{}
+		if err := utils.FlagFileUnmarshalJSON(fields.EndpointFile, &endpoint); err != nil {
+			return fmt.Errorf(msg.ErrorEndpointFile.Error(), fields.EndpointFile, err)
+		}
+		request.Endpoint = endpoint
+	}
+	return nil
+}
+
+// checkRequest validates the attributes that change before they're sent
+func checkRequest(request *api.Request) error {
+	if request.DataSource != nil && !api.ValidDataSource(*request.DataSource) {
+		return fmt.Errorf(msg.ErrorDataSource.Error(), *request.DataSource)
+	}
+
+	if request.AllDomains != nil && *request.AllDomains && len(request.DomainsIds) > 0 {
+		return msg.ErrorDomainsFlags
+	}
+
+	if p := request.SamplingPercentage; p != nil && (*p < 0 || *p > 100) {
+		return fmt.Errorf(msg.ErrorSampling.Error(), *p)
+	}
+
+	endpoint := request.Endpoint
+	if endpoint == nil {
+		return nil
+	}
+	if !endpoint.ValidType() {
+		return fmt.Errorf(msg.ErrorEndpointType.Error(), endpoint.Type(), strings.Join(api.EndpointTypes(), ", "))
+	}
+	if missing := endpoint.Missing(); len(missing) > 0 {
+		return fmt.Errorf(msg.ErrorEndpointMissing.Error(), endpoint.Type(), strings.Join(missing, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+package datastreaming
+
+import (
+	"os"
+	"testing"
+
+	msg "github.com/aziontech/azion-cli/messages/data_streaming"
+	"github.com/aziontech/azion-cli/pkg/httpmock"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestUpdate(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+
+	t.Run("update the endpoint only", func(t *testing.T) {
+		response, _ := os.ReadFile("./fixtures/response.json")
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("PATCH", "data_streaming/streamings/1337"),
+			httpmock.RESTPayload(200, string(response), func(payload map[string]interface{}) {
+				assert.Len(t, payload, 1)
+				assert.Equal(t, map[string]interface{}{
+					"endpoint_type": "splunk",
+					"url":           "https://splunk.example.com:8088/services/collector",
+					"api_key":       "0f8e4c1a-splunk-token",
+				}, payload["endpoint"])
+			}),
+		)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--data-streaming-id", "1337", "--endpoint-file", "./fixtures/splunk.yaml"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		assert.Equal(t, "🚀 Updated Data Streaming with ID 1337\n\n", stdout.String())
+	})
+
+	t.Run("stream chosen domains", func(t *testing.T) {
+		response, _ := os.ReadFile("./fixtures/response.json")
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("PATCH", "data_streaming/streamings/1337"),
+			httpmock.RESTPayload(200, string(response), func(payload map[string]interface{}) {
+				assert.Equal(t, false, payload["all_domains"])
+				assert.Equal(t, []interface{}{float64(1234), float64(5678)}, payload["domains_ids"])
+			}),
+		)
+
+		f, _, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--data-streaming-id", "1337", "--domains", "1234,5678"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+	})
+
+	t.Run("disable sampling", func(t *testing.T) {
+		response, _ := os.ReadFile("./fixtures/response.json")
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("PATCH", "data_streaming/streamings/1337"),
+			httpmock.RESTPayload(200, string(response), func(payload map[string]interface{}) {
+				assert.Equal(t, map[string]interface{}{"sampling_percentage": float64(100)}, payload)
+			}),
+		)
+
+		f, _, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--data-streaming-id", "1337", "--no-sampling"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+	})
+
+	t.Run("enable sampling", func(t *testing.T) {
+		response, _ := os.ReadFile("./fixtures/response.json")
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("PATCH", "data_streaming/streamings/1337"),
+			httpmock.RESTPayload(200, string(response), func(payload map[string]interface{}) {
+				assert.Equal(t, map[string]interface{}{"sampling_percentage": float64(0)}, payload)
+			}),
+		)
+
+		f, _, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--data-streaming-id", "1337", "--sampling-percentage", "0"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+	})
+
+	t.Run("sampling flags together", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+
+		f, _, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--data-streaming-id", "1337", "--sampling-percentage", "10", "--no-sampling"})
+
+		_, err := cmd.ExecuteC()
+		require.ErrorIs(t, err, msg.ErrorSamplingFlags)
+	})
+
+	t.Run("no flags to update", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+
+		f, _, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--data-streaming-id", "1337"})
+
+		_, err := cmd.ExecuteC()
+		require.ErrorIs(t, err, msg.ErrorNoUpdateFlags)
+	})
+
+	t.Run("invalid data source", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+
+		f, _, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--data-streaming-id", "1337", "--data-source", "dns"})
+
+		_, err := cmd.ExecuteC()
+		require.EqualError(t, err, `Invalid --data-source flag provided: "dns". The sources are http, waf, cells_console and rtm_activity`)
+	})
+}
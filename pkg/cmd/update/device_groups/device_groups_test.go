@@ -1,4 +1,4 @@
-package update
+package devicegroups
 
 // import (
 // 	"fmt"
@@ -0,0 +1,144 @@
+package digitalcertificate
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc"
+	"go.uber.org/zap"
+
+	msg "github.com/aziontech/azion-cli/messages/digital_certificate"
+	api "github.com/aziontech/azion-cli/pkg/api/digital_certificate"
+	"github.com/aziontech/azion-cli/pkg/certificate"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/utils"
+	"github.com/spf13/cobra"
+)
+
+type Fields struct {
+	ID             int32
+	Name           string
+	Certificate    string
+	Chain          string
+	PrivateKey     string
+	DomainIDs      []int64
+	SkipValidation bool
+	Path           string
+}
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	fields := &Fields{}
+
+	cmd := &cobra.Command{
+		Use:           msg.Usage,
+		Short:         msg.UpdateShortDescription,
+		Long:          msg.UpdateLongDescription,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Example: heredoc.Doc(`
+		$ azion update digital-certificate --digital-certificate-id 1337 --name "renamed"
+		$ azion update digital-certificate --digital-certificate-id 1337 --certificate cert.pem --chain chain.pem --private-key key.pem
+		$ azion update digital-certificate --digital-certificate-id 1337 --certificate cert.pem --private-key key.pem --domain-id 1678392838
+		$ azion update digital-certificate --digital-certificate-id 1337 --file "update.json"
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+			request := api.UpdateRequest{}
+
+			if !cmd.Flags().Changed("digital-certificate-id") {
+				answer, err := utils.AskInput(msg.AskDigitalCertificateID)
+				if err != nil {
+					return err
+				}
+
+				id, err := strconv.ParseInt(answer, 10, 32)
+				if err != nil {
+					logger.Debug("Error while converting answer to int32", zap.Error(err))
+					return msg.ErrorConvertID
+				}
+				fields.ID = int32(id)
+			}
+
+			if cmd.Flags().Changed("file") {
+				err := utils.FlagFileUnmarshalJSON(fields.Path, &request)
+				if err != nil {
+					logger.Debug("Error while parsing <"+fields.Path+"> file", zap.Error(err))
+					return utils.ErrorUnmarshalReader
+				}
+
+				if !fields.SkipValidation && request.GetCertificate() != "" && request.GetPrivateKey() != "" {
+					bundle, err := certificate.Parse([]byte(request.GetCertificate()), []byte(request.GetPrivateKey()), fields.Path, fields.Path)
+					if err != nil {
+						return err
+					}
+					if err := certificate.Check(ctx, f, bundle, fields.DomainIDs); err != nil {
+						return err
+					}
+				}
+			} else {
+				if err := updateRequestFromFlags(ctx, cmd, f, fields, &request); err != nil {
+					return err
+				}
+			}
+
+			request.Id = fields.ID
+			client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
+			response, err := client.Update(ctx, &request)
+			if err != nil {
+				return fmt.Errorf(msg.ErrorUpdate.Error(), err)
+			}
+
+			logger.LogSuccess(f.IOStreams.Out, fmt.Sprintf(msg.UpdateOutputSuccess, response.GetId()))
+			return nil
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.Int32Var(&fields.ID, "digital-certificate-id", 0, msg.FlagDigitalCertificateID)
+	flags.StringVar(&fields.Name, "name", "", msg.FlagName)
+	flags.StringVar(&fields.Certificate, "certificate", "", msg.FlagCertificate)
+	flags.StringVar(&fields.Chain, "chain", "", msg.FlagChain)
+	flags.StringVar(&fields.PrivateKey, "private-key", "", msg.FlagPrivateKey)
+	flags.Int64SliceVar(&fields.DomainIDs, "domain-id", []int64{}, msg.FlagDomainID)
+	flags.BoolVar(&fields.SkipValidation, "skip-validation", false, msg.FlagSkipValidation)
+	flags.StringVar(&fields.Path, "file", "", msg.UpdateFlagFile)
+	flags.BoolP("help", "h", false, msg.UpdateHelpFlag)
+	cmdutil.MarkPrompted(cmd, "digital-certificate-id")
+	return cmd
+}
+
+func updateRequestFromFlags(ctx context.Context, cmd *cobra.Command, f *cmdutil.Factory, fields *Fields, request *api.UpdateRequest) error {
+	changedCertificate := cmd.Flags().Changed("certificate")
+	changedKey := cmd.Flags().Changed("private-key")
+	if changedCertificate != changedKey {
+		return msg.ErrorCertificateWithoutKey
+	}
+
+	if !changedCertificate && !cmd.Flags().Changed("name") {
+		return msg.ErrorNoUpdateFlags
+	}
+
+	if cmd.Flags().Changed("name") {
+		request.SetName(fields.Name)
+	}
+
+	if changedCertificate {
+		bundle, err := certificate.Load(fields.Certificate, fields.Chain, fields.PrivateKey)
+		if err != nil {
+			return err
+		}
+
+		if !fields.SkipValidation {
+			if err := certificate.Check(ctx, f, bundle, fields.DomainIDs); err != nil {
+				return err
+			}
+		}
+
+		request.SetCertificate(bundle.Certificate)
+		request.SetPrivateKey(bundle.PrivateKey)
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package digitalcertificate
+
+import (
+	"testing"
+
+	"github.com/aziontech/azion-cli/pkg/httpmock"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestUpdate(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+
+	t.Run("rename a certificate", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("PATCH", "digital_certificates/1337"),
+			httpmock.JSONFromFile("./fixtures/response.json"),
+		)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--digital-certificate-id", "1337", "--name", "renamed.example.com"})
+
+		err := cmd.Execute()
+		require.NoError(t, err)
+		assert.Equal(t, "🚀 Updated Digital Certificate with ID 1337\n\n", stdout.String())
+	})
+
+	t.Run("update with file", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("PATCH", "digital_certificates/1337"),
+			httpmock.JSONFromFile("./fixtures/response.json"),
+		)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--digital-certificate-id", "1337", "--file", "./fixtures/update.json"})
+
+		err := cmd.Execute()
+		require.NoError(t, err)
+		assert.Equal(t, "🚀 Updated Digital Certificate with ID 1337\n\n", stdout.String())
+	})
+
+	t.Run("certificate without private key", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+
+		f, _, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--digital-certificate-id", "1337", "--certificate", "cert.pem"})
+
+		err := cmd.Execute()
+		require.Error(t, err)
+	})
+
+	t.Run("nothing to update", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+
+		f, _, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--digital-certificate-id", "1337"})
+
+		err := cmd.Execute()
+		require.Error(t, err)
+	})
+}
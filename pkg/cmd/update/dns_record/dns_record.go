@@ -0,0 +1,191 @@
+package dnsrecord
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"go.uber.org/zap"
+
+	msg "github.com/aziontech/azion-cli/messages/dns_record"
+	api "github.com/aziontech/azion-cli/pkg/api/dns"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/utils"
+	sdk "github.com/aziontech/azionapi-go-sdk/idns"
+	"github.com/spf13/cobra"
+)
+
+type Fields struct {
+	ZoneID      int64
+	RecordID    int64
+	Entry       string
+	RecordType  string
+	Answers     []string
+	TTL         int32
+	Description string
+	Policy      string
+	Weight      int32
+	Path        string
+}
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	fields := &Fields{}
+
+	cmd := &cobra.Command{
+		Use:           msg.Usage,
+		Short:         msg.UpdateShortDescription,
+		Long:          msg.UpdateLongDescription,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Example: heredoc.Doc(`
+		$ azion update dns-record --zone-id 1337 --record-id 42 --answers 192.0.2.3
+		$ azion update dns-record --zone-id 1337 --record-id 42 --ttl 300 --description "main site"
+		$ azion update dns-record --zone-id 1337 --record-id 42 --file "update.json"
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+
+			if !cmd.Flags().Changed("zone-id") {
+				answer, err := utils.AskInput(msg.AskZoneID)
+				if err != nil {
+					return err
+				}
+
+				id, err := strconv.ParseInt(answer, 10, 64)
+				if err != nil {
+					logger.Debug("Error while converting answer to int64", zap.Error(err))
+					return msg.ErrorConvertZoneID
+				}
+				fields.ZoneID = id
+			}
+
+			if !cmd.Flags().Changed("record-id") {
+				answer, err := utils.AskInput(msg.AskRecordID)
+				if err != nil {
+					return err
+				}
+
+				id, err := strconv.ParseInt(answer, 10, 64)
+				if err != nil {
+					logger.Debug("Error while converting answer to int64", zap.Error(err))
+					return msg.ErrorConvertRecordID
+				}
+				fields.RecordID = id
+			}
+
+			request := sdk.RecordPostOrPut{}
+			if cmd.Flags().Changed("file") {
+				err := utils.FlagFileUnmarshalJSON(fields.Path, &request)
+				if err != nil {
+					logger.Debug("Error while parsing <"+fields.Path+"> file", zap.Error(err))
+					return utils.ErrorUnmarshalReader
+				}
+			} else if err := updateRequest(cmd, fields, &request); err != nil {
+				return err
+			}
+
+			client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
+
+			// the API replaces every attribute of the record, so the ones that don't change are sent as they are
+			current, err := client.GetRecord(ctx, fields.ZoneID, fields.RecordID)
+			if err != nil {
+				return fmt.Errorf(msg.ErrorGet.Error(), err)
+			}
+			if request.Entry == nil {
+				request.Entry = current.Entry
+			}
+			if request.RecordType == nil {
+				request.RecordType = current.RecordType
+			}
+			if request.AnswersList == nil {
+				request.AnswersList = current.AnswersList
+			}
+			if request.Ttl == nil {
+				request.Ttl = current.Ttl
+			}
+			if request.Description == nil {
+				request.Description = current.Description
+			}
+			if request.Policy == nil {
+				request.Policy = current.Policy
+			}
+			if request.Weight == nil {
+				request.Weight = current.Weight
+			}
+
+			response, err := client.UpdateRecord(ctx, fields.ZoneID, fields.RecordID, &request)
+			if err != nil {
+				return fmt.Errorf(msg.ErrorUpdate.Error(), err)
+			}
+
+			id := int64(response.GetId())
+			if id == 0 {
+				id = fields.RecordID
+			}
+			logger.LogSuccess(f.IOStreams.Out, fmt.Sprintf(msg.UpdateOutputSuccess, id))
+			return nil
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.Int64Var(&fields.ZoneID, "zone-id", 0, msg.FlagZoneID)
+	flags.Int64Var(&fields.RecordID, "record-id", 0, msg.FlagRecordID)
+	flags.StringVar(&fields.Entry, "entry", "", msg.FlagEntry)
+	flags.StringVar(&fields.RecordType, "record-type", "", msg.FlagRecordType)
+	flags.StringSliceVar(&fields.Answers, "answers", []string{}, msg.FlagAnswers)
+	flags.Int32Var(&fields.TTL, "ttl", 0, msg.FlagTTL)
+	flags.StringVar(&fields.Description, "description", "", msg.FlagDescription)
+	flags.StringVar(&fields.Policy, "policy", "", msg.FlagPolicy)
+	flags.Int32Var(&fields.Weight, "weight", 0, msg.FlagWeight)
+	flags.StringVar(&fields.Path, "file", "", msg.UpdateFlagFile)
+	flags.BoolP("help", "h", false, msg.UpdateHelpFlag)
+	cmdutil.MarkPrompted(cmd, "zone-id", "record-id")
+	return cmd
+}
+
+// updateRequest sets the attributes given in the flags, validating them
+func updateRequest(cmd *cobra.Command, fields *Fields, request *sdk.RecordPostOrPut) error {
+	changed := false
+	for _, flag := range []string{"entry", "record-type", "answers", "ttl", "description", "policy", "weight"} {
+		changed = changed || cmd.Flags().Changed(flag)
+	}
+	if !changed {
+		return msg.ErrorNoUpdateFlags
+	}
+
+	if cmd.Flags().Changed("entry") {
+		request.SetEntry(fields.Entry)
+	}
+	if cmd.Flags().Changed("record-type") {
+		recordType := strings.ToUpper(fields.RecordType)
+		if !api.ValidType(recordType) {
+			return fmt.Errorf(msg.ErrorRecordType.Error(), fields.RecordType)
+		}
+		request.SetRecordType(recordType)
+	}
+	if cmd.Flags().Changed("answers") {
+		if len(fields.Answers) == 0 {
+			return msg.ErrorMissingAnswers
+		}
+		request.SetAnswersList(fields.Answers)
+	}
+	if cmd.Flags().Changed("ttl") {
+		request.SetTtl(fields.TTL)
+	}
+	if cmd.Flags().Changed("description") {
+		request.SetDescription(fields.Description)
+	}
+	if cmd.Flags().Changed("policy") {
+		if fields.Policy != api.PolicySimple && fields.Policy != api.PolicyWeighted {
+			return fmt.Errorf(msg.ErrorPolicy.Error(), fields.Policy)
+		}
+		request.SetPolicy(fields.Policy)
+	}
+	if cmd.Flags().Changed("weight") {
+		request.SetWeight(fields.Weight)
+	}
+	return nil
+}
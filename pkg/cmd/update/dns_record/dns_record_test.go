@@ -0,0 +1,86 @@
+package dnsrecord
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	msg "github.com/aziontech/azion-cli/messages/dns_record"
+	"github.com/aziontech/azion-cli/pkg/httpmock"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestUpdate(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+
+	t.Run("update keeps the attributes that don't change", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("GET", "intelligent_dns/1337/records"),
+			httpmock.JSONFromFile("./fixtures/records.json"),
+		)
+		mock.Register(
+			httpmock.REST("PUT", "intelligent_dns/1337/records/42"),
+			func(req *http.Request) (*http.Response, error) {
+				payload := map[string]interface{}{}
+				require.NoError(t, json.NewDecoder(req.Body).Decode(&payload))
+				assert.Equal(t, "www", payload["entry"])
+				assert.Equal(t, "A", payload["record_type"])
+				assert.Equal(t, []interface{}{"192.0.2.3"}, payload["answers_list"])
+				assert.Equal(t, float64(300), payload["ttl"])
+				assert.Equal(t, "site", payload["description"])
+				return httpmock.JSONFromFile("./fixtures/record.json")(req)
+			},
+		)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--zone-id", "1337", "--record-id", "42", "--answers", "192.0.2.3"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		assert.Equal(t, "🚀 Updated Edge DNS Record with ID 42\n\n", stdout.String())
+		mock.Verify(t)
+	})
+
+	t.Run("record that is not in the zone", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("GET", "intelligent_dns/1337/records"),
+			httpmock.JSONFromFile("./fixtures/records.json"),
+		)
+
+		f, _, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--zone-id", "1337", "--record-id", "7", "--ttl", "60"})
+
+		_, err := cmd.ExecuteC()
+		require.Error(t, err)
+	})
+
+	t.Run("invalid policy", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+
+		f, _, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--zone-id", "1337", "--record-id", "42", "--policy", "latency"})
+
+		_, err := cmd.ExecuteC()
+		require.EqualError(t, err, `Invalid --policy flag provided: "latency". The policies are simple and weighted`)
+	})
+
+	t.Run("no values to update", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+
+		f, _, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--zone-id", "1337", "--record-id", "42"})
+
+		_, err := cmd.ExecuteC()
+		require.ErrorIs(t, err, msg.ErrorNoUpdateFlags)
+	})
+}
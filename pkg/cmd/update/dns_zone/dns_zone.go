@@ -0,0 +1,120 @@
+package dnszone
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc"
+	"go.uber.org/zap"
+
+	msg "github.com/aziontech/azion-cli/messages/dns_zone"
+	api "github.com/aziontech/azion-cli/pkg/api/dns"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/utils"
+	sdk "github.com/aziontech/azionapi-go-sdk/idns"
+	"github.com/spf13/cobra"
+)
+
+type Fields struct {
+	ID     int64
+	Name   string
+	Domain string
+	Active string
+	Path   string
+}
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	fields := &Fields{}
+
+	cmd := &cobra.Command{
+		Use:           msg.Usage,
+		Short:         msg.UpdateShortDescription,
+		Long:          msg.UpdateLongDescription,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Example: heredoc.Doc(`
+		$ azion update dns-zone --zone-id 1337 --name "renamed"
+		$ azion update dns-zone --zone-id 1337 --active false
+		$ azion update dns-zone --zone-id 1337 --file "update.json"
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+
+			if !cmd.Flags().Changed("zone-id") {
+				answer, err := utils.AskInput(msg.AskZoneID)
+				if err != nil {
+					return err
+				}
+
+				id, err := strconv.ParseInt(answer, 10, 64)
+				if err != nil {
+					logger.Debug("Error while converting answer to int64", zap.Error(err))
+					return msg.ErrorConvertID
+				}
+				fields.ID = id
+			}
+
+			request := sdk.Zone{}
+			if cmd.Flags().Changed("file") {
+				err := utils.FlagFileUnmarshalJSON(fields.Path, &request)
+				if err != nil {
+					logger.Debug("Error while parsing <"+fields.Path+"> file", zap.Error(err))
+					return utils.ErrorUnmarshalReader
+				}
+			} else if !cmd.Flags().Changed("name") && !cmd.Flags().Changed("domain") && !cmd.Flags().Changed("active") {
+				return msg.ErrorNoUpdateFlags
+			}
+
+			client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
+
+			// the API replaces every attribute of the zone, so the ones that don't change are sent as they are
+			current, err := client.GetZone(ctx, fields.ID)
+			if err != nil {
+				return fmt.Errorf(msg.ErrorGet.Error(), err)
+			}
+			if request.Name == nil {
+				request.Name = current.Name
+			}
+			if request.Domain == nil {
+				request.Domain = current.Domain
+			}
+			if request.IsActive == nil {
+				request.IsActive = current.IsActive
+			}
+
+			if cmd.Flags().Changed("name") {
+				request.SetName(fields.Name)
+			}
+			if cmd.Flags().Changed("domain") {
+				request.SetDomain(fields.Domain)
+			}
+			if cmd.Flags().Changed("active") {
+				active, err := strconv.ParseBool(fields.Active)
+				if err != nil {
+					return msg.ErrorActiveFlag
+				}
+				request.SetIsActive(active)
+			}
+
+			response, err := client.UpdateZone(ctx, fields.ID, &request)
+			if err != nil {
+				return fmt.Errorf(msg.ErrorUpdate.Error(), err)
+			}
+
+			logger.LogSuccess(f.IOStreams.Out, fmt.Sprintf(msg.UpdateOutputSuccess, response.GetId()))
+			return nil
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.Int64Var(&fields.ID, "zone-id", 0, msg.FlagZoneID)
+	flags.StringVar(&fields.Name, "name", "", msg.FlagName)
+	flags.StringVar(&fields.Domain, "domain", "", msg.FlagDomain)
+	flags.StringVar(&fields.Active, "active", "", msg.FlagActive)
+	flags.StringVar(&fields.Path, "file", "", msg.UpdateFlagFile)
+	flags.BoolP("help", "h", false, msg.UpdateHelpFlag)
+	cmdutil.MarkPrompted(cmd, "zone-id")
+	return cmd
+}
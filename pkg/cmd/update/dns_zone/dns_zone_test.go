@@ -0,0 +1,55 @@
+package dnszone
+
+import (
+	"os"
+	"testing"
+
+	msg "github.com/aziontech/azion-cli/messages/dns_zone"
+	"github.com/aziontech/azion-cli/pkg/httpmock"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestUpdate(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+
+	t.Run("update keeps the attributes that don't change", func(t *testing.T) {
+		response, _ := os.ReadFile("./fixtures/zone.json")
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("GET", "intelligent_dns/1337"),
+			httpmock.JSONFromFile("./fixtures/zone.json"),
+		)
+		mock.Register(
+			httpmock.REST("PUT", "intelligent_dns/1337"),
+			httpmock.RESTPayload(200, string(response), func(payload map[string]interface{}) {
+				assert.Equal(t, "example.com", payload["name"])
+				assert.Equal(t, "example.com", payload["domain"])
+				assert.Equal(t, false, payload["is_active"])
+			}),
+		)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--zone-id", "1337", "--active", "false"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		assert.Equal(t, "🚀 Updated Edge DNS Zone with ID 1337\n\n", stdout.String())
+		mock.Verify(t)
+	})
+
+	t.Run("no values to update", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+
+		f, _, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--zone-id", "1337"})
+
+		_, err := cmd.ExecuteC()
+		require.ErrorIs(t, err, msg.ErrorNoUpdateFlags)
+	})
+}
@@ -137,6 +137,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 	flags.StringVar(&fields.CnameAccessOnly, "cname-access-only", "", msg.FlagCnameAccessOnly)
 	flags.StringVar(&fields.InPath, "file", "", msg.FlagFile)
 	flags.BoolP("help", "h", false, msg.HelpFlag)
+	cmdutil.MarkPromptedUnless(cmd, "file", "domain-id")
 
 	return cmd
 }
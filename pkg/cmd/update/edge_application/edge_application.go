@@ -208,6 +208,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 	flags.StringVar(&fields.WebApplicationFirewall, "webapp-firewall", "", msg.WebApplicationFirewall)
 	flags.StringVar(&fields.InPath, "file", "", msg.FlagFile)
 	flags.BoolP("help", "h", false, msg.HelpFlag)
+	cmdutil.MarkPromptedUnless(cmd, "file", "application-id")
 	return cmd
 }
 
@@ -0,0 +1,176 @@
+package edgefirewall
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc"
+	"go.uber.org/zap"
+
+	msg "github.com/aziontech/azion-cli/messages/edge_firewall"
+	api "github.com/aziontech/azion-cli/pkg/api/edge_firewall"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/utils"
+	"github.com/spf13/cobra"
+)
+
+type Fields struct {
+	ID                       int64
+	Name                     string
+	Active                   string
+	EdgeFunctionsEnabled     string
+	NetworkProtectionEnabled string
+	WafEnabled               string
+	Domains                  []int64
+	AddDomains               []int64
+	RemoveDomains            []int64
+	Path                     string
+}
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	fields := &Fields{}
+
+	cmd := &cobra.Command{
+		Use:           msg.Usage,
+		Short:         msg.UpdateShortDescription,
+		Long:          msg.UpdateLongDescription,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Example: heredoc.Doc(`
+		$ azion update edge-firewall --edge-firewall-id 1337 --name "renamed"
+		$ azion update edge-firewall --edge-firewall-id 1337 --waf-enabled false
+		$ azion update edge-firewall --edge-firewall-id 1337 --add-domains 1678392838 --remove-domains 1678392839
+		$ azion update edge-firewall --edge-firewall-id 1337 --domains 1678392838,1678392840
+		$ azion update edge-firewall --edge-firewall-id 1337 --file "update.json"
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+			request := api.UpdateRequest{}
+
+			if !cmd.Flags().Changed("edge-firewall-id") {
+				answer, err := utils.AskInput(msg.AskEdgeFirewallID)
+				if err != nil {
+					return err
+				}
+
+				id, err := strconv.ParseInt(answer, 10, 64)
+				if err != nil {
+					logger.Debug("Error while converting answer to int64", zap.Error(err))
+					return msg.ErrorConvertID
+				}
+				fields.ID = id
+			}
+
+			client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
+
+			if cmd.Flags().Changed("file") {
+				err := utils.FlagFileUnmarshalJSON(fields.Path, &request)
+				if err != nil {
+					logger.Debug("Error while parsing <"+fields.Path+"> file", zap.Error(err))
+					return utils.ErrorUnmarshalReader
+				}
+			} else {
+				if err := updateRequestFromFlags(ctx, cmd, client, fields, &request); err != nil {
+					return err
+				}
+			}
+
+			request.Id = fields.ID
+			response, err := client.Update(ctx, &request)
+			if err != nil {
+				return fmt.Errorf(msg.ErrorUpdate.Error(), err)
+			}
+
+			logger.LogSuccess(f.IOStreams.Out, fmt.Sprintf(msg.UpdateOutputSuccess, response.GetId()))
+			return nil
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.Int64Var(&fields.ID, "edge-firewall-id", 0, msg.FlagEdgeFirewallID)
+	flags.StringVar(&fields.Name, "name", "", msg.FlagName)
+	flags.StringVar(&fields.Active, "active", "", msg.FlagActive)
+	flags.StringVar(&fields.EdgeFunctionsEnabled, "edge-functions-enabled", "", msg.FlagEdgeFunctionsEnabled)
+	flags.StringVar(&fields.NetworkProtectionEnabled, "network-protection-enabled", "", msg.FlagNetworkProtectionEnabled)
+	flags.StringVar(&fields.WafEnabled, "waf-enabled", "", msg.FlagWafEnabled)
+	flags.Int64SliceVar(&fields.Domains, "domains", []int64{}, msg.FlagDomains)
+	flags.Int64SliceVar(&fields.AddDomains, "add-domains", []int64{}, msg.UpdateFlagAddDomains)
+	flags.Int64SliceVar(&fields.RemoveDomains, "remove-domains", []int64{}, msg.UpdateFlagRemoveDomain)
+	flags.StringVar(&fields.Path, "file", "", msg.UpdateFlagFile)
+	flags.BoolP("help", "h", false, msg.UpdateHelpFlag)
+	cmdutil.MarkPrompted(cmd, "edge-firewall-id")
+	return cmd
+}
+
+func updateRequestFromFlags(ctx context.Context, cmd *cobra.Command, client *api.Client, fields *Fields, request *api.UpdateRequest) error {
+	changed := false
+
+	if cmd.Flags().Changed("name") {
+		request.SetName(fields.Name)
+		changed = true
+	}
+
+	bools := []struct {
+		flag  string
+		value string
+		set   func(bool)
+	}{
+		{"active", fields.Active, request.SetIsActive},
+		{"edge-functions-enabled", fields.EdgeFunctionsEnabled, request.SetEdgeFunctionsEnabled},
+		{"network-protection-enabled", fields.NetworkProtectionEnabled, request.SetNetworkProtectionEnabled},
+		{"waf-enabled", fields.WafEnabled, request.SetWafEnabled},
+	}
+	for _, b := range bools {
+		if !cmd.Flags().Changed(b.flag) {
+			continue
+		}
+		value, err := strconv.ParseBool(b.value)
+		if err != nil {
+			return fmt.Errorf(msg.ErrorBoolFlag.Error(), b.flag)
+		}
+		b.set(value)
+		changed = true
+	}
+
+	incremental := cmd.Flags().Changed("add-domains") || cmd.Flags().Changed("remove-domains")
+	switch {
+	case cmd.Flags().Changed("domains") && incremental:
+		return msg.ErrorDomainsFlags
+	case cmd.Flags().Changed("domains"):
+		request.SetDomains(fields.Domains)
+		changed = true
+	case incremental:
+		current, err := client.Get(ctx, fields.ID)
+		if err != nil {
+			return fmt.Errorf(msg.ErrorGet.Error(), err)
+		}
+		request.SetDomains(editDomains(current.GetDomains(), fields.AddDomains, fields.RemoveDomains))
+		changed = true
+	}
+
+	if !changed {
+		return msg.ErrorNoUpdateFlags
+	}
+	return nil
+}
+
+// editDomains adds and removes domains from the ones the Edge Firewall protects, keeping their order
+func editDomains(current, add, remove []int64) []int64 {
+	removed := make(map[int64]bool, len(remove))
+	for _, id := range remove {
+		removed[id] = true
+	}
+
+	seen := make(map[int64]bool, len(current)+len(add))
+	domains := []int64{}
+	for _, id := range append(append([]int64{}, current...), add...) {
+		if removed[id] || seen[id] {
+			continue
+		}
+		seen[id] = true
+		domains = append(domains, id)
+	}
+	return domains
+}
@@ -0,0 +1,105 @@
+package edgefirewall
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/aziontech/azion-cli/pkg/httpmock"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestUpdate(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+	t.Run("add and remove domains", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("GET", "edge_firewall/1337"),
+			httpmock.JSONFromFile("./fixtures/current.json"),
+		)
+		mock.Register(
+			httpmock.REST("PATCH", "edge_firewall/1337"),
+			func(req *http.Request) (*http.Response, error) {
+				payload := map[string]interface{}{}
+				require.NoError(t, json.NewDecoder(req.Body).Decode(&payload))
+				assert.Equal(t, []interface{}{float64(1678392838), float64(1678392840)}, payload["domains"])
+				return httpmock.JSONFromFile("./fixtures/response.json")(req)
+			},
+		)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--edge-firewall-id", "1337", "--add-domains", "1678392840,1678392838", "--remove-domains", "1678392839"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		assert.Equal(t, "🚀 Updated Edge Firewall with ID 1337\n\n", stdout.String())
+		mock.Verify(t)
+	})
+
+	t.Run("update flags without reading the current domains", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("PATCH", "edge_firewall/1337"),
+			func(req *http.Request) (*http.Response, error) {
+				payload := map[string]interface{}{}
+				require.NoError(t, json.NewDecoder(req.Body).Decode(&payload))
+				assert.Equal(t, map[string]interface{}{"name": "renamed", "waf_enabled": false}, payload)
+				return httpmock.JSONFromFile("./fixtures/response.json")(req)
+			},
+		)
+
+		f, _, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--edge-firewall-id", "1337", "--name", "renamed", "--waf-enabled", "false"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+	})
+
+	t.Run("domains with incremental flags", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+
+		f, _, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--edge-firewall-id", "1337", "--domains", "1", "--add-domains", "2"})
+
+		_, err := cmd.ExecuteC()
+		require.Error(t, err)
+	})
+
+	t.Run("no values to update", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+
+		f, _, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--edge-firewall-id", "1337"})
+
+		_, err := cmd.ExecuteC()
+		require.Error(t, err)
+	})
+}
+
+func TestEditDomains(t *testing.T) {
+	tests := []struct {
+		name    string
+		current []int64
+		add     []int64
+		remove  []int64
+		want    []int64
+	}{
+		{"add keeps order", []int64{3, 1}, []int64{2}, nil, []int64{3, 1, 2}},
+		{"add existing domain", []int64{1, 2}, []int64{2}, nil, []int64{1, 2}},
+		{"remove domain", []int64{1, 2, 3}, nil, []int64{2}, []int64{1, 3}},
+		{"remove everything", []int64{1}, nil, []int64{1}, []int64{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, editDomains(tt.current, tt.add, tt.remove))
+		})
+	}
+}
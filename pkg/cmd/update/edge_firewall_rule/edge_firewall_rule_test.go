@@ -0,0 +1,69 @@
+package edgefirewallrule
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/aziontech/azion-cli/pkg/httpmock"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestUpdate(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+	t.Run("update rule from file", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("PATCH", "edge_firewall/1337/rules_engine/42"),
+			func(req *http.Request) (*http.Response, error) {
+				payload := map[string]interface{}{}
+				require.NoError(t, json.NewDecoder(req.Body).Decode(&payload))
+				assert.Equal(t, map[string]interface{}{
+					"is_active": false,
+					"behaviors": []interface{}{map[string]interface{}{"name": "run_function", "argument": float64(8125)}},
+				}, payload)
+				return httpmock.JSONFromFile("./fixtures/response.json")(req)
+			},
+		)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--edge-firewall-id", "1337", "--rule-id", "42", "--file", "./fixtures/rule.json"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		assert.Equal(t, "🚀 Updated Edge Firewall rule with ID 42\n\n", stdout.String())
+		mock.Verify(t)
+	})
+
+	t.Run("update rule that is not found", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("PATCH", "edge_firewall/1337/rules_engine/42"),
+			httpmock.StatusStringResponse(404, "Not Found"),
+		)
+
+		f, _, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--edge-firewall-id", "1337", "--rule-id", "42", "--file", "./fixtures/rule.json"})
+
+		_, err := cmd.ExecuteC()
+		require.Error(t, err)
+	})
+
+	t.Run("file that doesn't exist", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+
+		f, _, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--edge-firewall-id", "1337", "--rule-id", "42", "--file", "./fixtures/missing.json"})
+
+		_, err := cmd.ExecuteC()
+		require.Error(t, err)
+		assert.Empty(t, mock.Requests)
+	})
+}
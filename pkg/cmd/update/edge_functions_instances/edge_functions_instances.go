@@ -1,4 +1,4 @@
-package update
+package edgefunctionsinstances
 
 import (
 	"context"
@@ -34,10 +34,10 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		SilenceUsage:  true,
 		SilenceErrors: true,
 		Example: heredoc.Doc(`
-        $ azion edge_functions_instances update -a 1674767911 -i 43121 -f 1209
-        $ azion edge_functions_instances update --application-id 1674767911 --instance-id 2121 --function-id 1212 --name updated
-        $ azion edge_functions_instances update  -a 1674767911 -i 43121 --in "update.json"
-        `),
+		$ azion update edge-function-instance -a 1674767911 -i 43121 -f 1209
+		$ azion update edge-function-instance --application-id 1674767911 --instance-id 2121 --function-id 1212 --name updated
+		$ azion update edge-function-instance  -a 1674767911 -i 43121 --in "update.json"
+		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if cmd.Flags().Changed("in") && (!cmd.Flags().Changed("application-id") || !cmd.Flags().Changed("instance-id")) {
 				return msg.ErrorMandatoryUpdateFlagsIn
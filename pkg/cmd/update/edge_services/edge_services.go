@@ -1,4 +1,4 @@
-package update
+package edgeservices
 
 import (
 	"bufio"
@@ -12,7 +12,7 @@ import (
 
 	"github.com/MakeNowJust/heredoc"
 	msg "github.com/aziontech/azion-cli/messages/edge_services"
-	"github.com/aziontech/azion-cli/pkg/cmd/edge_services/requests"
+	api "github.com/aziontech/azion-cli/pkg/api/edge_services"
 	"github.com/aziontech/azion-cli/pkg/cmdutil"
 	"github.com/aziontech/azion-cli/utils"
 	sdk "github.com/aziontech/azionapi-go-sdk/edgeservices"
@@ -42,8 +42,8 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		SilenceUsage:  true,
 		SilenceErrors: true,
 		Example: heredoc.Doc(`
-        $ azion edge_services update --service-id 1234 --name 'Hello'
-        `),
+		$ azion update edge-service --service-id 1234 --name 'Hello'
+		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// either id parameter or in path should be passed
 			if !cmd.Flags().Changed("service-id") && !cmd.Flags().Changed("in") {
@@ -132,10 +132,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 				}
 			}
 
-			client, err := requests.CreateClient(f)
-			if err != nil {
-				return err
-			}
+			client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
 
 			if err := updateService(client, f.IOStreams.Out, fields.Id, cmd, request); err != nil {
 				return err
@@ -144,7 +141,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 			return nil
 		},
 	}
-	updateCmd.Flags().Int64VarP(&fields.Id, "service-id", "s", 0, msg.EdgeServiceFlagId)
+	updateCmd.Flags().Int64Var(&fields.Id, "service-id", 0, msg.EdgeServiceFlagId)
 	updateCmd.Flags().StringVar(&fields.Name, "name", "", msg.EdgeServiceUpdateFlagName)
 	updateCmd.Flags().StringVar(&fields.Active, "active", "", msg.EdgeServiceUpdateFlagActive)
 	updateCmd.Flags().StringVar(&fields.Variables, "variables-file", "", msg.EdgeServiceUpdateFlagVariables)
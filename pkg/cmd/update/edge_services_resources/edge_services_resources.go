@@ -1,4 +1,4 @@
-package update
+package edgeservicesresources
 
 import (
 	"context"
@@ -9,7 +9,7 @@ import (
 
 	"github.com/MakeNowJust/heredoc"
 	msg "github.com/aziontech/azion-cli/messages/edge_services"
-	"github.com/aziontech/azion-cli/pkg/cmd/edge_services/requests"
+	api "github.com/aziontech/azion-cli/pkg/api/edge_services"
 	"github.com/aziontech/azion-cli/pkg/cmdutil"
 	"github.com/aziontech/azion-cli/utils"
 	sdk "github.com/aziontech/azionapi-go-sdk/edgeservices"
@@ -43,8 +43,8 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		SilenceUsage:  true,
 		SilenceErrors: true,
 		Example: heredoc.Doc(`
-		$ azion edge_services resources update --service-id 1234 --resource-id 69420 --name '/tmp/hello.txt'
-		$ azion edge_services resources update --service-id 1234 --resource-id 69420 --name "/tmp/my_script.sh" --content-type shellscript --content-file "./text.txt"
+		$ azion update edge-service-resource --service-id 1234 --resource-id 69420 --name '/tmp/hello.txt'
+		$ azion update edge-service-resource --service-id 1234 --resource-id 69420 --name "/tmp/my_script.sh" --content-type shellscript --content-file "./text.txt"
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
 
@@ -131,10 +131,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 				}
 			}
 
-			client, err := requests.CreateClient(f)
-			if err != nil {
-				return err
-			}
+			client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
 
 			if err := updateResource(client, f.IOStreams.Out, fields.ServiceId, fields.ResourceId, request); err != nil {
 				return err
@@ -144,7 +141,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		},
 	}
 
-	updateCmd.Flags().Int64VarP(&fields.ServiceId, "service-id", "s", 0, msg.EdgeServiceFlagId)
+	updateCmd.Flags().Int64Var(&fields.ServiceId, "service-id", 0, msg.EdgeServiceFlagId)
 	updateCmd.Flags().Int64VarP(&fields.ResourceId, "resource-id", "r", 0, msg.EdgeServiceResourceFlagId)
 	updateCmd.Flags().StringVar(&fields.Name, "name", "", msg.EdgeServiceResourceUpdateFlagName)
 	updateCmd.Flags().StringVar(&fields.Trigger, "trigger", "", msg.EdgeServiceResourceUpdateFlagTrigger)
@@ -1,4 +1,4 @@
-package update
+package edgeservicesresources
 
 import (
 	"bytes"
@@ -41,7 +41,7 @@ func TestUpdate(t *testing.T) {
 
 		cmd := NewCmd(f)
 
-		cmd.SetArgs([]string{"-s", "1234"})
+		cmd.SetArgs([]string{"--service-id", "1234"})
 		cmd.SetIn(&bytes.Buffer{})
 		cmd.SetOut(io.Discard)
 		cmd.SetErr(io.Discard)
@@ -62,7 +62,7 @@ func TestUpdate(t *testing.T) {
 
 		cmd := NewCmd(f)
 
-		cmd.SetArgs([]string{"-s", "1234", "-r", "666"})
+		cmd.SetArgs([]string{"--service-id", "1234", "-r", "666"})
 		cmd.SetIn(&bytes.Buffer{})
 		cmd.SetOut(io.Discard)
 		cmd.SetErr(io.Discard)
@@ -135,7 +135,7 @@ func TestUpdate(t *testing.T) {
 		_, _ = contentFile.Write([]byte("This content is made for testing purposes"))
 
 		cmd := NewCmd(f)
-		cmd.SetArgs([]string{"-s", "1234", "-r", "666", "--name", "BIRL", "--trigger", "Install", "--content-type", "shellscript", "--content-file", contentFile.Name()})
+		cmd.SetArgs([]string{"--service-id", "1234", "-r", "666", "--name", "BIRL", "--trigger", "Install", "--content-type", "shellscript", "--content-file", contentFile.Name()})
 		cmd.SetIn(&bytes.Buffer{})
 		cmd.SetOut(io.Discard)
 		cmd.SetErr(io.Discard)
@@ -0,0 +1,172 @@
+package networklist
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc"
+	"go.uber.org/zap"
+
+	msg "github.com/aziontech/azion-cli/messages/network_list"
+	api "github.com/aziontech/azion-cli/pkg/api/network_list"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/networklist"
+	"github.com/aziontech/azion-cli/utils"
+	"github.com/spf13/cobra"
+)
+
+type Fields struct {
+	ID          int64
+	Name        string
+	Items       []string
+	FromFile    string
+	Add         []string
+	Remove      []string
+	SkipInvalid bool
+	NoAggregate bool
+	Path        string
+}
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	fields := &Fields{}
+
+	cmd := &cobra.Command{
+		Use:           msg.Usage,
+		Short:         msg.UpdateShortDescription,
+		Long:          msg.UpdateLongDescription,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Example: heredoc.Doc(`
+		$ azion update network-list --network-list-id 1337 --from-file "./drop.txt"
+		$ azion update network-list --network-list-id 1337 --add 203.0.113.0/24 --remove 192.0.2.7
+		$ azion update network-list --network-list-id 1337 --name "renamed"
+		$ azion update network-list --network-list-id 1337 --file "update.json"
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+
+			if !cmd.Flags().Changed("network-list-id") {
+				answer, err := utils.AskInput(msg.AskNetworkListID)
+				if err != nil {
+					return err
+				}
+
+				id, err := strconv.ParseInt(answer, 10, 64)
+				if err != nil {
+					logger.Debug("Error while converting answer to int64", zap.Error(err))
+					return msg.ErrorConvertID
+				}
+				fields.ID = id
+			}
+
+			request := api.Request{}
+			if cmd.Flags().Changed("file") {
+				err := utils.FlagFileUnmarshalJSON(fields.Path, &request)
+				if err != nil {
+					logger.Debug("Error while parsing <"+fields.Path+"> file", zap.Error(err))
+					return utils.ErrorUnmarshalReader
+				}
+			} else if err := checkFlags(cmd); err != nil {
+				return err
+			}
+
+			client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
+
+			// the API replaces every attribute of the Network List, so the ones that don't change are sent as they are
+			current, err := client.Get(ctx, fields.ID)
+			if err != nil {
+				return fmt.Errorf(msg.ErrorGet.Error(), err)
+			}
+
+			if err := updateRequest(cmd, f, fields, current, &request); err != nil {
+				return err
+			}
+
+			response, err := client.Update(ctx, fields.ID, &request)
+			if err != nil {
+				return fmt.Errorf(msg.ErrorUpdate.Error(), err)
+			}
+
+			logger.LogSuccess(f.IOStreams.Out, fmt.Sprintf(msg.UpdateOutputSuccess, response.Id))
+			return nil
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.Int64Var(&fields.ID, "network-list-id", 0, msg.FlagNetworkListID)
+	flags.StringVar(&fields.Name, "name", "", msg.FlagName)
+	flags.StringSliceVar(&fields.Items, "items", []string{}, msg.FlagItems)
+	flags.StringVar(&fields.FromFile, "from-file", "", msg.FlagFromFile)
+	flags.StringSliceVar(&fields.Add, "add", []string{}, msg.UpdateFlagAdd)
+	flags.StringSliceVar(&fields.Remove, "remove", []string{}, msg.UpdateFlagRemove)
+	flags.BoolVar(&fields.SkipInvalid, "skip-invalid", false, msg.FlagSkipInvalid)
+	flags.BoolVar(&fields.NoAggregate, "no-aggregate", false, msg.FlagNoAggregate)
+	flags.StringVar(&fields.Path, "file", "", msg.UpdateFlagFile)
+	flags.BoolP("help", "h", false, msg.UpdateHelpFlag)
+	cmdutil.MarkPrompted(cmd, "network-list-id")
+	return cmd
+}
+
+func checkFlags(cmd *cobra.Command) error {
+	changed := cmd.Flags().Changed
+	replace := changed("items") || changed("from-file")
+	incremental := changed("add") || changed("remove")
+
+	if (changed("items") && changed("from-file")) || (replace && incremental) {
+		return msg.ErrorItemsFlags
+	}
+	if !replace && !incremental && !changed("name") {
+		return msg.ErrorNoUpdateFlags
+	}
+	return nil
+}
+
+// updateRequest completes the request with the current attributes of the Network List, and with its items
+// replaced, added or removed as the flags tell
+func updateRequest(cmd *cobra.Command, f *cmdutil.Factory, fields *Fields, current *api.NetworkList, request *api.Request) error {
+	if cmd.Flags().Changed("name") {
+		request.Name = &fields.Name
+	}
+	if request.Name == nil {
+		request.Name = &current.Name
+	}
+	if request.ListType == nil {
+		request.ListType = &current.ListType
+	}
+	listType := *request.ListType
+
+	var entries []networklist.Entry
+	switch {
+	case cmd.Flags().Changed("file") && request.ItemsValues != nil:
+		entries = networklist.FromValues(request.ItemsValues)
+	case cmd.Flags().Changed("from-file"):
+		read, err := networklist.ReadFile(fields.FromFile, f.IOStreams.In)
+		if err != nil {
+			return err
+		}
+		entries = read
+	case cmd.Flags().Changed("items"):
+		entries = networklist.FromValues(fields.Items)
+	case cmd.Flags().Changed("add") || cmd.Flags().Changed("remove"):
+		entries = append(networklist.FromValues(current.ItemsValues), networklist.FromValues(fields.Add)...)
+	default:
+		// only the name changes
+		request.ItemsValues = current.ItemsValues
+		return nil
+	}
+
+	result := networklist.Clean(listType, entries, !fields.NoAggregate)
+	if cmd.Flags().Changed("remove") {
+		items, invalid := networklist.Remove(listType, result.Items, networklist.FromValues(fields.Remove))
+		result.Items = items
+		result.Invalid = append(result.Invalid, invalid...)
+	}
+
+	if err := networklist.Report(f.IOStreams.Out, result, fields.SkipInvalid); err != nil {
+		return err
+	}
+	request.ItemsValues = result.Items
+	return nil
+}
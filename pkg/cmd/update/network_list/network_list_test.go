@@ -0,0 +1,88 @@
+package networklist
+
+import (
+	"os"
+	"testing"
+
+	"github.com/aziontech/azion-cli/pkg/httpmock"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestUpdate(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+	response, _ := os.ReadFile("./fixtures/response.json")
+
+	t.Run("add and remove items", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("GET", "network_lists/1337"),
+			httpmock.JSONFromFile("./fixtures/current.json"),
+		)
+		mock.Register(
+			httpmock.REST("PUT", "network_lists/1337"),
+			httpmock.RESTPayload(200, string(response), func(payload map[string]interface{}) {
+				assert.Equal(t, "blocklist", payload["name"])
+				assert.Equal(t, "ip_cidr", payload["list_type"])
+				assert.Equal(t, []interface{}{"192.0.2.0/25", "192.0.2.192/26", "203.0.113.0/24"}, payload["items_values"])
+			}),
+		)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--network-list-id", "1337", "--add", "203.0.113.0/24", "--remove", "198.51.100.7,192.0.2.128/26"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		assert.Contains(t, stdout.String(), "🚀 Updated Network List with ID 1337\n")
+		mock.Verify(t)
+	})
+
+	t.Run("rename keeps the items", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("GET", "network_lists/1337"),
+			httpmock.JSONFromFile("./fixtures/current.json"),
+		)
+		mock.Register(
+			httpmock.REST("PUT", "network_lists/1337"),
+			httpmock.RESTPayload(200, string(response), func(payload map[string]interface{}) {
+				assert.Equal(t, "renamed", payload["name"])
+				assert.Equal(t, []interface{}{"192.0.2.0/24", "198.51.100.7"}, payload["items_values"])
+			}),
+		)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--network-list-id", "1337", "--name", "renamed"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		assert.Equal(t, "🚀 Updated Network List with ID 1337\n\n", stdout.String())
+	})
+
+	t.Run("replace items with add", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+
+		f, _, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--network-list-id", "1337", "--items", "192.0.2.1", "--add", "192.0.2.2"})
+
+		_, err := cmd.ExecuteC()
+		require.Error(t, err)
+	})
+
+	t.Run("no values to update", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+
+		f, _, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--network-list-id", "1337"})
+
+		_, err := cmd.ExecuteC()
+		require.Error(t, err)
+	})
+}
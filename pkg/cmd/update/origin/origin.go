@@ -58,7 +58,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 				answers, err := utils.AskInput(msg.AskAppID)
 				if err != nil {
 					logger.Debug("Error while parsing answer", zap.Error(err))
-					return utils.ErrorParseResponse
+					return err
 				}
 
 				applicationID, err := strconv.Atoi(answers)
@@ -74,7 +74,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 				answers, err := utils.AskInput(msg.AskOriginKey)
 				if err != nil {
 					logger.Debug("Error while parsing answer", zap.Error(err))
-					return utils.ErrorParseResponse
+					return err
 				}
 
 				fields.OriginKey = answers
@@ -104,6 +104,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 
 	flags := cmd.Flags()
 	addFlags(flags, fields)
+	cmdutil.MarkPrompted(cmd, "application-id", "origin-key")
 	return cmd
 }
 
@@ -76,6 +76,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 	flags.StringVar(&fields.Phase, "phase", "", msg.RulesEnginePhase)
 	flags.StringVar(&fields.Path, "file", "", msg.FlagFile)
 	flags.BoolP("help", "h", false, msg.FlagHelp)
+	cmdutil.MarkPrompted(cmd, "application-id", "rule-id", "phase", "file")
 	return cmd
 }
 
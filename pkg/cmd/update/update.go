@@ -4,12 +4,25 @@ import (
 	"github.com/MakeNowJust/heredoc"
 	msg "github.com/aziontech/azion-cli/messages/update"
 	cacheSetting "github.com/aziontech/azion-cli/pkg/cmd/update/cache_setting"
+	dataStreaming "github.com/aziontech/azion-cli/pkg/cmd/update/data_streaming"
+	deviceGroups "github.com/aziontech/azion-cli/pkg/cmd/update/device_groups"
+	digitalCertificate "github.com/aziontech/azion-cli/pkg/cmd/update/digital_certificate"
+	dnsRecord "github.com/aziontech/azion-cli/pkg/cmd/update/dns_record"
+	dnsZone "github.com/aziontech/azion-cli/pkg/cmd/update/dns_zone"
 	domain "github.com/aziontech/azion-cli/pkg/cmd/update/domain"
 	edgeApplication "github.com/aziontech/azion-cli/pkg/cmd/update/edge_application"
+	edgeFirewall "github.com/aziontech/azion-cli/pkg/cmd/update/edge_firewall"
+	edgeFirewallRule "github.com/aziontech/azion-cli/pkg/cmd/update/edge_firewall_rule"
 	edgeFunction "github.com/aziontech/azion-cli/pkg/cmd/update/edge_function"
+	edgeFunctionsInstances "github.com/aziontech/azion-cli/pkg/cmd/update/edge_functions_instances"
+	edgeServices "github.com/aziontech/azion-cli/pkg/cmd/update/edge_services"
+	edgeServicesResources "github.com/aziontech/azion-cli/pkg/cmd/update/edge_services_resources"
+	networkList "github.com/aziontech/azion-cli/pkg/cmd/update/network_list"
 	origin "github.com/aziontech/azion-cli/pkg/cmd/update/origin"
 	rulesEngine "github.com/aziontech/azion-cli/pkg/cmd/update/rules_engine"
 	"github.com/aziontech/azion-cli/pkg/cmd/update/variables"
+	wafAllowedRule "github.com/aziontech/azion-cli/pkg/cmd/update/waf_allowed_rule"
+	wafRuleSet "github.com/aziontech/azion-cli/pkg/cmd/update/waf_rule_set"
 
 	"github.com/aziontech/azion-cli/pkg/cmdutil"
 	"github.com/spf13/cobra"
@@ -35,6 +48,19 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 	cmd.AddCommand(edgeFunction.NewCmd(f))
 	cmd.AddCommand(cacheSetting.NewCmd(f))
 	cmd.AddCommand(variables.NewCmd(f))
+	cmd.AddCommand(deviceGroups.NewCmd(f))
+	cmd.AddCommand(edgeFunctionsInstances.NewCmd(f))
+	cmd.AddCommand(edgeServices.NewCmd(f))
+	cmd.AddCommand(edgeServicesResources.NewCmd(f))
+	cmd.AddCommand(digitalCertificate.NewCmd(f))
+	cmd.AddCommand(edgeFirewall.NewCmd(f))
+	cmd.AddCommand(edgeFirewallRule.NewCmd(f))
+	cmd.AddCommand(wafRuleSet.NewCmd(f))
+	cmd.AddCommand(wafAllowedRule.NewCmd(f))
+	cmd.AddCommand(networkList.NewCmd(f))
+	cmd.AddCommand(dnsZone.NewCmd(f))
+	cmd.AddCommand(dnsRecord.NewCmd(f))
+	cmd.AddCommand(dataStreaming.NewCmd(f))
 
 	cmd.Flags().BoolP("help", "h", false, msg.FlagHelp)
 	return cmd
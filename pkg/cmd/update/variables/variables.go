@@ -76,6 +76,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 
 	flags := cmd.Flags()
 	addFlags(flags, fields)
+	cmdutil.MarkPromptedUnless(cmd, "file", "variable-id", "key", "value", "secret")
 
 	return cmd
 }
@@ -94,7 +95,7 @@ func createRequestFromFlags(cmd *cobra.Command, fields *Fields, request *api.Req
 		answers, err := utils.AskInput(msg.AskVariableID)
 		if err != nil {
 			logger.Debug("Error while parsing answer", zap.Error(err))
-			return utils.ErrorParseResponse
+			return err
 		}
 		fields.ID = answers
 	}
@@ -103,7 +104,7 @@ func createRequestFromFlags(cmd *cobra.Command, fields *Fields, request *api.Req
 		answers, err := utils.AskInput(msg.AskKey)
 		if err != nil {
 			logger.Debug("Error while parsing answer", zap.Error(err))
-			return utils.ErrorParseResponse
+			return err
 		}
 		fields.Key = answers
 	}
@@ -112,7 +113,7 @@ func createRequestFromFlags(cmd *cobra.Command, fields *Fields, request *api.Req
 		answers, err := utils.AskInput(msg.AskValue)
 		if err != nil {
 			logger.Debug("Error while parsing answer", zap.Error(err))
-			return utils.ErrorParseResponse
+			return err
 		}
 		fields.Value = answers
 	}
@@ -121,7 +122,7 @@ func createRequestFromFlags(cmd *cobra.Command, fields *Fields, request *api.Req
 		answers, err := utils.AskInput(msg.AskSecret)
 		if err != nil {
 			logger.Debug("Error while parsing answer", zap.Error(err))
-			return utils.ErrorParseResponse
+			return err
 		}
 		fields.Secret = answers
 	}
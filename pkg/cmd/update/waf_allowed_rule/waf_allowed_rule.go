@@ -0,0 +1,159 @@
+package wafallowedrule
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc"
+	"go.uber.org/zap"
+
+	msg "github.com/aziontech/azion-cli/messages/waf_allowed_rule"
+	api "github.com/aziontech/azion-cli/pkg/api/waf"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/utils"
+	"github.com/spf13/cobra"
+)
+
+type Fields struct {
+	WafRuleSetID  int64
+	AllowedRuleID int64
+	RuleID        int64
+	Name          string
+	Reason        string
+	URLPath       string
+	UseRegex      string
+	Active        string
+	Path          string
+}
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	fields := &Fields{}
+
+	cmd := &cobra.Command{
+		Use:           msg.Usage,
+		Aliases:       []string{"waf-exception"},
+		Short:         msg.UpdateShortDescription,
+		Long:          msg.UpdateLongDescription,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Example: heredoc.Doc(`
+		$ azion update waf-allowed-rule --waf-rule-set-id 1337 --allowed-rule-id 7 --active false
+		$ azion update waf-allowed-rule --waf-rule-set-id 1337 --allowed-rule-id 7 --path "/search/v2"
+		$ azion update waf-exception --waf-rule-set-id 1337 --allowed-rule-id 7 --file "allowed_rule.json"
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !cmd.Flags().Changed("waf-rule-set-id") {
+				answer, err := utils.AskInput(msg.AskWafRuleSetID)
+				if err != nil {
+					return err
+				}
+
+				id, err := strconv.ParseInt(answer, 10, 64)
+				if err != nil {
+					logger.Debug("Error while converting answer to int64", zap.Error(err))
+					return msg.ErrorConvertWafRuleSetID
+				}
+				fields.WafRuleSetID = id
+			}
+
+			if !cmd.Flags().Changed("allowed-rule-id") {
+				answer, err := utils.AskInput(msg.AskAllowedRuleID)
+				if err != nil {
+					return err
+				}
+
+				id, err := strconv.ParseInt(answer, 10, 64)
+				if err != nil {
+					logger.Debug("Error while converting answer to int64", zap.Error(err))
+					return msg.ErrorConvertAllowedRuleID
+				}
+				fields.AllowedRuleID = id
+			}
+
+			request := api.AllowedRuleRequest{}
+			if cmd.Flags().Changed("file") {
+				err := utils.FlagFileUnmarshalJSON(fields.Path, &request)
+				if err != nil {
+					logger.Debug("Error while parsing <"+fields.Path+"> file", zap.Error(err))
+					return utils.ErrorUnmarshalReader
+				}
+			} else {
+				if !changedAny(cmd, "rule-id", "name", "reason", "path", "use-regex", "active") {
+					return msg.ErrorNoUpdateFlags
+				}
+				if err := requestFromFlags(cmd, fields, &request); err != nil {
+					return err
+				}
+			}
+
+			client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
+			response, err := client.UpdateAllowedRule(context.Background(), fields.WafRuleSetID, fields.AllowedRuleID, &request)
+			if err != nil {
+				return fmt.Errorf(msg.ErrorUpdate.Error(), err)
+			}
+
+			logger.LogSuccess(f.IOStreams.Out, fmt.Sprintf(msg.UpdateOutputSuccess, response.Id))
+			return nil
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.Int64Var(&fields.WafRuleSetID, "waf-rule-set-id", 0, msg.FlagWafRuleSetID)
+	flags.Int64Var(&fields.AllowedRuleID, "allowed-rule-id", 0, msg.FlagAllowedRuleID)
+	flags.Int64Var(&fields.RuleID, "rule-id", 0, msg.FlagRuleID)
+	flags.StringVar(&fields.Name, "name", "", msg.FlagName)
+	flags.StringVar(&fields.Reason, "reason", "", msg.FlagReason)
+	flags.StringVar(&fields.URLPath, "path", "", msg.FlagPath)
+	flags.StringVar(&fields.UseRegex, "use-regex", "", msg.FlagUseRegex)
+	flags.StringVar(&fields.Active, "active", "", msg.FlagActive)
+	flags.StringVar(&fields.Path, "file", "", msg.UpdateFlagFile)
+	flags.BoolP("help", "h", false, msg.UpdateHelpFlag)
+	cmdutil.MarkPrompted(cmd, "waf-rule-set-id", "allowed-rule-id")
+	return cmd
+}
+
+func requestFromFlags(cmd *cobra.Command, fields *Fields, request *api.AllowedRuleRequest) error {
+	if cmd.Flags().Changed("rule-id") {
+		request.RuleId = &fields.RuleID
+	}
+	if cmd.Flags().Changed("name") {
+		request.Name = &fields.Name
+	}
+	if cmd.Flags().Changed("reason") {
+		request.Reason = &fields.Reason
+	}
+	if cmd.Flags().Changed("path") {
+		request.Path = &fields.URLPath
+	}
+
+	bools := []struct {
+		flag  string
+		value string
+		field **bool
+	}{
+		{"use-regex", fields.UseRegex, &request.UseRegex},
+		{"active", fields.Active, &request.IsActive},
+	}
+	for _, b := range bools {
+		if !cmd.Flags().Changed(b.flag) {
+			continue
+		}
+		value, err := strconv.ParseBool(b.value)
+		if err != nil {
+			return fmt.Errorf(msg.ErrorBoolFlag.Error(), b.flag)
+		}
+		*b.field = &value
+	}
+	return nil
+}
+
+func changedAny(cmd *cobra.Command, flags ...string) bool {
+	for _, flag := range flags {
+		if cmd.Flags().Changed(flag) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,80 @@
+package wafallowedrule
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	msg "github.com/aziontech/azion-cli/messages/waf_allowed_rule"
+	"github.com/aziontech/azion-cli/pkg/httpmock"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestUpdate(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+	t.Run("update only the given flags", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("PATCH", "waf/rulesets/1337/allowed_rules/7"),
+			func(req *http.Request) (*http.Response, error) {
+				payload := map[string]interface{}{}
+				require.NoError(t, json.NewDecoder(req.Body).Decode(&payload))
+				assert.Equal(t, map[string]interface{}{"path": "/search/v2", "is_active": false}, payload)
+				return httpmock.JSONFromFile("./fixtures/response.json")(req)
+			},
+		)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--waf-rule-set-id", "1337", "--allowed-rule-id", "7", "--path", "/search/v2", "--active", "false"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		assert.Equal(t, "🚀 Updated WAF allowed rule with ID 7\n\n", stdout.String())
+		mock.Verify(t)
+	})
+
+	t.Run("no attribute to update", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+
+		f, _, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--waf-rule-set-id", "1337", "--allowed-rule-id", "7"})
+
+		_, err := cmd.ExecuteC()
+		require.Equal(t, msg.ErrorNoUpdateFlags, err)
+		assert.Empty(t, mock.Requests)
+	})
+
+	t.Run("invalid active flag", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+
+		f, _, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--waf-rule-set-id", "1337", "--allowed-rule-id", "7", "--active", "maybe"})
+
+		_, err := cmd.ExecuteC()
+		require.EqualError(t, err, fmt.Sprintf(msg.ErrorBoolFlag.Error(), "active"))
+		assert.Empty(t, mock.Requests)
+	})
+
+	t.Run("update allowed rule that is not found", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("PATCH", "waf/rulesets/1337/allowed_rules/7"),
+			httpmock.StatusStringResponse(404, "Not Found"),
+		)
+
+		f, _, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--waf-rule-set-id", "1337", "--allowed-rule-id", "7", "--name", "renamed"})
+
+		_, err := cmd.ExecuteC()
+		require.Error(t, err)
+	})
+}
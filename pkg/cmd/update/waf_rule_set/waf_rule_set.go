@@ -0,0 +1,138 @@
+package wafruleset
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"go.uber.org/zap"
+
+	msg "github.com/aziontech/azion-cli/messages/waf_rule_set"
+	api "github.com/aziontech/azion-cli/pkg/api/waf"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/utils"
+	"github.com/spf13/cobra"
+)
+
+type Fields struct {
+	ID              int64
+	Name            string
+	Mode            string
+	Active          string
+	Threats         map[string]string
+	BypassAddresses []string
+	Path            string
+}
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	fields := &Fields{}
+
+	cmd := &cobra.Command{
+		Use:           msg.Usage,
+		Short:         msg.UpdateShortDescription,
+		Long:          msg.UpdateLongDescription,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Example: heredoc.Doc(`
+		$ azion update waf-rule-set --waf-rule-set-id 1337 --mode blocking
+		$ azion update waf-rule-set --waf-rule-set-id 1337 --threat cross_site_scripting=highest
+		$ azion update waf-rule-set --waf-rule-set-id 1337 --bypass-addresses 192.0.2.10
+		$ azion update waf-rule-set --waf-rule-set-id 1337 --file "update.json"
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			request := api.UpdateRequest{}
+
+			if !cmd.Flags().Changed("waf-rule-set-id") {
+				answer, err := utils.AskInput(msg.AskWafRuleSetID)
+				if err != nil {
+					return err
+				}
+
+				id, err := strconv.ParseInt(answer, 10, 64)
+				if err != nil {
+					logger.Debug("Error while converting answer to int64", zap.Error(err))
+					return msg.ErrorConvertID
+				}
+				fields.ID = id
+			}
+
+			if cmd.Flags().Changed("file") {
+				err := utils.FlagFileUnmarshalJSON(fields.Path, &request)
+				if err != nil {
+					logger.Debug("Error while parsing <"+fields.Path+"> file", zap.Error(err))
+					return utils.ErrorUnmarshalReader
+				}
+			} else {
+				if err := updateRequestFromFlags(cmd, fields, &request); err != nil {
+					return err
+				}
+			}
+
+			request.Id = fields.ID
+			client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
+			response, err := client.Update(context.Background(), &request)
+			if err != nil {
+				return fmt.Errorf(msg.ErrorUpdate.Error(), err)
+			}
+
+			logger.LogSuccess(f.IOStreams.Out, fmt.Sprintf(msg.UpdateOutputSuccess, response.GetId()))
+			return nil
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.Int64Var(&fields.ID, "waf-rule-set-id", 0, msg.FlagWafRuleSetID)
+	flags.StringVar(&fields.Name, "name", "", msg.FlagName)
+	flags.StringVar(&fields.Mode, "mode", "", msg.FlagMode)
+	flags.StringVar(&fields.Active, "active", "", msg.FlagActive)
+	flags.StringToStringVar(&fields.Threats, "threat", map[string]string{}, fmt.Sprintf(msg.FlagThreat, strings.Join(api.ThreatFamilies, ", ")))
+	flags.StringSliceVar(&fields.BypassAddresses, "bypass-addresses", []string{}, msg.FlagBypassAddresses)
+	flags.StringVar(&fields.Path, "file", "", msg.UpdateFlagFile)
+	flags.BoolP("help", "h", false, msg.UpdateHelpFlag)
+	cmdutil.MarkPrompted(cmd, "waf-rule-set-id")
+	return cmd
+}
+
+func updateRequestFromFlags(cmd *cobra.Command, fields *Fields, request *api.UpdateRequest) error {
+	changed := false
+
+	if cmd.Flags().Changed("name") {
+		request.SetName(fields.Name)
+		changed = true
+	}
+
+	if cmd.Flags().Changed("mode") {
+		request.SetMode(fields.Mode)
+		changed = true
+	}
+
+	if cmd.Flags().Changed("active") {
+		active, err := strconv.ParseBool(fields.Active)
+		if err != nil {
+			return msg.ErrorActiveFlag
+		}
+		request.SetActive(active)
+		changed = true
+	}
+
+	if cmd.Flags().Changed("threat") {
+		if err := api.ValidateThreats(fields.Threats); err != nil {
+			return err
+		}
+		request.Threats = fields.Threats
+		changed = true
+	}
+
+	if cmd.Flags().Changed("bypass-addresses") {
+		request.SetBypassAddresses(fields.BypassAddresses)
+		changed = true
+	}
+
+	if !changed {
+		return msg.ErrorNoUpdateFlags
+	}
+	return nil
+}
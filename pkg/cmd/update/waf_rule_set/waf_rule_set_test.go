@@ -0,0 +1,51 @@
+package wafruleset
+
+import (
+	"os"
+	"testing"
+
+	"github.com/aziontech/azion-cli/pkg/httpmock"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestUpdate(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+	response, _ := os.ReadFile("./fixtures/response.json")
+
+	t.Run("update only the given threats", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("PATCH", "waf/rulesets/1337"),
+			httpmock.WithHeader(httpmock.RESTPayload(200, string(response), func(payload map[string]interface{}) {
+				assert.Equal(t, map[string]interface{}{
+					"mode":                        "blocking",
+					"unwanted_access":             true,
+					"unwanted_access_sensitivity": "low",
+				}, payload)
+			}), "Content-Type", "application/json"),
+		)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--waf-rule-set-id", "1337", "--mode", "blocking", "--threat", "unwanted_access=low"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		assert.Equal(t, "🚀 Updated WAF Rule Set with ID 1337\n\n", stdout.String())
+	})
+
+	t.Run("no values to update", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+
+		f, _, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--waf-rule-set-id", "1337"})
+
+		_, err := cmd.ExecuteC()
+		require.Error(t, err)
+	})
+}
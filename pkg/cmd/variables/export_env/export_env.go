@@ -0,0 +1,79 @@
+package exportenv
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+
+	msg "github.com/aziontech/azion-cli/messages/variables"
+	api "github.com/aziontech/azion-cli/pkg/api/variables"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/dotenv"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/utils"
+)
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	var toPath string
+
+	cmd := &cobra.Command{
+		Use:           msg.ExportUsage,
+		Short:         msg.ExportShortDescription,
+		Long:          msg.ExportLongDescription,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Example: heredoc.Doc(`
+		$ azion variables export
+		$ azion variables export --to .env
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
+			remote, err := client.List(context.Background())
+			if err != nil {
+				return fmt.Errorf(msg.ErrorListVariables.Error(), err)
+			}
+
+			var vars []dotenv.Variable
+			var secrets []string
+			for _, variable := range remote {
+				if variable.GetSecret() {
+					secrets = append(secrets, variable.GetKey())
+					continue
+				}
+				vars = append(vars, dotenv.Variable{Key: variable.GetKey(), Value: variable.GetValue()})
+			}
+			sort.Slice(vars, func(i, j int) bool { return vars[i].Key < vars[j].Key })
+			if len(secrets) > 0 {
+				sort.Strings(secrets)
+				logger.LogWarning(f.IOStreams.Err, fmt.Sprintf(msg.ExportSkippedSecrets, len(secrets), strings.Join(secrets, ", ")))
+			}
+
+			var content bytes.Buffer
+			if err := dotenv.Write(&content, vars); err != nil {
+				return fmt.Errorf(msg.ErrorWriteEnvFile.Error(), err)
+			}
+
+			out := f.IOStreams.Out
+			if !cmd.Flags().Changed("to") {
+				_, err := out.Write(content.Bytes())
+				return err
+			}
+
+			if err := cmdutil.WriteDetailsToFile(content.Bytes(), toPath, out); err != nil {
+				return fmt.Errorf("%s: %w", utils.ErrorWriteFile, err)
+			}
+			fmt.Fprintf(out, msg.FileWritten, filepath.Clean(toPath))
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&toPath, "to", "", msg.ExportFlagTo)
+	cmd.Flags().BoolP("help", "h", false, msg.ExportHelpFlag)
+	return cmd
+}
@@ -0,0 +1,57 @@
+package exportenv
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/aziontech/azion-cli/pkg/httpmock"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/testutils"
+)
+
+func TestExport(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+
+	t.Run("export to the standard output", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("GET", "variables"),
+			httpmock.JSONFromFile("./fixtures/variables.json"),
+		)
+
+		f, stdout, stderr := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		assert.Equal(t, "GREETING=\"hello world\"\nREGION=sa-east\n", stdout.String())
+		assert.Contains(t, stderr.String(), "Skipping 1 secrets, whose values can't be exported: API_TOKEN")
+	})
+
+	t.Run("export to a file", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("GET", "variables"),
+			httpmock.JSONFromFile("./fixtures/variables.json"),
+		)
+
+		path := filepath.Join(t.TempDir(), ".env")
+		f, stdout, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--to", path})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		assert.Equal(t, "File successfully written to: "+path+"\n", stdout.String())
+
+		content, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, "GREETING=\"hello world\"\nREGION=sa-east\n", string(content))
+	})
+}
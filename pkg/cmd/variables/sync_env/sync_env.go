@@ -0,0 +1,243 @@
+package syncenv
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+
+	msg "github.com/aziontech/azion-cli/messages/variables"
+	api "github.com/aziontech/azion-cli/pkg/api/variables"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/dotenv"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/utils"
+)
+
+// secretPatterns are the keys created as secrets unless the --secret flag tells otherwise
+var secretPatterns = []string{"*SECRET*", "*TOKEN*", "*PASSWORD*", "*_KEY"}
+
+type action int
+
+const (
+	create action = iota
+	update
+	remove
+)
+
+// change is what the sync does to a variable
+type change struct {
+	action action
+	key    string
+	value  string
+	secret bool
+	uuid   string
+}
+
+type Fields struct {
+	From    string
+	Delete  bool
+	DryRun  bool
+	Secrets []string
+}
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	fields := &Fields{}
+
+	cmd := &cobra.Command{
+		Use:           msg.SyncUsage,
+		Short:         msg.SyncShortDescription,
+		Long:          msg.SyncLongDescription,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Example: heredoc.Doc(`
+		$ azion variables sync --from .env
+		$ azion variables sync --from .env.production --delete --dry-run
+		$ azion variables sync --from .env --secret "*_TOKEN,STRIPE_*"
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !cmd.Flags().Changed("from") {
+				answer, err := utils.AskInput(msg.AskFrom)
+				if err != nil {
+					return err
+				}
+				fields.From = answer
+			}
+
+			if strings.TrimSpace(fields.From) == "" {
+				return msg.ErrorSyncEmptyFrom
+			}
+
+			for _, pattern := range fields.Secrets {
+				if _, err := path.Match(pattern, ""); err != nil {
+					return fmt.Errorf(msg.ErrorSecretPattern.Error(), pattern)
+				}
+			}
+
+			lines, err := utils.LoadEnvVarsFromFile(fields.From)
+			if err != nil {
+				return fmt.Errorf(msg.ErrorReadEnvFile.Error(), fields.From, err)
+			}
+			local, err := dotenv.Parse(lines)
+			if err != nil {
+				return fmt.Errorf(msg.ErrorReadEnvFile.Error(), fields.From, err)
+			}
+
+			// an empty file would ask to delete everything, which is rather a mistake
+			if fields.Delete && len(local) == 0 {
+				return fmt.Errorf(msg.ErrorSyncDeleteEmpty.Error(), fields.From)
+			}
+
+			ctx := context.Background()
+			client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
+			remote, err := client.List(ctx)
+			if err != nil {
+				return fmt.Errorf(msg.ErrorListVariables.Error(), err)
+			}
+
+			changes, unchanged, kept := diff(local, remote, fields)
+
+			out := f.IOStreams.Out
+			if fields.DryRun {
+				for _, c := range changes {
+					printChange(out, c)
+				}
+				created, updated, deleted := count(changes)
+				logger.FInfo(out, fmt.Sprintf(msg.SyncDryRun, created, updated, deleted, unchanged))
+				return nil
+			}
+
+			var done []change
+			failed := 0
+			for _, c := range changes {
+				if err := apply(ctx, client, c); err != nil {
+					failed++
+					logger.FInfo(out, fmt.Sprintf(msg.SyncFailed, c.key, err))
+					continue
+				}
+				done = append(done, c)
+				printChange(out, c)
+			}
+
+			if kept > 0 {
+				logger.LogWarning(out, fmt.Sprintf(msg.SyncKept, kept))
+			}
+			if failed > 0 {
+				return fmt.Errorf(msg.ErrorSync.Error(), failed, len(changes))
+			}
+			created, updated, deleted := count(done)
+			logger.LogSuccess(out, fmt.Sprintf(msg.SyncOutputSuccess, created, updated, deleted, unchanged))
+			return nil
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&fields.From, "from", "", msg.SyncFlagFrom)
+	flags.BoolVar(&fields.Delete, "delete", false, msg.SyncFlagDelete)
+	flags.BoolVar(&fields.DryRun, "dry-run", false, msg.SyncFlagDryRun)
+	flags.StringSliceVar(&fields.Secrets, "secret", secretPatterns, msg.SyncFlagSecret)
+	flags.BoolP("help", "h", false, msg.SyncHelpFlag)
+	cmdutil.MarkPrompted(cmd, "from")
+	return cmd
+}
+
+// diff returns the changes that make the account's variables match the file, in the order of the
+// file, along with how many variables are up to date and how many are kept for not being in it.
+// The API doesn't return the values of secrets, so secrets in the file are always updated
+func diff(local []dotenv.Variable, remote []api.Response, fields *Fields) ([]change, int, int) {
+	byKey := make(map[string]api.Response, len(remote))
+	for _, variable := range remote {
+		byKey[variable.GetKey()] = variable
+	}
+
+	var changes []change
+	unchanged := 0
+	inFile := make(map[string]bool, len(local))
+	for _, variable := range local {
+		inFile[variable.Key] = true
+		c := change{key: variable.Key, value: variable.Value, secret: isSecret(variable.Key, fields.Secrets)}
+
+		existing, ok := byKey[variable.Key]
+		switch {
+		case !ok:
+			c.action = create
+		case existing.GetSecret() || existing.GetSecret() != c.secret || existing.GetValue() != c.value:
+			c.action = update
+			c.uuid = existing.GetUuid()
+		default:
+			unchanged++
+			continue
+		}
+		changes = append(changes, c)
+	}
+
+	kept := 0
+	for _, variable := range remote {
+		if inFile[variable.GetKey()] {
+			continue
+		}
+		if !fields.Delete {
+			kept++
+			continue
+		}
+		changes = append(changes, change{action: remove, key: variable.GetKey(), uuid: variable.GetUuid()})
+	}
+	return changes, unchanged, kept
+}
+
+// isSecret tells whether the key matches any of the secret patterns, ignoring case
+func isSecret(key string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if matched, _ := path.Match(strings.ToUpper(pattern), strings.ToUpper(key)); matched {
+			return true
+		}
+	}
+	return false
+}
+
+func apply(ctx context.Context, client *api.Client, c change) error {
+	if c.action == remove {
+		return client.Delete(ctx, c.uuid)
+	}
+
+	request := api.Request{Uuid: c.uuid}
+	request.SetKey(c.key)
+	request.SetValue(c.value)
+	request.SetSecret(c.secret)
+	if c.action == create {
+		_, err := client.Create(ctx, request)
+		return err
+	}
+	_, err := client.Update(ctx, &request)
+	return err
+}
+
+// printChange displays the change without the variable's value, which may be a secret
+func printChange(out io.Writer, c change) {
+	format := map[action]string{create: msg.SyncCreate, update: msg.SyncUpdate, remove: msg.SyncDelete}[c.action]
+	if c.secret && c.action == create {
+		format = msg.SyncCreateSecret
+	}
+	if c.secret && c.action == update {
+		format = msg.SyncUpdateSecret
+	}
+	logger.FInfo(out, fmt.Sprintf(format, c.key))
+}
+
+func count(changes []change) (created, updated, deleted int) {
+	for _, c := range changes {
+		switch c.action {
+		case create:
+			created++
+		case update:
+			updated++
+		case remove:
+			deleted++
+		}
+	}
+	return created, updated, deleted
+}
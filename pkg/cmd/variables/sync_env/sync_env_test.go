@@ -0,0 +1,181 @@
+package syncenv
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/aziontech/azion-cli/pkg/httpmock"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/testutils"
+)
+
+// payload checks the request's payload before responding with the fixture
+func payload(t *testing.T, fixture string, check func(payload map[string]interface{})) httpmock.Responder {
+	return func(req *http.Request) (*http.Response, error) {
+		body := map[string]interface{}{}
+		require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+		check(body)
+		return httpmock.JSONFromFile(fixture)(req)
+	}
+}
+
+func TestSync(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+
+	t.Run("dry run", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("GET", "variables"),
+			httpmock.JSONFromFile("./fixtures/variables.json"),
+		)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--from", "./fixtures/production.env", "--delete", "--dry-run"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		assert.Equal(t, "~ REGION\n+ API_TOKEN (secret)\n- LEGACY_FLAG\n1 variables would be created, 1 updated and 1 deleted. 1 are up to date\n", stdout.String())
+		assert.NotContains(t, stdout.String(), "s3cr3t")
+		mock.Verify(t)
+	})
+
+	t.Run("sync the file", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("GET", "variables"),
+			httpmock.JSONFromFile("./fixtures/variables.json"),
+		)
+		mock.Register(
+			httpmock.REST("PUT", "variables/32e8ffca-4021-49a4-971f-330935566af4"),
+			payload(t, "./fixtures/variable.json", func(payload map[string]interface{}) {
+				assert.Equal(t, "REGION", payload["key"])
+				assert.Equal(t, "sa-east", payload["value"])
+				assert.Equal(t, false, payload["secret"])
+			}),
+		)
+		mock.Register(
+			httpmock.REST("POST", "variables"),
+			payload(t, "./fixtures/variable.json", func(payload map[string]interface{}) {
+				assert.Equal(t, "API_TOKEN", payload["key"])
+				assert.Equal(t, "s3cr3t value", payload["value"])
+				assert.Equal(t, true, payload["secret"])
+			}),
+		)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--from", "./fixtures/production.env"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		assert.Contains(t, stdout.String(), "1 variables of your account aren't in the file and were kept")
+		assert.Contains(t, stdout.String(), "Synced variables: 1 created, 1 updated and 0 deleted. 1 were up to date")
+		mock.Verify(t)
+	})
+
+	t.Run("delete the variables missing from the file", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("GET", "variables"),
+			httpmock.JSONFromFile("./fixtures/variables.json"),
+		)
+		mock.Register(
+			httpmock.REST("PUT", "variables/32e8ffca-4021-49a4-971f-330935566af4"),
+			httpmock.JSONFromFile("./fixtures/variable.json"),
+		)
+		mock.Register(
+			httpmock.REST("POST", "variables"),
+			payload(t, "./fixtures/variable.json", func(payload map[string]interface{}) {
+				assert.Equal(t, false, payload["secret"])
+			}),
+		)
+		mock.Register(
+			httpmock.REST("DELETE", "variables/7d0e6a2c-91b4-4c3e-8f5a-2b6d9c1e4f70"),
+			httpmock.StatusStringResponse(http.StatusNoContent, ""),
+		)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--from", "./fixtures/production.env", "--delete", "--secret", "*_PASSWORD"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		assert.Contains(t, stdout.String(), "Synced variables: 1 created, 1 updated and 1 deleted. 1 were up to date")
+		mock.Verify(t)
+	})
+
+	t.Run("failed changes", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("GET", "variables"),
+			httpmock.JSONFromFile("./fixtures/variables.json"),
+		)
+		mock.Register(
+			httpmock.REST("PUT", "variables/32e8ffca-4021-49a4-971f-330935566af4"),
+			httpmock.StatusStringResponse(http.StatusBadRequest, `{"value": "invalid"}`),
+		)
+		mock.Register(
+			httpmock.REST("POST", "variables"),
+			httpmock.JSONFromFile("./fixtures/variable.json"),
+		)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--from", "./fixtures/production.env"})
+
+		_, err := cmd.ExecuteC()
+		require.EqualError(t, err, "1 of 2 changes failed. Fix them and run the sync again; the variables that were synced are up to date")
+		assert.Contains(t, stdout.String(), "REGION failed")
+		assert.Contains(t, stdout.String(), "+ API_TOKEN (secret)")
+	})
+
+	t.Run("invalid file", func(t *testing.T) {
+		f, _, _ := testutils.NewFactory(&httpmock.Registry{})
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--from", "./fixtures/invalid.env"})
+
+		_, err := cmd.ExecuteC()
+		require.EqualError(t, err, `Failed to read the .env file ./fixtures/invalid.env: line 1: expected KEY=value, found "REGION". Check the file and try again`)
+	})
+
+	t.Run("empty path", func(t *testing.T) {
+		f, _, _ := testutils.NewFactory(&httpmock.Registry{})
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--from", "", "--delete"})
+
+		_, err := cmd.ExecuteC()
+		require.EqualError(t, err, "The path given to --from is empty. Provide the path of the .env file and try again")
+	})
+
+	t.Run("delete with a file without variables", func(t *testing.T) {
+		f, _, _ := testutils.NewFactory(&httpmock.Registry{})
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--from", "./fixtures/empty.env", "--delete"})
+
+		_, err := cmd.ExecuteC()
+		require.EqualError(t, err, "The .env file ./fixtures/empty.env has no variables, so --delete would remove all the variables of the account. Check the file and try again")
+	})
+
+	t.Run("invalid secret pattern", func(t *testing.T) {
+		f, _, _ := testutils.NewFactory(&httpmock.Registry{})
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--from", "./fixtures/production.env", "--secret", "[TOKEN"})
+
+		_, err := cmd.ExecuteC()
+		require.EqualError(t, err, "Invalid secret pattern provided: [TOKEN. Check the pattern and try again")
+	})
+}
+
+func TestIsSecret(t *testing.T) {
+	assert.True(t, isSecret("API_TOKEN", secretPatterns))
+	assert.True(t, isSecret("db_password", secretPatterns))
+	assert.True(t, isSecret("STRIPE_KEY", secretPatterns))
+	assert.False(t, isSecret("KEYBOARD_LAYOUT", secretPatterns))
+	assert.False(t, isSecret("REGION", secretPatterns))
+}
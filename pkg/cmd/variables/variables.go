@@ -0,0 +1,30 @@
+package variables
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	msg "github.com/aziontech/azion-cli/messages/variables"
+	exportenv "github.com/aziontech/azion-cli/pkg/cmd/variables/export_env"
+	syncenv "github.com/aziontech/azion-cli/pkg/cmd/variables/sync_env"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   msg.Usage,
+		Short: msg.ShortDescription,
+		Long:  msg.LongDescription,
+		Example: heredoc.Doc(`
+		$ azion variables sync --from .env
+		$ azion variables export --to .env
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+	}
+
+	cmd.AddCommand(syncenv.NewCmd(f))
+	cmd.AddCommand(exportenv.NewCmd(f))
+	cmd.Flags().BoolP("help", "h", false, msg.FlagHelp)
+	return cmd
+}
@@ -1,21 +1,30 @@
 package whoami
 
 import (
+	"context"
+	"fmt"
+	"time"
+
 	"github.com/MakeNowJust/heredoc"
 	msg "github.com/aziontech/azion-cli/messages/whoami"
+	api "github.com/aziontech/azion-cli/pkg/api/personal_token"
 	"github.com/aziontech/azion-cli/pkg/cmdutil"
 	"github.com/aziontech/azion-cli/pkg/logger"
 	"github.com/aziontech/azion-cli/pkg/token"
 	"github.com/spf13/cobra"
+	"go.uber.org/zap"
 )
 
 func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	var expiry bool
 	whoamiCmd := &cobra.Command{
 		Use:   msg.Usage,
 		Short: msg.ShortDescription,
 		Long:  msg.LongDescription,
 		Example: heredoc.Doc(`
 		$ azion whoami
+		$ azion whoami --profile staging
+		$ azion whoami --expiry
         `),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			settings, err := token.ReadSettings()
@@ -28,6 +37,25 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 			}
 
 			logger.FInfo(f.IOStreams.Out, settings.Email+"\n")
+			logger.FInfo(f.IOStreams.Out, fmt.Sprintf(msg.ProfileOutput, settings.ActiveProfile()))
+
+			if expiry {
+				// listing the tokens of the account is slow, so it's only done when asked for
+				if settings.ExpiresAt.IsZero() && settings.UUID != "" {
+					settings.ExpiresAt = lookUpExpiry(f, settings.UUID)
+					if !settings.ExpiresAt.IsZero() {
+						if err := token.WriteSettings(settings); err != nil {
+							logger.Debug("Error while saving the token's expiration", zap.Error(err))
+						}
+					}
+				}
+				if settings.ExpiresAt.IsZero() {
+					logger.FInfo(f.IOStreams.Out, msg.ExpiryUnknown)
+				} else {
+					logger.FInfo(f.IOStreams.Out, fmt.Sprintf(msg.ExpiryOutput, settings.ExpiresAt.Local().Format(time.DateOnly)))
+				}
+			}
+			token.WarnExpiry(f.IOStreams.Err, settings, time.Now())
 			return nil
 		},
 	}
@@ -36,7 +64,20 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 	whoamiCmd.SetOut(f.IOStreams.Out)
 	whoamiCmd.SetErr(f.IOStreams.Err)
 
+	whoamiCmd.Flags().BoolVar(&expiry, "expiry", false, msg.FlagExpiry)
 	whoamiCmd.Flags().BoolP("help", "h", false, msg.HelpFlag)
 
 	return whoamiCmd
 }
+
+// lookUpExpiry finds when the saved token expires, for settings written before the CLI kept it.
+// Failing to find it isn't an error, since whoami works offline
+func lookUpExpiry(f *cmdutil.Factory, uuid string) time.Time {
+	client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
+	personalToken, err := client.Find(context.Background(), uuid)
+	if err != nil || personalToken == nil {
+		logger.Debug("Could not find the token's expiration", zap.Error(err))
+		return time.Time{}
+	}
+	return personalToken.GetExpiresAt()
+}
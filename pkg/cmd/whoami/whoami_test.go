@@ -0,0 +1,85 @@
+package whoami
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/aziontech/azion-cli/pkg/config"
+	"github.com/aziontech/azion-cli/pkg/httpmock"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/testutils"
+	"github.com/aziontech/azion-cli/pkg/token"
+)
+
+const tokens = `{"count": 1, "results": [
+	{"uuid": "5b8934cf-3561-4b48-aceb-7ba52a227b6c", "name": "laptop", "expires_at": "2099-07-26T00:00:00Z"}
+]}`
+
+// useSettings saves a logged in profile, without the token's expiration, in a temporary directory
+func useSettings(t *testing.T) {
+	require.NoError(t, config.SetPath(filepath.Join(t.TempDir(), "settings.toml")))
+	t.Cleanup(func() {
+		_ = config.SetPath(filepath.Join(config.DEFAULT_DIR, config.DEFAULT_SETTINGS))
+	})
+	require.NoError(t, token.WriteSettings(token.Settings{
+		Token: "token",
+		UUID:  "5b8934cf-3561-4b48-aceb-7ba52a227b6c",
+		Email: "dev@example.com",
+	}))
+}
+
+func TestWhoami(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+
+	t.Run("without looking up the expiration", func(t *testing.T) {
+		useSettings(t)
+		mock := &httpmock.Registry{}
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{})
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+
+		assert.Equal(t, "dev@example.com\nProfile: default\n", stdout.String())
+		assert.Empty(t, mock.Requests)
+	})
+
+	t.Run("look up and save the expiration", func(t *testing.T) {
+		useSettings(t)
+		mock := &httpmock.Registry{}
+		mock.Register(httpmock.REST("GET", "iam/personal_tokens"), httpmock.JSONFromString(tokens))
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--expiry"})
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		mock.Verify(t)
+
+		assert.Contains(t, stdout.String(), "Expires: 2099-07-2")
+		settings, err := token.ReadSettings()
+		require.NoError(t, err)
+		assert.True(t, settings.ExpiresAt.Equal(time.Date(2099, 7, 26, 0, 0, 0, 0, time.UTC)))
+	})
+
+	t.Run("unknown expiration", func(t *testing.T) {
+		useSettings(t)
+		mock := &httpmock.Registry{}
+		mock.Register(httpmock.REST("GET", "iam/personal_tokens"), httpmock.JSONFromString(`{"count": 0, "results": []}`))
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--expiry"})
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		mock.Verify(t)
+
+		assert.Contains(t, stdout.String(), "Expires: unknown\n")
+	})
+}
@@ -3,6 +3,7 @@ package cmdutil
 import (
 	msg "github.com/aziontech/azion-cli/messages/general"
 	"github.com/aziontech/azion-cli/pkg/contracts"
+	"github.com/aziontech/azion-cli/pkg/printer"
 	"github.com/spf13/cobra"
 )
 
@@ -12,5 +13,33 @@ func AddAzionApiFlags(cmd *cobra.Command, opts *contracts.ListOptions) {
 	cmd.Flags().StringVar(&opts.Sort, "sort", "", msg.ApiListFlagSort)
 	cmd.Flags().Int64Var(&opts.Page, "page", 1, msg.ApiListFlagPage)
 	cmd.Flags().Int64Var(&opts.PageSize, "page-size", 10, msg.ApiListFlagPageSize)
-	cmd.Flags().StringVar(&opts.Filter, "filter", "", msg.ApiListFlagFilter)
+}
+
+// AddFormatFlag registers the global --format flag. The root command registers it once for every
+// command; tests of a single command register it on that command
+func AddFormatFlag(cmd *cobra.Command, f *Factory) {
+	cmd.PersistentFlags().StringVar(&f.Format, "format", "", msg.FlagFormat)
+}
+
+// AddOutputFlags registers the output flags shared by every list and describe command, which
+// print their results in the format given to the global --format flag
+func AddOutputFlags(f *Factory, cmd *cobra.Command, opts *printer.Options) {
+	preRunE := cmd.PreRunE
+	cmd.PreRunE = func(cmd *cobra.Command, args []string) error {
+		opts.Format = f.Format
+		if preRunE != nil {
+			return preRunE(cmd, args)
+		}
+		return nil
+	}
+
+	cmd.Flags().StringSliceVar(&opts.Columns, "columns", []string{}, msg.FlagColumns)
+	cmd.Flags().BoolVar(&opts.NoHeaders, "no-headers", false, msg.FlagNoHeaders)
+	cmd.Flags().StringVar(&opts.Template, "template", "", msg.FlagTemplate)
+	cmd.Flags().StringVar(&opts.Query, "query", "", msg.FlagQuery)
+}
+
+// AddFilterFlags registers the client-side filters of the list commands
+func AddFilterFlags(cmd *cobra.Command, opts *printer.Options) {
+	cmd.Flags().StringArrayVar(&opts.Filters, "filter", []string{}, msg.FlagFilter)
 }
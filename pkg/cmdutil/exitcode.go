@@ -0,0 +1,25 @@
+package cmdutil
+
+// Exit codes of the CLI. They are stable, so that scripts can tell the failures worth retrying
+// from the ones that need their input fixed
+const (
+	ExitOK = 0
+	// ExitError is any failure without a code of its own
+	ExitError = 1
+	// ExitValidation is an invalid or missing flag, argument or value. Fix it and run the command again
+	ExitValidation = 2
+	// ExitAuth is a missing, invalid or expired token, or one without permission for the resource
+	ExitAuth = 3
+	// ExitNotFound is a resource that doesn't exist
+	ExitNotFound = 4
+	// ExitConflict is a name already in use, or a change that conflicts with the state of the resource
+	ExitConflict = 5
+	// ExitRateLimited is a request refused for exceeding the rate limit. Retry later
+	ExitRateLimited = 6
+	// ExitNetwork is the API not being reached or not answering in time. Retry later
+	ExitNetwork = 7
+	// ExitServer is an internal failure of the API. Retry later
+	ExitServer = 8
+	// ExitAborted is the user interrupting the command
+	ExitAborted = 130
+)
@@ -2,9 +2,13 @@ package cmdutil
 
 import (
 	"encoding/json"
+	"fmt"
 	"io"
 	"os"
 	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v2"
 )
 
 func WriteDetailsToFile(data []byte, outPath string, writer io.Writer) error {
@@ -33,3 +37,41 @@ func UnmarshallJsonFromReader(file io.Reader, object interface{}) error {
 	}
 	return nil
 }
+
+// IsYAML reports whether the file at path holds YAML instead of JSON, which only the .yaml
+// and .yml extensions tell. Any other file, and stdin, is JSON
+func IsYAML(path string) bool {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		return true
+	}
+	return false
+}
+
+// YAMLToJSON converts a YAML document to JSON, so that it's decoded by the json tags
+// of the request models
+func YAMLToJSON(data []byte) ([]byte, error) {
+	var document interface{}
+	if err := yaml.Unmarshal(data, &document); err != nil {
+		return nil, err
+	}
+	return json.Marshal(jsonValue(document))
+}
+
+// jsonValue turns the maps decoded from YAML, which may have keys of any type, into
+// maps with string keys
+func jsonValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[interface{}]interface{}:
+		object := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			object[fmt.Sprint(key)] = jsonValue(item)
+		}
+		return object
+	case []interface{}:
+		for i, item := range v {
+			v[i] = jsonValue(item)
+		}
+	}
+	return value
+}
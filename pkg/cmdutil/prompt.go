@@ -0,0 +1,47 @@
+package cmdutil
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// promptedAnnotation marks the flags a command asks for when they aren't given. Its values
+// are the flags that replace them, such as --file
+const promptedAnnotation = "azion_prompted"
+
+// MarkPrompted marks the flags the command prompts for when they aren't given, so that
+// they are reported as missing when prompts are disabled
+func MarkPrompted(cmd *cobra.Command, names ...string) {
+	MarkPromptedUnless(cmd, "", names...)
+}
+
+// MarkPromptedUnless marks the flags the command prompts for unless the alternative flag
+// is given
+func MarkPromptedUnless(cmd *cobra.Command, alternative string, names ...string) {
+	var alternatives []string
+	if alternative != "" {
+		alternatives = []string{alternative}
+	}
+	for _, name := range names {
+		_ = cmd.Flags().SetAnnotation(name, promptedAnnotation, alternatives)
+	}
+}
+
+// MissingPrompted lists, in their --flag form, the flags of the command that it would prompt for
+func MissingPrompted(cmd *cobra.Command) []string {
+	flags := cmd.Flags()
+	var missing []string
+	flags.VisitAll(func(flag *pflag.Flag) {
+		alternatives, ok := flag.Annotations[promptedAnnotation]
+		if !ok || flag.Changed {
+			return
+		}
+		for _, alternative := range alternatives {
+			if flags.Changed(alternative) {
+				return
+			}
+		}
+		missing = append(missing, "--"+flag.Name)
+	})
+	return missing
+}
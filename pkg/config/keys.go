@@ -0,0 +1,81 @@
+package config
+
+import (
+	"strings"
+
+	msg "github.com/aziontech/azion-cli/messages/config"
+)
+
+// EnvPrefix prefixes the environment variables that set the settings, as in AZIONCLI_API_URL
+const EnvPrefix = "AZIONCLI"
+
+// Key is a setting 'azion config' displays and changes
+type Key struct {
+	Name        string
+	Description string
+	// Project tells whether projects may set it in their ProjectFile. The settings that choose
+	// where the requests and the token go can't be, as any cloned repository could set them
+	Project bool
+	// Secret values are masked when displayed
+	Secret bool
+	// Global settings are the same for every profile
+	Global bool
+	// ReadOnly settings are changed by other commands, which ReadOnlyHint names
+	ReadOnly     bool
+	ReadOnlyHint string
+}
+
+var Keys = []Key{
+	{Name: "api_url", Description: msg.KeyApiURL},
+	{Name: "storage_url", Description: msg.KeyStorageURL},
+	{Name: "token", Description: msg.KeyToken, Secret: true, ReadOnly: true, ReadOnlyHint: "Run 'azion login' to change it"},
+	{Name: "credential_helper", Description: msg.KeyCredentialHelper, Global: true},
+	{Name: "timeout", Description: msg.KeyTimeout, Project: true},
+	{Name: "upload_timeout", Description: msg.KeyUploadTimeout, Project: true},
+	{Name: "max_retries", Description: msg.KeyMaxRetries, Project: true},
+	{Name: "retry_max_wait", Description: msg.KeyRetryMaxWait, Project: true},
+	{Name: "proxy", Description: msg.KeyProxy},
+	{Name: "ca_bundle", Description: msg.KeyCABundle},
+}
+
+// FindKey returns the setting with the given name
+func FindKey(name string) (Key, bool) {
+	for _, key := range Keys {
+		if key.Name == name {
+			return key, true
+		}
+	}
+	return Key{}, false
+}
+
+// KeyNames lists the names of the settings, for error messages
+func KeyNames() string {
+	names := make([]string, 0, len(Keys))
+	for _, key := range Keys {
+		names = append(names, key.Name)
+	}
+	return strings.Join(names, ", ")
+}
+
+// EnvName is the environment variable that sets the setting
+func EnvName(key string) string {
+	return EnvPrefix + "_" + strings.ToUpper(key)
+}
+
+// FlagName is the flag that sets the setting, for the commands that have one
+func FlagName(key string) string {
+	return strings.ReplaceAll(key, "_", "-")
+}
+
+// Display returns the value of the setting as it's displayed, with secrets masked but for their
+// last characters, which tell them apart
+func Display(name, value string) string {
+	key, _ := FindKey(name)
+	if !key.Secret || value == "" {
+		return value
+	}
+	if len(value) <= 8 {
+		return strings.Repeat("*", len(value))
+	}
+	return strings.Repeat("*", 8) + value[len(value)-4:]
+}
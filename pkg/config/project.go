@@ -0,0 +1,41 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+
+	"github.com/pelletier/go-toml/v2"
+)
+
+// ProjectFile keeps the settings of the project in the current directory, next to azion.json
+var ProjectFile = filepath.Join("azion", "config")
+
+// ReadProject returns the settings of the project in the current directory, which has none when
+// it has no ProjectFile
+func ReadProject() (map[string]string, error) {
+	values := map[string]string{}
+	data, err := os.ReadFile(ProjectFile)
+	if errors.Is(err, os.ErrNotExist) {
+		return values, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := toml.Unmarshal(data, &values); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// WriteProject writes the settings of the project in the current directory
+func WriteProject(values map[string]string) error {
+	data, err := toml.Marshal(values)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(ProjectFile), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(ProjectFile, data, 0644)
+}
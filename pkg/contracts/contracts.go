@@ -23,7 +23,6 @@ type ListOptions struct {
 	Sort     string
 	Page     int64
 	PageSize int64
-	Filter   string
 }
 
 type DescribeOptions struct {
@@ -0,0 +1,36 @@
+// Package credential keeps the personal tokens of the CLI out of settings.toml. Tokens are handed
+// to a credential helper, which is either the built-in file helper or an external program named
+// azion-credential-<name>, as git does with its credential helpers.
+//
+// External helpers are called with the action as their only argument, get, store or erase, and
+// read key=value lines from the standard input:
+//
+//	profile=<name of the profile>
+//	token=<personal token, for store only>
+//
+// For get, they write a token=<personal token> line to the standard output, or nothing when they
+// don't have a token for the profile. A helper that exits with a status other than 0 has failed
+package credential
+
+import "strings"
+
+// FileHelper is the name of the built-in helper, which is used when no other is set
+const FileHelper = "file"
+
+// ProgramPrefix prefixes the name of the programs of external helpers
+const ProgramPrefix = "azion-credential-"
+
+// Helper keeps the token of each profile
+type Helper interface {
+	Get(profile string) (string, error)
+	Store(profile, token string) error
+	Erase(profile string) error
+}
+
+// New returns the helper with the given name
+func New(name string) Helper {
+	if name == "" || name == FileHelper {
+		return &File{}
+	}
+	return &Program{Name: ProgramPrefix + strings.TrimPrefix(name, ProgramPrefix)}
+}
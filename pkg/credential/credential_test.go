@@ -0,0 +1,93 @@
+package credential
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aziontech/azion-cli/pkg/config"
+)
+
+func TestFile(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, config.SetPath(filepath.Join(dir, "settings.toml")))
+	t.Cleanup(func() {
+		_ = config.SetPath(filepath.Join(config.DEFAULT_DIR, config.DEFAULT_SETTINGS))
+	})
+
+	helper := New("")
+	token, err := helper.Get("default")
+	require.NoError(t, err)
+	assert.Empty(t, token)
+
+	require.NoError(t, helper.Store("default", "defaulttoken"))
+	require.NoError(t, helper.Store("staging", "stagingtoken"))
+	token, err = helper.Get("staging")
+	require.NoError(t, err)
+	assert.Equal(t, "stagingtoken", token)
+
+	info, err := os.Stat(filepath.Join(dir, FileName))
+	require.NoError(t, err)
+	if runtime.GOOS != "windows" {
+		assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+	}
+
+	require.NoError(t, helper.Erase("staging"))
+	token, err = helper.Get("staging")
+	require.NoError(t, err)
+	assert.Empty(t, token)
+	token, err = helper.Get("default")
+	require.NoError(t, err)
+	assert.Equal(t, "defaulttoken", token)
+}
+
+// fakeHelper is an external helper keeping each profile's token in a file of its own
+const fakeHelper = `#!/bin/sh
+while IFS='=' read -r key value; do
+	case "$key" in
+		profile) profile="$value" ;;
+		token) token="$value" ;;
+	esac
+done
+case "$1" in
+	get) [ -f "$STORE/$profile" ] && echo "token=$(cat "$STORE/$profile")" ;;
+	store) printf '%s' "$token" > "$STORE/$profile" ;;
+	erase) rm -f "$STORE/$profile" ;;
+	*) echo "unknown action $1" >&2; exit 1 ;;
+esac
+exit 0
+`
+
+func TestProgram(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the fake helper is a shell script")
+	}
+
+	bin := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(bin, "azion-credential-fake"), []byte(fakeHelper), 0700))
+	t.Setenv("PATH", bin+string(os.PathListSeparator)+os.Getenv("PATH"))
+	t.Setenv("STORE", t.TempDir())
+
+	helper := New("fake")
+	token, err := helper.Get("default")
+	require.NoError(t, err)
+	assert.Empty(t, token)
+
+	require.NoError(t, helper.Store("default", "defaulttoken"))
+	token, err = helper.Get("default")
+	require.NoError(t, err)
+	assert.Equal(t, "defaulttoken", token)
+
+	require.NoError(t, helper.Erase("default"))
+	token, err = helper.Get("default")
+	require.NoError(t, err)
+	assert.Empty(t, token)
+
+	_, err = New("missing").Get("default")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "azion-credential-missing get")
+}
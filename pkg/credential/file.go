@@ -0,0 +1,100 @@
+package credential
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+
+	"github.com/pelletier/go-toml/v2"
+
+	"github.com/aziontech/azion-cli/pkg/config"
+)
+
+// FileName is the file the built-in helper keeps the tokens in, next to settings.toml
+const FileName = "credentials.toml"
+
+// File keeps the tokens in a file only its owner can read
+type File struct{}
+
+func (h *File) Get(profile string) (string, error) {
+	tokens, err := h.read()
+	if err != nil {
+		return "", err
+	}
+	return tokens[profile], nil
+}
+
+func (h *File) Store(profile, token string) error {
+	tokens, err := h.read()
+	if err != nil {
+		return err
+	}
+	tokens[profile] = token
+	return h.write(tokens)
+}
+
+func (h *File) Erase(profile string) error {
+	tokens, err := h.read()
+	if err != nil {
+		return err
+	}
+	if _, ok := tokens[profile]; !ok {
+		return nil
+	}
+	delete(tokens, profile)
+	return h.write(tokens)
+}
+
+// Path is where the tokens are kept
+func (h *File) Path() (string, error) {
+	dir, err := config.Dir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir.Dir, FileName), nil
+}
+
+func (h *File) read() (map[string]string, error) {
+	path, err := h.Path()
+	if err != nil {
+		return nil, err
+	}
+
+	tokens := map[string]string{}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return tokens, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := toml.Unmarshal(data, &tokens); err != nil {
+		return nil, err
+	}
+	return tokens, nil
+}
+
+func (h *File) write(tokens map[string]string) error {
+	path, err := h.Path()
+	if err != nil {
+		return err
+	}
+
+	data, err := toml.Marshal(tokens)
+	if err != nil {
+		return err
+	}
+	return WritePrivate(path, data)
+}
+
+// WritePrivate writes a file only its owner can read and write, tightening the permissions of a
+// file that already exists
+func WritePrivate(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		return err
+	}
+	return os.Chmod(path, 0600)
+}
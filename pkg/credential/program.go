@@ -0,0 +1,55 @@
+package credential
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// Program is an external helper
+type Program struct {
+	Name string
+}
+
+func (h *Program) Get(profile string) (string, error) {
+	out, err := h.run("get", "profile="+profile+"\n")
+	if err != nil {
+		return "", err
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(out))
+	for scanner.Scan() {
+		if token, found := strings.CutPrefix(scanner.Text(), "token="); found {
+			return token, nil
+		}
+	}
+	return "", scanner.Err()
+}
+
+func (h *Program) Store(profile, token string) error {
+	_, err := h.run("store", "profile="+profile+"\ntoken="+token+"\n")
+	return err
+}
+
+func (h *Program) Erase(profile string) error {
+	_, err := h.run("erase", "profile="+profile+"\n")
+	return err
+}
+
+func (h *Program) run(action, input string) ([]byte, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command(h.Name, action)
+	cmd.Stdin = strings.NewReader(input)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if message := strings.TrimSpace(stderr.String()); message != "" {
+			return nil, fmt.Errorf("%s %s: %w: %s", h.Name, action, err, message)
+		}
+		return nil, fmt.Errorf("%s %s: %w", h.Name, action, err)
+	}
+	return stdout.Bytes(), nil
+}
@@ -0,0 +1,105 @@
+// Package dotenv reads and writes the KEY=value files that tools such as docker compose and most
+// web frameworks load their environment from
+package dotenv
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Variable is an assignment of the file, with the line it's on
+type Variable struct {
+	Key   string
+	Value string
+	Line  int
+}
+
+// Parse reads the assignments of a .env file given as lines, as utils.LoadEnvVarsFromFile returns
+// them. Blank lines and comments are skipped, an export prefix is allowed and values may be quoted.
+// When a key is assigned more than once, the last assignment wins, as in the shells
+func Parse(lines []string) ([]Variable, error) {
+	var vars []Variable
+	index := map[string]int{}
+
+	for i, line := range lines {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		line = strings.TrimPrefix(line, "export ")
+
+		key, value, found := strings.Cut(line, "=")
+		key = strings.TrimSpace(key)
+		if !found || !validKey(key) {
+			return nil, fmt.Errorf("line %d: expected KEY=value, found %q", i+1, line)
+		}
+
+		value, err := parseValue(strings.TrimSpace(value))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+1, err)
+		}
+
+		variable := Variable{Key: key, Value: value, Line: i + 1}
+		if at, ok := index[key]; ok {
+			vars[at] = variable
+			continue
+		}
+		index[key] = len(vars)
+		vars = append(vars, variable)
+	}
+	return vars, nil
+}
+
+// parseValue unquotes double quoted values, which may hold escapes, and single quoted ones, which
+// are taken literally. Unquoted values end at a comment
+func parseValue(value string) (string, error) {
+	switch {
+	case strings.HasPrefix(value, `"`):
+		unquoted, err := strconv.Unquote(value)
+		if err != nil {
+			return "", fmt.Errorf("invalid double quoted value %s", value)
+		}
+		return unquoted, nil
+	case strings.HasPrefix(value, "'"):
+		if len(value) < 2 || !strings.HasSuffix(value, "'") {
+			return "", fmt.Errorf("invalid single quoted value %s", value)
+		}
+		return value[1 : len(value)-1], nil
+	}
+	if at := strings.Index(value, " #"); at >= 0 {
+		value = strings.TrimSpace(value[:at])
+	}
+	return value, nil
+}
+
+func validKey(key string) bool {
+	if key == "" {
+		return false
+	}
+	for i, c := range key {
+		letter := c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+		if !letter && (i == 0 || c < '0' || c > '9') {
+			return false
+		}
+	}
+	return true
+}
+
+// Write writes the variables as a .env file, quoting the values that wouldn't be read back as they are
+func Write(w io.Writer, vars []Variable) error {
+	for _, variable := range vars {
+		if _, err := fmt.Fprintf(w, "%s=%s\n", variable.Key, quote(variable.Value)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func quote(value string) string {
+	if value == "" || strings.ContainsAny(value, " \t\n\r\"'#\\$`") {
+		return strconv.Quote(value)
+	}
+	return value
+}
@@ -0,0 +1,67 @@
+package dotenv
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	lines := []string{
+		"# database",
+		"DATABASE_URL=postgres://db.example.com/app",
+		"",
+		"export API_TOKEN = 'abc $123'",
+		`GREETING="hello\nworld"`,
+		"REGION=us-east # the closest one",
+		"EMPTY=",
+		"REGION=sa-east",
+	}
+
+	vars, err := Parse(lines)
+	require.NoError(t, err)
+	assert.Equal(t, []Variable{
+		{Key: "DATABASE_URL", Value: "postgres://db.example.com/app", Line: 2},
+		{Key: "API_TOKEN", Value: "abc $123", Line: 4},
+		{Key: "GREETING", Value: "hello\nworld", Line: 5},
+		{Key: "REGION", Value: "sa-east", Line: 8},
+		{Key: "EMPTY", Value: "", Line: 7},
+	}, vars)
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		line string
+		want string
+	}{
+		{"NO_VALUE", `line 1: expected KEY=value, found "NO_VALUE"`},
+		{"1KEY=value", `line 1: expected KEY=value, found "1KEY=value"`},
+		{`QUOTED="open`, `line 1: invalid double quoted value "open`},
+		{"QUOTED='open", `line 1: invalid single quoted value 'open`},
+	}
+	for _, tt := range tests {
+		_, err := Parse([]string{tt.line})
+		assert.EqualError(t, err, tt.want)
+	}
+}
+
+func TestWrite(t *testing.T) {
+	vars := []Variable{
+		{Key: "REGION", Value: "sa-east"},
+		{Key: "GREETING", Value: "hello world"},
+		{Key: "EMPTY", Value: ""},
+	}
+
+	var out bytes.Buffer
+	require.NoError(t, Write(&out, vars))
+	assert.Equal(t, "REGION=sa-east\nGREETING=\"hello world\"\nEMPTY=\"\"\n", out.String())
+
+	parsed, err := Parse(strings.Split(out.String(), "\n"))
+	require.NoError(t, err)
+	for i, variable := range parsed {
+		assert.Equal(t, vars[i].Value, variable.Value)
+	}
+}
@@ -14,10 +14,10 @@ type Release struct {
 	TagName string `json:"tag_name"`
 }
 
-func GetVersionGitHub(name string) (string, error) {
+func GetVersionGitHub(c *http.Client, name string) (string, error) {
 	apiURL := fmt.Sprintf("https://api.github.com/repos/aziontech/%s/releases/latest", name)
 
-	response, err := http.Get(apiURL)
+	response, err := c.Get(apiURL)
 	if err != nil {
 		logger.Debug("Failed to get latest version of "+name, zap.Error(err))
 		return "", err
@@ -0,0 +1,53 @@
+package httpclient
+
+import (
+	"net/http"
+	"sync"
+)
+
+// RequestIDHeader identifies the responses of the Azion API, so that support can find them
+const RequestIDHeader = "X-Request-Id"
+
+// Failure is how a request failed: with an error status, or without a response at all
+type Failure struct {
+	StatusCode int
+	RequestID  string
+	Err        error
+}
+
+var (
+	lastFailure *Failure
+	failureMu   sync.Mutex
+)
+
+// LastFailure returns how the last request sent failed, or nil when it succeeded. The commands
+// turn the API errors into messages, so this is how the exit code learns the HTTP status
+func LastFailure() *Failure {
+	failureMu.Lock()
+	defer failureMu.Unlock()
+	return lastFailure
+}
+
+func recordFailure(failure *Failure) {
+	failureMu.Lock()
+	defer failureMu.Unlock()
+	lastFailure = failure
+}
+
+// failureTransport records how the requests end, after their retries
+type failureTransport struct {
+	next http.RoundTripper
+}
+
+func (t *failureTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.next.RoundTrip(req)
+	switch {
+	case err != nil:
+		recordFailure(&Failure{Err: err})
+	case resp.StatusCode >= http.StatusBadRequest:
+		recordFailure(&Failure{StatusCode: resp.StatusCode, RequestID: resp.Header.Get(RequestIDHeader)})
+	default:
+		recordFailure(nil)
+	}
+	return resp, err
+}
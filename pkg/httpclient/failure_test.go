@@ -0,0 +1,38 @@
+package httpclient
+
+import (
+	"net/http"
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLastFailure(t *testing.T) {
+	t.Cleanup(func() { recordFailure(nil) })
+
+	transport := &failureTransport{next: &attempts{responses: []func() (*http.Response, error){
+		status(http.StatusTooManyRequests, RequestIDHeader, "b1f3c2"),
+		reset,
+		status(http.StatusOK),
+	}}}
+	send := func() {
+		req, err := http.NewRequest("GET", "https://api.azionapi.net/domains", nil)
+		require.NoError(t, err)
+		resp, err := transport.RoundTrip(req)
+		if err == nil {
+			resp.Body.Close()
+		}
+	}
+
+	send()
+	assert.Equal(t, &Failure{StatusCode: http.StatusTooManyRequests, RequestID: "b1f3c2"}, LastFailure())
+
+	send()
+	require.NotNil(t, LastFailure())
+	assert.ErrorIs(t, LastFailure().Err, syscall.ECONNRESET)
+
+	send()
+	assert.Nil(t, LastFailure())
+}
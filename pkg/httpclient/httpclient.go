@@ -0,0 +1,194 @@
+// Package httpclient builds the HTTP client the commands share. Its timeouts depend on the class
+// of the operation, as uploading a large file takes much longer than an API call, and its
+// transport goes through the proxy and trusts the certificate authorities the settings give, so
+// that the CLI works behind corporate proxies that inspect TLS traffic
+package httpclient
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Class is a class of operations that share a timeout
+type Class int
+
+const (
+	// API is the class of the calls to the Azion API
+	API Class = iota
+	// Upload is the class of the requests that send files to the storage API
+	Upload
+)
+
+const (
+	DefaultTimeout       = 30 * time.Second
+	DefaultUploadTimeout = 30 * time.Minute
+)
+
+// Options configure the client. Zero durations keep the defaults
+type Options struct {
+	// Timeout limits each attempt of an API call
+	Timeout time.Duration
+	// UploadTimeout limits each upload
+	UploadTimeout time.Duration
+	// Proxy is the URL of the proxy the requests go through. When empty, the proxy is taken from
+	// the HTTPS_PROXY, HTTP_PROXY and NO_PROXY environment variables
+	Proxy string
+	// CABundle is the path of a PEM file with certificate authorities to trust besides the
+	// ones of the system
+	CABundle string
+	// MaxRetries is how many times a request that failed for a transient reason is sent again.
+	// Zero disables the retries
+	MaxRetries int
+	// RetryMaxWait limits the wait between two attempts
+	RetryMaxWait time.Duration
+	// Trace receives each request and response, with their secrets redacted
+	Trace io.Writer
+	// TraceBodies adds the bodies to the trace
+	TraceBodies bool
+	// HAR records the requests, when given
+	HAR *HAR
+}
+
+var timeouts = map[Class]time.Duration{
+	API:    DefaultTimeout,
+	Upload: DefaultUploadTimeout,
+}
+
+// New returns a client with the default options
+func New() *http.Client {
+	return &http.Client{Timeout: DefaultTimeout}
+}
+
+// Configure applies the options to the client. Its transport, which traces the requests, retries
+// the ones that fail for transient reasons and records the last failure, is replaced unless it's
+// one the caller provided, as the tests do
+func Configure(c *http.Client, opts Options) error {
+	if opts.Timeout == 0 {
+		opts.Timeout = DefaultTimeout
+	}
+	if opts.UploadTimeout == 0 {
+		opts.UploadTimeout = DefaultUploadTimeout
+	}
+	if opts.RetryMaxWait == 0 {
+		opts.RetryMaxWait = DefaultRetryMaxWait
+	}
+
+	switch c.Transport.(type) {
+	case nil, *http.Transport, *failureTransport:
+		transport, err := NewTransport(opts)
+		if err != nil {
+			return err
+		}
+		var next http.RoundTripper = transport
+		if opts.Trace != nil || opts.HAR != nil {
+			next = &traceTransport{next: transport, out: opts.Trace, bodies: opts.TraceBodies, har: opts.HAR}
+		}
+		c.Transport = &failureTransport{
+			next: &retryTransport{next: next, maxRetries: opts.MaxRetries, maxWait: opts.RetryMaxWait},
+		}
+	}
+
+	timeouts[API] = opts.Timeout
+	timeouts[Upload] = opts.UploadTimeout
+	// the timeout goes in the transport of the CLI, or in the client otherwise
+	*c = *For(c, API)
+	return nil
+}
+
+// NewTransport returns a transport that goes through the proxy and trusts the certificate
+// authorities of the options
+func NewTransport(opts Options) (*http.Transport, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	transport.Proxy = http.ProxyFromEnvironment
+	if opts.Proxy != "" {
+		proxy, err := url.Parse(opts.Proxy)
+		if err != nil || proxy.Host == "" {
+			return nil, fmt.Errorf("invalid proxy URL %s", opts.Proxy)
+		}
+		transport.Proxy = http.ProxyURL(proxy)
+	}
+
+	if opts.CABundle != "" {
+		pool, err := certPool(opts.CABundle)
+		if err != nil {
+			return nil, err
+		}
+		transport.TLSClientConfig = &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}
+	}
+
+	return transport, nil
+}
+
+// For returns a copy of the client with the timeout of the class. The copy shares the connections
+// of the client. With the transport of the CLI, the timeout limits each attempt of a request
+// rather than all of them and the waits between them
+func For(c *http.Client, class Class) *http.Client {
+	client := *c
+	if failures, ok := c.Transport.(*failureTransport); ok {
+		if retry, ok := failures.next.(*retryTransport); ok {
+			perAttempt := *retry
+			perAttempt.timeout = timeouts[class]
+			client.Transport = &failureTransport{next: &perAttempt}
+			client.Timeout = 0
+			return &client
+		}
+	}
+	client.Timeout = timeouts[class]
+	return &client
+}
+
+// WithoutRetries returns a copy of the client that sends each request once and doesn't record its
+// failures, for the endpoints other than the Azion API that retry on their own
+func WithoutRetries(c *http.Client) *http.Client {
+	client := *c
+	if failures, ok := c.Transport.(*failureTransport); ok {
+		if retry, ok := failures.next.(*retryTransport); ok {
+			client.Transport = retry.next
+			client.Timeout = retry.timeout
+		}
+	}
+	return &client
+}
+
+// ParseTimeout reads a timeout written as a duration, such as 90s or 5m, or as a number of seconds.
+// Empty values are zero, which keeps the default
+func ParseTimeout(value string) (time.Duration, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, nil
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		value = strconv.Itoa(seconds) + "s"
+	}
+	timeout, err := time.ParseDuration(value)
+	if err != nil || timeout < 0 {
+		return 0, fmt.Errorf("invalid timeout %s, expected a duration such as 90s or 5m", value)
+	}
+	return timeout, nil
+}
+
+// certPool returns the certificate authorities of the system together with the ones of the bundle
+func certPool(bundle string) (*x509.CertPool, error) {
+	pem, err := os.ReadFile(bundle)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the CA bundle: %w", err)
+	}
+
+	pool, err := x509.SystemCertPool()
+	if err != nil || pool == nil {
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no PEM certificates found in %s", bundle)
+	}
+	return pool, nil
+}
@@ -0,0 +1,140 @@
+package httpclient
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aziontech/azion-cli/pkg/httpmock"
+)
+
+func TestParseTimeout(t *testing.T) {
+	tests := []struct {
+		value string
+		want  time.Duration
+		err   bool
+	}{
+		{value: "", want: 0},
+		{value: "90s", want: 90 * time.Second},
+		{value: "5m", want: 5 * time.Minute},
+		{value: "45", want: 45 * time.Second},
+		{value: "soon", err: true},
+		{value: "-1m", err: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := ParseTimeout(tt.value)
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestConfigure(t *testing.T) {
+	t.Cleanup(func() {
+		timeouts[API] = DefaultTimeout
+		timeouts[Upload] = DefaultUploadTimeout
+	})
+
+	t.Run("timeouts per class", func(t *testing.T) {
+		c := New()
+		require.NoError(t, Configure(c, Options{Timeout: time.Minute, UploadTimeout: time.Hour}))
+		assert.Zero(t, c.Timeout)
+		assert.Equal(t, time.Minute, attemptTimeout(t, c))
+
+		upload := For(c, Upload)
+		assert.Zero(t, upload.Timeout)
+		assert.Equal(t, time.Hour, attemptTimeout(t, upload))
+		assert.Equal(t, time.Minute, attemptTimeout(t, c))
+	})
+
+	t.Run("defaults", func(t *testing.T) {
+		c := New()
+		require.NoError(t, Configure(c, Options{}))
+		assert.Equal(t, DefaultTimeout, attemptTimeout(t, c))
+		assert.Equal(t, DefaultUploadTimeout, attemptTimeout(t, For(c, Upload)))
+	})
+
+	t.Run("keep the transport of the caller", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		c := &http.Client{Transport: mock}
+		require.NoError(t, Configure(c, Options{Timeout: time.Minute, Proxy: "http://proxy.example.com:3128"}))
+		assert.Same(t, mock, c.Transport)
+		assert.Equal(t, time.Minute, c.Timeout)
+		assert.Equal(t, DefaultUploadTimeout, For(c, Upload).Timeout)
+	})
+}
+
+// attemptTimeout returns the timeout of each attempt of the requests of the client
+func attemptTimeout(t *testing.T, c *http.Client) time.Duration {
+	failures, ok := c.Transport.(*failureTransport)
+	require.True(t, ok)
+	retry, ok := failures.next.(*retryTransport)
+	require.True(t, ok)
+	return retry.timeout
+}
+
+func TestNewTransport(t *testing.T) {
+	req, err := http.NewRequest("GET", "https://api.azionapi.net/tokens", nil)
+	require.NoError(t, err)
+
+	t.Run("proxy of the settings", func(t *testing.T) {
+		transport, err := NewTransport(Options{Proxy: "http://proxy.example.com:3128"})
+		require.NoError(t, err)
+		proxy, err := transport.Proxy(req)
+		require.NoError(t, err)
+		assert.Equal(t, &url.URL{Scheme: "http", Host: "proxy.example.com:3128"}, proxy)
+	})
+
+	t.Run("invalid proxy", func(t *testing.T) {
+		_, err := NewTransport(Options{Proxy: "proxy"})
+		require.ErrorContains(t, err, "invalid proxy URL proxy")
+	})
+
+	t.Run("proxy of the environment", func(t *testing.T) {
+		transport, err := NewTransport(Options{})
+		require.NoError(t, err)
+		assert.NotNil(t, transport.Proxy)
+	})
+
+	t.Run("trust the CA bundle", func(t *testing.T) {
+		server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		bundle := filepath.Join(t.TempDir(), "ca.pem")
+		cert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+		require.NoError(t, os.WriteFile(bundle, cert, 0600))
+
+		_, err := (&http.Client{}).Get(server.URL)
+		require.Error(t, err)
+
+		transport, err := NewTransport(Options{CABundle: bundle})
+		require.NoError(t, err)
+		resp, err := (&http.Client{Transport: transport}).Get(server.URL)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+	})
+
+	t.Run("bundle without certificates", func(t *testing.T) {
+		bundle := filepath.Join(t.TempDir(), "ca.pem")
+		require.NoError(t, os.WriteFile(bundle, []byte("not a certificate"), 0600))
+
+		_, err := NewTransport(Options{CABundle: bundle})
+		require.ErrorContains(t, err, "no PEM certificates found")
+	})
+}
@@ -0,0 +1,171 @@
+package httpclient
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/aziontech/azion-cli/pkg/logger"
+)
+
+const (
+	DefaultMaxRetries   = 3
+	DefaultRetryMaxWait = 30 * time.Second
+
+	// retryBaseWait is the wait before the first retry, which doubles on each of the next ones
+	retryBaseWait = 500 * time.Millisecond
+)
+
+// sleep waits for the duration unless the context is done first. Tests replace it
+var sleep = func(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// retryTransport sends the requests again when they fail for reasons that are likely to go away:
+// a connection reset, a gateway error or a rate limit. Only idempotent requests are retried, but
+// for rate limited ones, which the API rejects before processing them. The timeout limits each
+// attempt, so that the waits between them don't use up the time of the next one
+type retryTransport struct {
+	next       http.RoundTripper
+	maxRetries int
+	maxWait    time.Duration
+	timeout    time.Duration
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		resp, err := t.attempt(req)
+		if attempt >= t.maxRetries || !t.retryable(req, resp, err) {
+			return resp, err
+		}
+
+		wait := t.backoff(attempt, resp)
+		if err != nil {
+			logger.Debug("Retrying request", zap.String("method", req.Method), zap.String("url", req.URL.String()),
+				zap.Int("attempt", attempt+1), zap.Duration("wait", wait), zap.Error(err))
+		} else {
+			logger.Debug("Retrying request", zap.String("method", req.Method), zap.String("url", req.URL.String()),
+				zap.Int("attempt", attempt+1), zap.Duration("wait", wait), zap.Int("status", resp.StatusCode))
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		if err := sleep(req.Context(), wait); err != nil {
+			return nil, err
+		}
+		if req.Body != nil && req.Body != http.NoBody {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+	}
+}
+
+// attempt sends the request once, limited by the timeout until its body is closed
+func (t *retryTransport) attempt(req *http.Request) (*http.Response, error) {
+	if t.timeout <= 0 {
+		return t.next.RoundTrip(req)
+	}
+	ctx, cancel := context.WithTimeout(req.Context(), t.timeout)
+	resp, err := t.next.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	resp.Body = &cancelBody{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+// cancelBody ends the context of an attempt once its response is read
+type cancelBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
+func (t *retryTransport) retryable(req *http.Request, resp *http.Response, err error) bool {
+	if req.Context().Err() != nil {
+		return false
+	}
+	// a body that can't be read again can't be sent again
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+
+	if err != nil {
+		return idempotent(req.Method) && errors.Is(err, syscall.ECONNRESET)
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return idempotent(req.Method)
+	}
+	return false
+}
+
+// backoff returns how long to wait before the next attempt: the time the API asks for in the
+// Retry-After header or an exponential backoff with jitter, limited to the maximum wait
+func (t *retryTransport) backoff(attempt int, resp *http.Response) time.Duration {
+	wait, ok := retryAfter(resp)
+	if !ok {
+		wait = retryBaseWait << attempt
+		wait = wait/2 + time.Duration(rand.Int63n(int64(wait/2)+1))
+	}
+	if wait > t.maxWait {
+		wait = t.maxWait
+	}
+	return wait
+}
+
+// retryAfter reads the Retry-After header, given either in seconds or as a date
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+	value := resp.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+	return 0, false
+}
+
+func idempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
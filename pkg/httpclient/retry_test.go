@@ -0,0 +1,252 @@
+package httpclient
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/aziontech/azion-cli/pkg/logger"
+)
+
+// attempts answers each request with the next of its responses, recording the bodies it received
+type attempts struct {
+	responses []func() (*http.Response, error)
+	bodies    []string
+}
+
+func (a *attempts) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		body, _ := io.ReadAll(req.Body)
+		a.bodies = append(a.bodies, string(body))
+	}
+	next := a.responses[0]
+	a.responses = a.responses[1:]
+	return next()
+}
+
+func status(code int, headers ...string) func() (*http.Response, error) {
+	return func() (*http.Response, error) {
+		resp := &http.Response{StatusCode: code, Header: http.Header{}, Body: io.NopCloser(strings.NewReader(""))}
+		for i := 0; i+1 < len(headers); i += 2 {
+			resp.Header.Set(headers[i], headers[i+1])
+		}
+		return resp, nil
+	}
+}
+
+func reset() (*http.Response, error) {
+	return nil, fmt.Errorf("read tcp: %w", syscall.ECONNRESET)
+}
+
+// recordWaits replaces the waits between the attempts with a record of them
+func recordWaits(t *testing.T) *[]time.Duration {
+	waits := &[]time.Duration{}
+	original := sleep
+	sleep = func(_ context.Context, d time.Duration) error {
+		*waits = append(*waits, d)
+		return nil
+	}
+	t.Cleanup(func() { sleep = original })
+	return waits
+}
+
+func TestRetry(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+
+	tests := []struct {
+		name      string
+		method    string
+		responses []func() (*http.Response, error)
+		status    int
+		err       bool
+		calls     int
+	}{
+		{
+			name:      "retry gateway errors",
+			method:    "GET",
+			responses: []func() (*http.Response, error){status(502), status(503), status(504), status(200)},
+			status:    200,
+			calls:     4,
+		},
+		{
+			name:      "retry connection resets",
+			method:    "DELETE",
+			responses: []func() (*http.Response, error){reset, status(204)},
+			status:    204,
+			calls:     2,
+		},
+		{
+			name:      "give up after the retries",
+			method:    "GET",
+			responses: []func() (*http.Response, error){status(503), status(503), status(503), status(503)},
+			status:    503,
+			calls:     4,
+		},
+		{
+			name:      "don't retry requests that aren't idempotent",
+			method:    "POST",
+			responses: []func() (*http.Response, error){status(502)},
+			status:    502,
+			calls:     1,
+		},
+		{
+			name:      "don't retry resets of requests that aren't idempotent",
+			method:    "POST",
+			responses: []func() (*http.Response, error){reset},
+			err:       true,
+			calls:     1,
+		},
+		{
+			name:      "retry rate limited requests",
+			method:    "POST",
+			responses: []func() (*http.Response, error){status(429), status(201)},
+			status:    201,
+			calls:     2,
+		},
+		{
+			name:      "don't retry client errors",
+			method:    "GET",
+			responses: []func() (*http.Response, error){status(404)},
+			status:    404,
+			calls:     1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recordWaits(t)
+			next := &attempts{responses: tt.responses}
+			transport := &retryTransport{next: next, maxRetries: 3, maxWait: DefaultRetryMaxWait}
+
+			req, err := http.NewRequest(tt.method, "https://api.azionapi.net/domains", bytes.NewBufferString(`{"name":"x"}`))
+			require.NoError(t, err)
+			resp, err := transport.RoundTrip(req)
+			if tt.err {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.status, resp.StatusCode)
+			}
+			assert.Len(t, next.bodies, tt.calls)
+			for _, body := range next.bodies {
+				assert.Equal(t, `{"name":"x"}`, body)
+			}
+		})
+	}
+}
+
+func TestRetryWait(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+
+	t.Run("exponential backoff with jitter", func(t *testing.T) {
+		waits := recordWaits(t)
+		next := &attempts{responses: []func() (*http.Response, error){status(503), status(503), status(503), status(200)}}
+		transport := &retryTransport{next: next, maxRetries: 3, maxWait: DefaultRetryMaxWait}
+
+		req, _ := http.NewRequest("GET", "https://api.azionapi.net/domains", nil)
+		_, err := transport.RoundTrip(req)
+		require.NoError(t, err)
+
+		require.Len(t, *waits, 3)
+		for i, wait := range *waits {
+			max := retryBaseWait << i
+			assert.GreaterOrEqual(t, wait, max/2)
+			assert.LessOrEqual(t, wait, max)
+		}
+	})
+
+	t.Run("honor Retry-After", func(t *testing.T) {
+		waits := recordWaits(t)
+		next := &attempts{responses: []func() (*http.Response, error){status(429, "Retry-After", "7"), status(200)}}
+		transport := &retryTransport{next: next, maxRetries: 3, maxWait: DefaultRetryMaxWait}
+
+		req, _ := http.NewRequest("GET", "https://api.azionapi.net/domains", nil)
+		_, err := transport.RoundTrip(req)
+		require.NoError(t, err)
+		assert.Equal(t, []time.Duration{7 * time.Second}, *waits)
+	})
+
+	t.Run("limit the wait", func(t *testing.T) {
+		waits := recordWaits(t)
+		date := time.Now().Add(time.Hour).UTC().Format(http.TimeFormat)
+		next := &attempts{responses: []func() (*http.Response, error){status(429, "Retry-After", date), status(200)}}
+		transport := &retryTransport{next: next, maxRetries: 3, maxWait: 10 * time.Second}
+
+		req, _ := http.NewRequest("GET", "https://api.azionapi.net/domains", nil)
+		_, err := transport.RoundTrip(req)
+		require.NoError(t, err)
+		assert.Equal(t, []time.Duration{10 * time.Second}, *waits)
+	})
+
+	t.Run("disabled retries", func(t *testing.T) {
+		waits := recordWaits(t)
+		next := &attempts{responses: []func() (*http.Response, error){status(503)}}
+		transport := &retryTransport{next: next, maxRetries: 0, maxWait: DefaultRetryMaxWait}
+
+		req, _ := http.NewRequest("GET", "https://api.azionapi.net/domains", nil)
+		resp, err := transport.RoundTrip(req)
+		require.NoError(t, err)
+		assert.Equal(t, 503, resp.StatusCode)
+		assert.Empty(t, *waits)
+	})
+}
+
+func TestRetryTimeout(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+	t.Cleanup(func() {
+		timeouts[API] = DefaultTimeout
+		timeouts[Upload] = DefaultUploadTimeout
+	})
+
+	t.Run("waits don't count against the timeout", func(t *testing.T) {
+		var calls atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if calls.Add(1) == 1 {
+				w.Header().Set("Retry-After", "1")
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			fmt.Fprint(w, "ok")
+		}))
+		defer server.Close()
+
+		c := New()
+		require.NoError(t, Configure(c, Options{Timeout: 500 * time.Millisecond, MaxRetries: 1}))
+		resp, err := c.Get(server.URL)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		assert.Equal(t, "ok", string(body))
+		assert.Equal(t, int32(2), calls.Load())
+	})
+
+	t.Run("each attempt times out", func(t *testing.T) {
+		var calls atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls.Add(1)
+			select {
+			case <-r.Context().Done():
+			case <-time.After(5 * time.Second):
+			}
+		}))
+		defer server.Close()
+
+		c := New()
+		require.NoError(t, Configure(c, Options{Timeout: 100 * time.Millisecond, MaxRetries: 3}))
+		_, err := c.Get(server.URL)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Equal(t, int32(1), calls.Load())
+	})
+}
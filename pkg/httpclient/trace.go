@@ -0,0 +1,207 @@
+package httpclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+// maxTracedBody limits how much of each body the traces and the HAR files keep
+const maxTracedBody = 64 * 1024
+
+const redacted = "[REDACTED]"
+
+// secretHeaders are the headers whose values are left out of the traces
+var secretHeaders = map[string]bool{
+	"Authorization":       true,
+	"Proxy-Authorization": true,
+	"Cookie":              true,
+	"Set-Cookie":          true,
+}
+
+// traceTransport writes each request and response to the trace, as curl -v does, and records them
+// in the HAR file. Tokens, passwords and keys are left out of both
+type traceTransport struct {
+	next   http.RoundTripper
+	out    io.Writer
+	bodies bool
+	har    *HAR
+
+	mu sync.Mutex
+}
+
+func (t *traceTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var reqBody []byte
+	if t.bodies || t.har != nil {
+		req, reqBody = peekRequestBody(req)
+	}
+
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	elapsed := time.Since(start)
+
+	var respBody []byte
+	if resp != nil && (t.bodies || t.har != nil) {
+		respBody = peekResponseBody(resp)
+	}
+
+	if t.out != nil {
+		t.write(req, reqBody, resp, respBody, elapsed, err)
+	}
+	if t.har != nil {
+		t.har.add(start, elapsed, req, reqBody, resp, respBody)
+	}
+	return resp, err
+}
+
+func (t *traceTransport) write(req *http.Request, reqBody []byte, resp *http.Response, respBody []byte, elapsed time.Duration, err error) {
+	var b strings.Builder
+	fmt.Fprintf(&b, "> %s %s\n", req.Method, req.URL)
+	writeHeaders(&b, "> ", req.Header)
+	if t.bodies && len(reqBody) > 0 {
+		fmt.Fprintf(&b, ">\n%s\n", bodyText(reqBody))
+	}
+
+	if err != nil {
+		fmt.Fprintf(&b, "< error after %s: %s\n\n", elapsed.Round(time.Millisecond), err)
+	} else {
+		fmt.Fprintf(&b, "< %s (%s)\n", resp.Status, elapsed.Round(time.Millisecond))
+		writeHeaders(&b, "< ", resp.Header)
+		if t.bodies && len(respBody) > 0 {
+			fmt.Fprintf(&b, "<\n%s\n", bodyText(respBody))
+		}
+		b.WriteString("\n")
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	_, _ = io.WriteString(t.out, b.String())
+}
+
+func writeHeaders(b *strings.Builder, prefix string, header http.Header) {
+	names := make([]string, 0, len(header))
+	for name := range header {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		for _, value := range header[name] {
+			fmt.Fprintf(b, "%s%s: %s\n", prefix, name, redactHeader(name, value))
+		}
+	}
+}
+
+// redactHeader hides the value of secret headers, but for the authorization scheme
+func redactHeader(name, value string) string {
+	if !secretHeaders[http.CanonicalHeaderKey(name)] {
+		return value
+	}
+	if scheme, _, found := strings.Cut(value, " "); found && !strings.Contains(name, "Cookie") {
+		return scheme + " " + redacted
+	}
+	return redacted
+}
+
+// bodyText returns the body as it's traced: JSON with its secrets redacted, other text as is and
+// a note for binary content and for the bodies too large to be redacted
+func bodyText(body []byte) string {
+	if len(body) >= maxTracedBody {
+		return fmt.Sprintf("[body larger than %d bytes left out]", maxTracedBody)
+	}
+	if !utf8.Valid(body) {
+		return fmt.Sprintf("[%d bytes of binary data]", len(body))
+	}
+	return string(RedactBody(body))
+}
+
+// RedactBody replaces the values of the JSON fields that hold tokens, passwords and keys. Bodies
+// that aren't JSON are returned as they are
+func RedactBody(body []byte) []byte {
+	var value interface{}
+	if err := json.Unmarshal(body, &value); err != nil {
+		return body
+	}
+	out, err := json.Marshal(redactValue(value))
+	if err != nil {
+		return body
+	}
+	return out
+}
+
+func redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			if secretField(key) {
+				if field != nil && field != "" {
+					v[key] = redacted
+				}
+				continue
+			}
+			v[key] = redactValue(field)
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redactValue(item)
+		}
+	}
+	return value
+}
+
+func secretField(name string) bool {
+	name = strings.ToLower(name)
+	switch name {
+	case "key", "private_key", "secret_key", "access_key", "api_key":
+		return true
+	}
+	for _, secret := range []string{"token", "password", "secret"} {
+		if strings.Contains(name, secret) {
+			return true
+		}
+	}
+	return false
+}
+
+// peekRequestBody returns the start of the request body. When the body can't be read again, the
+// request is replaced by a copy whose body is sent in full
+func peekRequestBody(req *http.Request) (*http.Request, []byte) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return req, nil
+	}
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return req, nil
+		}
+		defer body.Close()
+		prefix, _ := io.ReadAll(io.LimitReader(body, maxTracedBody))
+		return req, prefix
+	}
+
+	prefix, _ := io.ReadAll(io.LimitReader(req.Body, maxTracedBody))
+	clone := req.Clone(req.Context())
+	clone.Body = readCloser{io.MultiReader(bytes.NewReader(prefix), req.Body), req.Body}
+	return clone, prefix
+}
+
+// peekResponseBody returns the start of the response body, leaving the body to be read in full
+func peekResponseBody(resp *http.Response) []byte {
+	if resp.Body == nil || resp.Body == http.NoBody {
+		return nil
+	}
+	prefix, _ := io.ReadAll(io.LimitReader(resp.Body, maxTracedBody))
+	resp.Body = readCloser{io.MultiReader(bytes.NewReader(prefix), resp.Body), resp.Body}
+	return prefix
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
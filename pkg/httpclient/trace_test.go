@@ -0,0 +1,116 @@
+package httpclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func tokenServer(t *testing.T) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"name":"ci","password":"hunter2"}`, string(body))
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"results":{"uuid":"1234","name":"ci","key":"azionsecrettoken"}}`))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func post(t *testing.T, transport http.RoundTripper, url string) string {
+	req, err := http.NewRequest("POST", url+"/iam/personal_tokens?page=1", strings.NewReader(`{"name":"ci","password":"hunter2"}`))
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "Token azionsavedtoken")
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := (&http.Client{Transport: transport}).Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return string(body)
+}
+
+func TestTrace(t *testing.T) {
+	t.Run("headers", func(t *testing.T) {
+		server := tokenServer(t)
+		out := &bytes.Buffer{}
+		body := post(t, &traceTransport{next: http.DefaultTransport, out: out}, server.URL)
+
+		assert.Contains(t, body, "azionsecrettoken")
+		assert.Contains(t, out.String(), "> POST "+server.URL+"/iam/personal_tokens?page=1\n")
+		assert.Contains(t, out.String(), "> Authorization: Token [REDACTED]\n")
+		assert.Contains(t, out.String(), "< 201 Created (")
+		assert.Contains(t, out.String(), "< Content-Type: application/json\n")
+		assert.NotContains(t, out.String(), "azionsavedtoken")
+		assert.NotContains(t, out.String(), `"name":"ci"`)
+	})
+
+	t.Run("bodies", func(t *testing.T) {
+		server := tokenServer(t)
+		out := &bytes.Buffer{}
+		body := post(t, &traceTransport{next: http.DefaultTransport, out: out, bodies: true}, server.URL)
+
+		assert.Contains(t, body, "azionsecrettoken")
+		assert.Contains(t, out.String(), `{"name":"ci","password":"[REDACTED]"}`)
+		assert.Contains(t, out.String(), `{"results":{"key":"[REDACTED]","name":"ci","uuid":"1234"}}`)
+		assert.NotContains(t, out.String(), "hunter2")
+		assert.NotContains(t, out.String(), "azionsecrettoken")
+	})
+
+	t.Run("HAR", func(t *testing.T) {
+		server := tokenServer(t)
+		har := NewHAR("Azion CLI", "1.0.0")
+		post(t, &traceTransport{next: http.DefaultTransport, har: har}, server.URL)
+
+		path := filepath.Join(t.TempDir(), "out.har")
+		require.NoError(t, har.WriteFile(path))
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.NotContains(t, string(data), "azionsavedtoken")
+		assert.NotContains(t, string(data), "azionsecrettoken")
+		assert.NotContains(t, string(data), "hunter2")
+
+		var log harLog
+		require.NoError(t, json.Unmarshal(data, &log))
+		assert.Equal(t, "1.2", log.Log.Version)
+		assert.Equal(t, harCreator{Name: "Azion CLI", Version: "1.0.0"}, log.Log.Creator)
+		require.Len(t, log.Log.Entries, 1)
+
+		entry := log.Log.Entries[0]
+		assert.Equal(t, "POST", entry.Request.Method)
+		assert.Equal(t, []harNameValue{{Name: "page", Value: "1"}}, entry.Request.QueryString)
+		assert.Contains(t, entry.Request.Headers, harNameValue{Name: "Authorization", Value: "Token [REDACTED]"})
+		assert.Equal(t, `{"name":"ci","password":"[REDACTED]"}`, entry.Request.PostData.Text)
+		assert.Equal(t, 201, entry.Response.Status)
+		assert.Equal(t, "application/json", entry.Response.Content.MimeType)
+		assert.Equal(t, `{"results":{"key":"[REDACTED]","name":"ci","uuid":"1234"}}`, entry.Response.Content.Text)
+	})
+}
+
+func TestRedactBody(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{name: "nested", body: `{"results":[{"token":"abc","private_key":"pem","id":1}]}`, want: `{"results":[{"id":1,"private_key":"[REDACTED]","token":"[REDACTED]"}]}`},
+		{name: "empty secrets", body: `{"password":"","client_secret":null}`, want: `{"client_secret":null,"password":""}`},
+		{name: "not JSON", body: `name=ci`, want: `name=ci`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, string(RedactBody([]byte(tt.body))))
+		})
+	}
+}
@@ -3,18 +3,48 @@ package iostreams
 import (
 	"io"
 	"os"
+
+	"golang.org/x/term"
 )
 
 type IOStreams struct {
 	In  io.ReadCloser
 	Out io.Writer
 	Err io.Writer
+
+	stdinTTY    bool
+	neverPrompt bool
 }
 
 func System() *IOStreams {
 	return &IOStreams{
-		In:  os.Stdin,
-		Out: os.Stdout,
-		Err: os.Stderr,
+		In:       os.Stdin,
+		Out:      os.Stdout,
+		Err:      os.Stderr,
+		stdinTTY: isTerminal(os.Stdin),
 	}
 }
+
+// IsStdinTTY tells whether the input is a terminal someone can answer prompts on
+func (s *IOStreams) IsStdinTTY() bool {
+	return s.stdinTTY
+}
+
+func (s *IOStreams) SetStdinTTY(isTTY bool) {
+	s.stdinTTY = isTTY
+}
+
+// SetNeverPrompt disables the prompts even when the input is a terminal
+func (s *IOStreams) SetNeverPrompt(never bool) {
+	s.neverPrompt = never
+}
+
+// CanPrompt tells whether the commands may ask for the values their flags weren't given
+func (s *IOStreams) CanPrompt() bool {
+	return s.stdinTTY && !s.neverPrompt
+}
+
+// isTerminal asks the terminal itself, as character devices such as /dev/null aren't terminals
+func isTerminal(f *os.File) bool {
+	return term.IsTerminal(int(f.Fd()))
+}
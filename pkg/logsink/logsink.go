@@ -0,0 +1,319 @@
+package logsink
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"time"
+
+	msg "github.com/aziontech/azion-cli/messages/logsink"
+	"github.com/aziontech/azion-cli/pkg/httpclient"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+const (
+	// DefaultBatchSize is the maximum number of events sent in a single HTTP request
+	DefaultBatchSize = 100
+	// DefaultAttempts is how many times a batch is sent to an HTTP endpoint before giving up
+	DefaultAttempts = 3
+	// DefaultMaxBackups is how many rotated files are kept next to the output file
+	DefaultMaxBackups = 5
+
+	megabyte = 1024 * 1024
+)
+
+// Sink persists or forwards events; each event is written as one JSON document
+type Sink interface {
+	Write(events []interface{}) error
+	Close() error
+}
+
+type Options struct {
+	OutputFile  string
+	MaxFileSize int64
+	ForwardUDP  string
+	ForwardHTTP string
+}
+
+// AddFlags registers the sink flags on commands that tail events
+func AddFlags(cmd *cobra.Command, opts *Options) {
+	cmd.Flags().StringVar(&opts.OutputFile, "output-file", "", msg.FlagOutputFile)
+	cmd.Flags().Int64Var(&opts.MaxFileSize, "max-file-size", 100, msg.FlagMaxFileSize)
+	cmd.Flags().StringVar(&opts.ForwardUDP, "forward-udp", "", msg.FlagForwardUDP)
+	cmd.Flags().StringVar(&opts.ForwardHTTP, "forward-http", "", msg.FlagForwardHTTP)
+}
+
+// New builds a sink writing to every destination set in opts; it returns nil when none is set
+func New(opts Options, client *http.Client) (Sink, error) {
+	var sinks multi
+
+	if opts.OutputFile != "" {
+		if opts.MaxFileSize <= 0 {
+			return nil, msg.ErrorInvalidSize
+		}
+		file, err := NewFile(opts.OutputFile, opts.MaxFileSize*megabyte, DefaultMaxBackups)
+		if err != nil {
+			return nil, err
+		}
+		sinks = append(sinks, file)
+	}
+
+	if opts.ForwardUDP != "" {
+		udp, err := NewUDP(opts.ForwardUDP)
+		if err != nil {
+			sinks.Close() // nolint:all
+			return nil, err
+		}
+		sinks = append(sinks, udp)
+	}
+
+	if opts.ForwardHTTP != "" {
+		webhook, err := NewHTTP(opts.ForwardHTTP, client)
+		if err != nil {
+			sinks.Close() // nolint:all
+			return nil, err
+		}
+		sinks = append(sinks, webhook)
+	}
+
+	if len(sinks) == 0 {
+		return nil, nil
+	}
+	return sinks, nil
+}
+
+type multi []Sink
+
+func (m multi) Write(events []interface{}) error {
+	var first error
+	for _, s := range m {
+		if err := s.Write(events); err != nil && first == nil {
+			first = err
+		}
+	}
+	return first
+}
+
+func (m multi) Close() error {
+	var first error
+	for _, s := range m {
+		if err := s.Close(); err != nil && first == nil {
+			first = err
+		}
+	}
+	return first
+}
+
+// File appends events as JSON lines and rotates the file once it grows past maxSize,
+// keeping up to maxBackups older files named <path>.1, <path>.2 and so on
+type File struct {
+	path       string
+	maxSize    int64
+	maxBackups int
+	file       *os.File
+	size       int64
+}
+
+func NewFile(path string, maxSize int64, maxBackups int) (*File, error) {
+	f := &File{path: path, maxSize: maxSize, maxBackups: maxBackups}
+	if err := f.open(); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func (f *File) open() error {
+	if err := os.MkdirAll(filepath.Dir(f.path), os.ModePerm); err != nil {
+		return fmt.Errorf(msg.ErrorOpenFile.Error(), f.path, err)
+	}
+
+	file, err := os.OpenFile(f.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return fmt.Errorf(msg.ErrorOpenFile.Error(), f.path, err)
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close() // nolint:all
+		return fmt.Errorf(msg.ErrorOpenFile.Error(), f.path, err)
+	}
+
+	f.file = file
+	f.size = info.Size()
+	return nil
+}
+
+func (f *File) rotate() error {
+	if err := f.file.Close(); err != nil {
+		return fmt.Errorf(msg.ErrorRotateFile.Error(), f.path, err)
+	}
+
+	for i := f.maxBackups - 1; i > 0; i-- {
+		older := fmt.Sprintf("%s.%d", f.path, i)
+		if _, err := os.Stat(older); err == nil {
+			if err := os.Rename(older, fmt.Sprintf("%s.%d", f.path, i+1)); err != nil {
+				return fmt.Errorf(msg.ErrorRotateFile.Error(), f.path, err)
+			}
+		}
+	}
+
+	if f.maxBackups > 0 {
+		if err := os.Rename(f.path, f.path+".1"); err != nil {
+			return fmt.Errorf(msg.ErrorRotateFile.Error(), f.path, err)
+		}
+	} else if err := os.Remove(f.path); err != nil {
+		return fmt.Errorf(msg.ErrorRotateFile.Error(), f.path, err)
+	}
+
+	return f.open()
+}
+
+func (f *File) Write(events []interface{}) error {
+	for _, event := range events {
+		line, err := json.Marshal(event)
+		if err != nil {
+			return fmt.Errorf(msg.ErrorWriteFile.Error(), err)
+		}
+		line = append(line, '\n')
+
+		if f.size > 0 && f.size+int64(len(line)) > f.maxSize {
+			if err := f.rotate(); err != nil {
+				return err
+			}
+		}
+
+		n, err := f.file.Write(line)
+		f.size += int64(n)
+		if err != nil {
+			return fmt.Errorf(msg.ErrorWriteFile.Error(), err)
+		}
+	}
+	return nil
+}
+
+func (f *File) Close() error {
+	return f.file.Close()
+}
+
+// UDP sends each event as a single datagram, the way syslog-style collectors expect
+type UDP struct {
+	conn net.Conn
+}
+
+func NewUDP(address string) (*UDP, error) {
+	conn, err := net.Dial("udp", address)
+	if err != nil {
+		return nil, fmt.Errorf(msg.ErrorDialUDP.Error(), address, err)
+	}
+	return &UDP{conn: conn}, nil
+}
+
+func (u *UDP) Write(events []interface{}) error {
+	for _, event := range events {
+		datagram, err := json.Marshal(event)
+		if err != nil {
+			return fmt.Errorf(msg.ErrorWriteUDP.Error(), err)
+		}
+		if _, err := u.conn.Write(datagram); err != nil {
+			return fmt.Errorf(msg.ErrorWriteUDP.Error(), err)
+		}
+	}
+	return nil
+}
+
+func (u *UDP) Close() error {
+	return u.conn.Close()
+}
+
+// HTTP posts events as JSON arrays of up to BatchSize items, retrying failed batches
+// with an exponential backoff
+type HTTP struct {
+	URL       string
+	Client    *http.Client
+	BatchSize int
+	Attempts  int
+	Backoff   time.Duration
+}
+
+func NewHTTP(endpoint string, client *http.Client) (*HTTP, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, msg.ErrorInvalidURL
+	}
+
+	// the batches are retried here rather than by the transport of the CLI, and the failures of
+	// the endpoint aren't the ones of the Azion API
+	return &HTTP{
+		URL:       endpoint,
+		Client:    httpclient.WithoutRetries(client),
+		BatchSize: DefaultBatchSize,
+		Attempts:  DefaultAttempts,
+		Backoff:   time.Second,
+	}, nil
+}
+
+func (h *HTTP) Write(events []interface{}) error {
+	for start := 0; start < len(events); start += h.BatchSize {
+		end := start + h.BatchSize
+		if end > len(events) {
+			end = len(events)
+		}
+		if err := h.send(events[start:end]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h *HTTP) send(batch []interface{}) error {
+	body, err := json.Marshal(batch)
+	if err != nil {
+		return err
+	}
+
+	var (
+		lastErr error
+		attempt int
+	)
+	wait := h.Backoff
+	for attempt = 1; attempt <= h.Attempts; attempt++ {
+		if attempt > 1 {
+			logger.Debug("Retrying to forward events", zap.Int("attempt", attempt), zap.Error(lastErr))
+			time.Sleep(wait)
+			wait *= 2
+		}
+
+		resp, err := h.Client.Post(h.URL, "application/json", bytes.NewReader(body))
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		resp.Body.Close() // nolint:all
+
+		if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+			return nil
+		}
+
+		lastErr = fmt.Errorf(msg.ErrorStatusHTTP.Error(), resp.StatusCode)
+		if resp.StatusCode < 500 && resp.StatusCode != http.StatusTooManyRequests {
+			// the endpoint rejected the batch itself; sending it again won't help
+			break
+		}
+	}
+
+	if attempt > h.Attempts {
+		attempt = h.Attempts
+	}
+	return fmt.Errorf(msg.ErrorForwardHTTP.Error(), h.URL, attempt, lastErr)
+}
+
+func (h *HTTP) Close() error {
+	return nil
+}
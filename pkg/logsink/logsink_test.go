@@ -0,0 +1,168 @@
+package logsink
+
+import (
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aziontech/azion-cli/pkg/httpclient"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+type event struct {
+	Host   string `json:"host"`
+	Status int    `json:"status"`
+}
+
+func events(n int) []interface{} {
+	evs := make([]interface{}, 0, n)
+	for i := 0; i < n; i++ {
+		evs = append(evs, event{Host: "example.com", Status: 200 + i})
+	}
+	return evs
+}
+
+func TestFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "logs", "events.log")
+
+	line, _ := json.Marshal(event{Host: "example.com", Status: 200})
+	lineSize := int64(len(line) + 1)
+
+	sink, err := NewFile(path, lineSize*2, 2)
+	require.NoError(t, err)
+
+	require.NoError(t, sink.Write(events(7)))
+	require.NoError(t, sink.Close())
+
+	current, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, 1, strings.Count(string(current), "\n"))
+	require.Contains(t, string(current), `"status":206`)
+
+	backup, err := os.ReadFile(path + ".1")
+	require.NoError(t, err)
+	require.Equal(t, 2, strings.Count(string(backup), "\n"))
+
+	_, err = os.Stat(path + ".2")
+	require.NoError(t, err)
+	_, err = os.Stat(path + ".3")
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestUDP(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer conn.Close()
+
+	sink, err := NewUDP(conn.LocalAddr().String())
+	require.NoError(t, err)
+	defer sink.Close()
+
+	require.NoError(t, sink.Write(events(2)))
+
+	buf := make([]byte, 1024)
+	for i := 0; i < 2; i++ {
+		require.NoError(t, conn.SetReadDeadline(time.Now().Add(2*time.Second)))
+		n, _, err := conn.ReadFrom(buf)
+		require.NoError(t, err)
+
+		var received event
+		require.NoError(t, json.Unmarshal(buf[:n], &received))
+		require.Equal(t, 200+i, received.Status)
+	}
+}
+
+func TestHTTP(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+
+	t.Run("batches and retries", func(t *testing.T) {
+		var calls, batches int32
+		var received int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// fail the very first request to exercise the retry
+			if atomic.AddInt32(&calls, 1) == 1 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			body, _ := io.ReadAll(r.Body)
+			var batch []event
+			require.NoError(t, json.Unmarshal(body, &batch))
+			atomic.AddInt32(&batches, 1)
+			atomic.AddInt32(&received, int32(len(batch)))
+		}))
+		defer server.Close()
+
+		sink, err := NewHTTP(server.URL, server.Client())
+		require.NoError(t, err)
+		sink.BatchSize = 2
+		sink.Backoff = time.Millisecond
+
+		require.NoError(t, sink.Write(events(5)))
+		require.Equal(t, int32(3), batches)
+		require.Equal(t, int32(5), received)
+		require.Equal(t, int32(4), calls)
+	})
+
+	t.Run("does not retry client errors", func(t *testing.T) {
+		var calls int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			w.WriteHeader(http.StatusBadRequest)
+		}))
+		defer server.Close()
+
+		sink, err := NewHTTP(server.URL, server.Client())
+		require.NoError(t, err)
+		sink.Backoff = time.Millisecond
+
+		err = sink.Write(events(1))
+		require.ErrorContains(t, err, "after 1 attempts")
+		require.Equal(t, int32(1), calls)
+	})
+
+	t.Run("retry once per attempt with the client of the CLI", func(t *testing.T) {
+		var calls int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+		}))
+		defer server.Close()
+
+		client := httpclient.New()
+		require.NoError(t, httpclient.Configure(client, httpclient.Options{MaxRetries: 3}))
+		sink, err := NewHTTP(server.URL, client)
+		require.NoError(t, err)
+		sink.Backoff = time.Millisecond
+
+		err = sink.Write(events(1))
+		require.ErrorContains(t, err, "after 3 attempts")
+		require.Equal(t, int32(3), calls)
+		require.Nil(t, httpclient.LastFailure())
+	})
+
+	t.Run("invalid url", func(t *testing.T) {
+		_, err := NewHTTP("collector:9000", http.DefaultClient)
+		require.Error(t, err)
+	})
+}
+
+func TestNew(t *testing.T) {
+	sink, err := New(Options{}, http.DefaultClient)
+	require.NoError(t, err)
+	require.Nil(t, sink)
+
+	_, err = New(Options{OutputFile: filepath.Join(t.TempDir(), "out.log")}, http.DefaultClient)
+	require.Error(t, err)
+}
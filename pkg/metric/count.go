@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"io"
 	"log"
+	"net/http"
 	"os"
 	"path/filepath"
 
@@ -24,7 +25,7 @@ type command struct {
 	Shell         string
 }
 
-func TotalCommandsCount(cmd *cobra.Command, commandName string, executionTime float64, errExec error) error {
+func TotalCommandsCount(c *http.Client, cmd *cobra.Command, commandName string, executionTime float64, errExec error) error {
 	if commandName == "" {
 		return nil
 	}
@@ -64,7 +65,7 @@ func TotalCommandsCount(cmd *cobra.Command, commandName string, executionTime fl
 		return err
 	}
 
-	tagName, err := github.GetVersionGitHub("vulcan")
+	tagName, err := github.GetVersionGitHub(c, "vulcan")
 	if err != nil {
 		return err
 	}
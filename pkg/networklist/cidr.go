@@ -0,0 +1,136 @@
+package networklist
+
+import (
+	"net/netip"
+	"sort"
+)
+
+// Prefix is an IP/CIDR item, masked to its network address
+type Prefix = netip.Prefix
+
+// ParsePrefix parses an IP address or CIDR block. Addresses become single host blocks, and
+// IPv4 addresses mapped into IPv6 are read as IPv4
+func ParsePrefix(value string) (Prefix, bool) {
+	if prefix, err := netip.ParsePrefix(value); err == nil {
+		addr := prefix.Addr()
+		bits := prefix.Bits()
+		if addr.Is4In6() && bits >= 96 {
+			addr = addr.Unmap()
+			bits -= 96
+		}
+		return netip.PrefixFrom(addr, bits).Masked(), true
+	}
+
+	addr, err := netip.ParseAddr(value)
+	if err != nil || addr.Zone() != "" {
+		return Prefix{}, false
+	}
+	addr = addr.Unmap()
+	return netip.PrefixFrom(addr, addr.BitLen()), true
+}
+
+// FormatPrefixes writes single host blocks as plain addresses, and the others in CIDR notation
+func FormatPrefixes(prefixes []Prefix) []string {
+	items := make([]string, 0, len(prefixes))
+	for _, prefix := range prefixes {
+		if prefix.IsSingleIP() {
+			items = append(items, prefix.Addr().String())
+			continue
+		}
+		items = append(items, prefix.String())
+	}
+	return items
+}
+
+// Aggregate returns the fewest CIDR blocks that cover exactly the same addresses as prefixes,
+// sorted with IPv4 blocks first
+func Aggregate(prefixes []Prefix) []Prefix {
+	sorted := append([]Prefix{}, prefixes...)
+	sort.Slice(sorted, func(i, j int) bool {
+		if c := sorted[i].Addr().Compare(sorted[j].Addr()); c != 0 {
+			return c < 0
+		}
+		return sorted[i].Bits() < sorted[j].Bits()
+	})
+
+	// sorted by address, a block is either inside the last one kept or after all of them
+	aggregated := make([]Prefix, 0, len(sorted))
+	for _, prefix := range sorted {
+		if n := len(aggregated); n > 0 && aggregated[n-1].Overlaps(prefix) {
+			continue
+		}
+		aggregated = append(aggregated, prefix)
+
+		// merge the halves of the same block for as long as they pair up
+		for len(aggregated) > 1 {
+			lower, upper := aggregated[len(aggregated)-2], aggregated[len(aggregated)-1]
+			parent, ok := siblings(lower, upper)
+			if !ok {
+				break
+			}
+			aggregated = append(aggregated[:len(aggregated)-2], parent)
+		}
+	}
+	return aggregated
+}
+
+// siblings reports whether lower and upper are the two halves of the same block, returning it
+func siblings(lower, upper Prefix) (Prefix, bool) {
+	bits := lower.Bits()
+	if bits == 0 || bits != upper.Bits() || lower.Addr().BitLen() != upper.Addr().BitLen() {
+		return Prefix{}, false
+	}
+	parent := netip.PrefixFrom(lower.Addr(), bits-1).Masked()
+	if parent.Addr() != lower.Addr() || !parent.Contains(upper.Addr()) {
+		return Prefix{}, false
+	}
+	return parent, true
+}
+
+// Exclude takes the removed blocks out of prefixes, splitting the blocks that contain one of them
+func Exclude(prefixes, removed []Prefix) []Prefix {
+	for _, r := range removed {
+		kept := make([]Prefix, 0, len(prefixes))
+		for _, prefix := range prefixes {
+			kept = append(kept, subtract(prefix, r)...)
+		}
+		prefixes = kept
+	}
+	return prefixes
+}
+
+// subtract returns the blocks that cover prefix without the addresses of removed
+func subtract(prefix, removed Prefix) []Prefix {
+	if !prefix.Overlaps(removed) {
+		return []Prefix{prefix}
+	}
+	if removed.Bits() <= prefix.Bits() {
+		return nil
+	}
+
+	// split the block in halves, keeping the one without removed whole
+	lower := netip.PrefixFrom(prefix.Addr(), prefix.Bits()+1)
+	upper := netip.PrefixFrom(lastAddr(lower).Next(), prefix.Bits()+1)
+	if lower.Contains(removed.Addr()) {
+		return append(subtract(lower, removed), upper)
+	}
+	return append([]Prefix{lower}, subtract(upper, removed)...)
+}
+
+func lastAddr(prefix Prefix) netip.Addr {
+	addr := prefix.Addr().AsSlice()
+	bits := prefix.Bits()
+	for i := range addr {
+		switch {
+		case bits >= 8:
+			bits -= 8
+		case bits > 0:
+			addr[i] |= 0xff >> bits
+			bits = 0
+		default:
+			addr[i] = 0xff
+		}
+	}
+	last, _ := netip.AddrFromSlice(addr)
+	return last
+}
@@ -0,0 +1,203 @@
+// Package networklist reads the items of Network Lists, validating, deduplicating and
+// aggregating them before they are uploaded
+package networklist
+
+import (
+	"bufio"
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// The types of items a Network List holds
+const (
+	TypeIPCIDR    = "ip_cidr"
+	TypeASN       = "asn"
+	TypeCountries = "countries"
+)
+
+var Types = []string{TypeIPCIDR, TypeASN, TypeCountries}
+
+// ValidType reports whether listType is one of the Network List types
+func ValidType(listType string) bool {
+	for _, t := range Types {
+		if t == listType {
+			return true
+		}
+	}
+	return false
+}
+
+// Entry is an item as it was read, before it is validated
+type Entry struct {
+	// Line is where the item was read from, or 0 when it was given in a flag
+	Line  int
+	Value string
+}
+
+// Result holds the items that will be uploaded and what was done to get to them
+type Result struct {
+	Items      []string
+	Read       int
+	Duplicates int
+	Merged     int
+	Invalid    []Entry
+}
+
+// Read splits the text of a list into entries. Items are separated by spaces, commas or new lines,
+// and anything after # or ; is a comment, as in most threat feeds
+func Read(r io.Reader) ([]Entry, error) {
+	var entries []Entry
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := scanner.Text()
+		if i := strings.IndexAny(text, "#;"); i >= 0 {
+			text = text[:i]
+		}
+		for _, value := range strings.FieldsFunc(text, isSeparator) {
+			entries = append(entries, Entry{Line: line, Value: value})
+		}
+	}
+	return entries, scanner.Err()
+}
+
+// FromValues turns the values of a flag into entries
+func FromValues(values []string) []Entry {
+	entries := make([]Entry, 0, len(values))
+	for _, value := range values {
+		for _, v := range strings.FieldsFunc(value, isSeparator) {
+			entries = append(entries, Entry{Value: v})
+		}
+	}
+	return entries
+}
+
+func isSeparator(r rune) bool {
+	return r == ',' || unicode.IsSpace(r)
+}
+
+// Clean validates the entries as items of listType and removes the duplicated ones. IP/CIDR items are
+// masked to their network address and, when aggregate is set, merged into the fewest CIDR blocks
+func Clean(listType string, entries []Entry, aggregate bool) *Result {
+	result := &Result{Read: len(entries)}
+
+	if listType == TypeIPCIDR {
+		var prefixes []Prefix
+		seen := make(map[Prefix]bool, len(entries))
+		for _, entry := range entries {
+			prefix, ok := ParsePrefix(entry.Value)
+			if !ok {
+				result.Invalid = append(result.Invalid, entry)
+				continue
+			}
+			if seen[prefix] {
+				result.Duplicates++
+				continue
+			}
+			seen[prefix] = true
+			prefixes = append(prefixes, prefix)
+		}
+
+		if aggregate {
+			aggregated := Aggregate(prefixes)
+			result.Merged = len(prefixes) - len(aggregated)
+			prefixes = aggregated
+		}
+		result.Items = FormatPrefixes(prefixes)
+		return result
+	}
+
+	seen := make(map[string]bool, len(entries))
+	for _, entry := range entries {
+		item, ok := normalize(listType, entry.Value)
+		if !ok {
+			result.Invalid = append(result.Invalid, entry)
+			continue
+		}
+		if seen[item] {
+			result.Duplicates++
+			continue
+		}
+		seen[item] = true
+		result.Items = append(result.Items, item)
+	}
+	return result
+}
+
+// Remove takes the entries out of the items of a list. IP/CIDR blocks that contain a removed
+// block are split around it, while the items the list holds that aren't blocks are kept as they are
+func Remove(listType string, items []string, entries []Entry) ([]string, []Entry) {
+	var invalid []Entry
+
+	if listType == TypeIPCIDR {
+		var prefixes, removed []Prefix
+		var unparsed []string
+		for _, item := range items {
+			prefix, ok := ParsePrefix(item)
+			if !ok {
+				unparsed = append(unparsed, item)
+				continue
+			}
+			prefixes = append(prefixes, prefix)
+		}
+		for _, entry := range entries {
+			prefix, ok := ParsePrefix(entry.Value)
+			if !ok {
+				invalid = append(invalid, entry)
+				continue
+			}
+			removed = append(removed, prefix)
+		}
+		return append(FormatPrefixes(Exclude(prefixes, removed)), unparsed...), invalid
+	}
+
+	removed := make(map[string]bool, len(entries))
+	for _, entry := range entries {
+		item, ok := normalize(listType, entry.Value)
+		if !ok {
+			invalid = append(invalid, entry)
+			continue
+		}
+		removed[item] = true
+	}
+
+	kept := []string{}
+	for _, item := range items {
+		if normalized, ok := normalize(listType, item); ok && removed[normalized] {
+			continue
+		}
+		kept = append(kept, item)
+	}
+	return kept, invalid
+}
+
+// normalize validates ASN and country items, returning them as the API stores them
+func normalize(listType, value string) (string, bool) {
+	switch listType {
+	case TypeASN:
+		if len(value) > 2 && strings.EqualFold(value[:2], "AS") {
+			value = value[2:]
+		}
+		asn, err := strconv.ParseUint(value, 10, 32)
+		if err != nil || asn == 0 {
+			return "", false
+		}
+		return strconv.FormatUint(asn, 10), true
+	case TypeCountries:
+		if len(value) != 2 {
+			return "", false
+		}
+		for _, r := range value {
+			if !unicode.IsLetter(r) || r > unicode.MaxASCII {
+				return "", false
+			}
+		}
+		return strings.ToUpper(value), true
+	}
+	return "", false
+}
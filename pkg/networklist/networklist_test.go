@@ -0,0 +1,124 @@
+package networklist
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRead(t *testing.T) {
+	feed := "# threat feed\n" +
+		"192.0.2.0/24 ; SBL123\n" +
+		"\n" +
+		"198.51.100.7, 198.51.100.8\n" +
+		"203.0.113.0/24\t# comment\n"
+
+	entries, err := Read(strings.NewReader(feed))
+	require.NoError(t, err)
+	assert.Equal(t, []Entry{
+		{Line: 2, Value: "192.0.2.0/24"},
+		{Line: 4, Value: "198.51.100.7"},
+		{Line: 4, Value: "198.51.100.8"},
+		{Line: 5, Value: "203.0.113.0/24"},
+	}, entries)
+}
+
+func TestClean(t *testing.T) {
+	t.Run("ip cidr items are deduplicated and aggregated", func(t *testing.T) {
+		entries := FromValues([]string{
+			"192.0.2.0/25", "192.0.2.128/25", "192.0.2.7",
+			"198.51.100.7", "198.51.100.7", "10.1.2.3/8", "not-an-ip", "2001:db8::1",
+		})
+
+		result := Clean(TypeIPCIDR, entries, true)
+		assert.Equal(t, []string{"10.0.0.0/8", "192.0.2.0/24", "198.51.100.7", "2001:db8::1"}, result.Items)
+		assert.Equal(t, 8, result.Read)
+		assert.Equal(t, 1, result.Duplicates)
+		assert.Equal(t, 2, result.Merged)
+		assert.Equal(t, []Entry{{Value: "not-an-ip"}}, result.Invalid)
+	})
+
+	t.Run("ip cidr items are kept when not aggregated", func(t *testing.T) {
+		entries := FromValues([]string{"192.0.2.128/25", "192.0.2.0/25", "192.0.2.128/25"})
+
+		result := Clean(TypeIPCIDR, entries, false)
+		assert.Equal(t, []string{"192.0.2.128/25", "192.0.2.0/25"}, result.Items)
+		assert.Equal(t, 1, result.Duplicates)
+		assert.Equal(t, 0, result.Merged)
+	})
+
+	t.Run("asn items", func(t *testing.T) {
+		entries := FromValues([]string{"AS16509", "16509", "as15169", "0", "AS-1", "4294967296"})
+
+		result := Clean(TypeASN, entries, true)
+		assert.Equal(t, []string{"16509", "15169"}, result.Items)
+		assert.Equal(t, 1, result.Duplicates)
+		assert.Len(t, result.Invalid, 3)
+	})
+
+	t.Run("country items", func(t *testing.T) {
+		entries := FromValues([]string{"br", "BR", "US", "USA", "1A"})
+
+		result := Clean(TypeCountries, entries, true)
+		assert.Equal(t, []string{"BR", "US"}, result.Items)
+		assert.Equal(t, 1, result.Duplicates)
+		assert.Len(t, result.Invalid, 2)
+	})
+}
+
+func TestAggregate(t *testing.T) {
+	tests := []struct {
+		name  string
+		items []string
+		want  []string
+	}{
+		{"siblings merge", []string{"192.0.2.0/25", "192.0.2.128/25"}, []string{"192.0.2.0/24"}},
+		{"merges cascade", []string{"192.0.2.0/26", "192.0.2.64/26", "192.0.2.128/25"}, []string{"192.0.2.0/24"}},
+		{"hosts merge", []string{"192.0.2.1", "192.0.2.0", "192.0.2.3", "192.0.2.2"}, []string{"192.0.2.0/30"}},
+		{"adjacent blocks of different parents stay", []string{"192.0.2.1", "192.0.2.2"}, []string{"192.0.2.1", "192.0.2.2"}},
+		{"contained blocks are dropped", []string{"192.0.2.7", "192.0.2.0/24", "192.0.2.0/28"}, []string{"192.0.2.0/24"}},
+		{"families stay apart", []string{"::/1", "128.0.0.0/1", "0.0.0.0/1", "8000::/1"}, []string{"0.0.0.0/0", "::/0"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, FormatPrefixes(Aggregate(prefixes(t, tt.items))))
+		})
+	}
+}
+
+func TestRemove(t *testing.T) {
+	t.Run("removing a host splits its block", func(t *testing.T) {
+		items, invalid := Remove(TypeIPCIDR, []string{"192.0.2.0/30", "198.51.100.7"}, FromValues([]string{"192.0.2.2"}))
+		assert.Empty(t, invalid)
+		assert.Equal(t, []string{"192.0.2.0/31", "192.0.2.3", "198.51.100.7"}, items)
+	})
+
+	t.Run("removing a block drops the blocks inside it", func(t *testing.T) {
+		items, _ := Remove(TypeIPCIDR, []string{"192.0.2.0/25", "192.0.2.200", "198.51.100.7"}, FromValues([]string{"192.0.2.0/24"}))
+		assert.Equal(t, []string{"198.51.100.7"}, items)
+	})
+
+	t.Run("items that aren't blocks are kept", func(t *testing.T) {
+		items, invalid := Remove(TypeIPCIDR, []string{"192.0.2.0/31", "2001:db8::/129", "not an ip"}, FromValues([]string{"192.0.2.1"}))
+		assert.Empty(t, invalid)
+		assert.Equal(t, []string{"192.0.2.0", "2001:db8::/129", "not an ip"}, items)
+	})
+
+	t.Run("removing asn items", func(t *testing.T) {
+		items, invalid := Remove(TypeASN, []string{"16509", "15169"}, FromValues([]string{"AS16509", "x"}))
+		assert.Equal(t, []string{"15169"}, items)
+		assert.Equal(t, []Entry{{Value: "x"}}, invalid)
+	})
+}
+
+func prefixes(t *testing.T, items []string) []Prefix {
+	parsed := make([]Prefix, 0, len(items))
+	for _, item := range items {
+		prefix, ok := ParsePrefix(item)
+		require.True(t, ok, item)
+		parsed = append(parsed, prefix)
+	}
+	return parsed
+}
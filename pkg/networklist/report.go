@@ -0,0 +1,56 @@
+package networklist
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	msg "github.com/aziontech/azion-cli/messages/network_list"
+	"github.com/aziontech/azion-cli/pkg/logger"
+)
+
+// ReadFile reads the entries of a list from the file at path, or from in when path is -
+func ReadFile(path string, in io.Reader) ([]Entry, error) {
+	if path != "-" {
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, fmt.Errorf(msg.ErrorReadItems.Error(), path, err)
+		}
+		defer file.Close()
+		in = file
+	}
+
+	entries, err := Read(in)
+	if err != nil {
+		return nil, fmt.Errorf(msg.ErrorReadItems.Error(), path, err)
+	}
+	return entries, nil
+}
+
+// Report tells the user how the items were cleaned. Invalid items fail the upload
+// unless skipInvalid is set, in which case each of them is warned about
+func Report(out io.Writer, result *Result, skipInvalid bool) error {
+	if len(result.Invalid) > 0 {
+		if !skipInvalid {
+			return fmt.Errorf(msg.ErrorInvalidItems.Error(), len(result.Invalid), result.Invalid[0])
+		}
+		for _, entry := range result.Invalid {
+			logger.LogWarning(out, fmt.Sprintf(msg.WarningInvalid, entry))
+		}
+	}
+
+	if len(result.Items) == 0 {
+		return msg.ErrorNoItems
+	}
+
+	logger.FInfo(out, fmt.Sprintf(msg.ItemsSummary, result.Read, result.Duplicates, result.Merged, len(result.Invalid), len(result.Items)))
+	return nil
+}
+
+// String describes the entry in messages, with the line it was read from
+func (e Entry) String() string {
+	if e.Line == 0 {
+		return fmt.Sprintf("%q", e.Value)
+	}
+	return fmt.Sprintf("%q on line %d", e.Value, e.Line)
+}
@@ -0,0 +1,408 @@
+package printer
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/template"
+	"time"
+
+	table "github.com/MaxwelMazur/tablecli"
+	msg "github.com/aziontech/azion-cli/messages/printer"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/fatih/color"
+	"github.com/tidwall/gjson"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	FormatTable    = "table"
+	FormatJSON     = "json"
+	FormatYAML     = "yaml"
+	FormatCSV      = "csv"
+	FormatTemplate = "template"
+
+	// truncateAt matches utils.TruncateString, which can't be imported here without a cycle
+	truncateAt = 30
+)
+
+// Options holds the output flags shared by the list and describe commands
+type Options struct {
+	Format    string
+	Columns   []string
+	NoHeaders bool
+	Template  string
+	Query     string
+	Filters   []string
+}
+
+// Column describes one field of an API result
+// Path is the gjson path of the value in the JSON of the result, and is also
+// the name users give to --columns. Columns flagged as Details are only part of
+// the default selection when --details is set.
+type Column struct {
+	Header   string
+	Path     string
+	Details  bool
+	Truncate bool
+	Format   func(value gjson.Result) string
+}
+
+// Validate checks the output flags before any request is made
+func (o *Options) Validate() error {
+	switch o.Format {
+	case "", FormatTable, FormatJSON, FormatYAML, FormatCSV:
+	case FormatTemplate:
+		if o.Template == "" {
+			return msg.ErrorMissingTemplate
+		}
+		if _, err := template.New("output").Parse(o.Template); err != nil {
+			return fmt.Errorf(msg.ErrorInvalidTemplate.Error(), err)
+		}
+	default:
+		return msg.ErrorInvalidFormat
+	}
+
+	if o.Query != "" && (o.Format == FormatCSV || o.Format == FormatTemplate || len(o.Columns) > 0) {
+		return msg.ErrorQueryFormat
+	}
+
+	_, err := parseFilters(o.Filters)
+	return err
+}
+
+// IsStructured reports whether the output is meant to be read by other programs
+func (o *Options) IsStructured() bool {
+	return o.Format == FormatJSON || o.Format == FormatYAML
+}
+
+// IsDefault reports whether the human readable table was asked for without a column selection;
+// commands with a richer view of a single result print their own in that case
+func (o *Options) IsDefault() bool {
+	return (o.Format == "" || o.Format == FormatTable) && len(o.Columns) == 0 && o.Query == ""
+}
+
+// PrintList prints a slice of API results in the chosen format
+func PrintList(w io.Writer, opts *Options, columns []Column, items interface{}, details bool) error {
+	selected, err := selectColumns(columns, opts.Columns, details)
+	if err != nil {
+		return err
+	}
+
+	raw, err := json.Marshal(items)
+	if err != nil {
+		return err
+	}
+	if string(raw) == "null" {
+		raw = []byte("[]")
+	}
+	results := gjson.ParseBytes(raw).Array()
+
+	if len(opts.Filters) > 0 {
+		predicates, err := parseFilters(opts.Filters)
+		if err != nil {
+			return err
+		}
+		results, raw = filterResults(results, predicates)
+	}
+
+	if opts.Query != "" {
+		return printQuery(w, opts, gjson.GetBytes(raw, opts.Query))
+	}
+
+	switch opts.Format {
+	case FormatJSON, FormatYAML:
+		return printStructured(w, opts, selected, raw, results, true)
+	case FormatCSV:
+		return printCSV(w, opts, selected, results)
+	case FormatTemplate:
+		return printTemplate(w, opts, results)
+	default:
+		printTable(w, opts, selected, results)
+		return nil
+	}
+}
+
+// PrintItem prints a single API result; in the table format each column is a line
+func PrintItem(w io.Writer, opts *Options, columns []Column, item interface{}) error {
+	selected, err := selectColumns(columns, opts.Columns, true)
+	if err != nil {
+		return err
+	}
+
+	raw, err := json.Marshal(item)
+	if err != nil {
+		return err
+	}
+	result := gjson.ParseBytes(raw)
+
+	if opts.Query != "" {
+		return printQuery(w, opts, result.Get(opts.Query))
+	}
+
+	switch opts.Format {
+	case FormatJSON, FormatYAML:
+		return printStructured(w, opts, selected, raw, []gjson.Result{result}, false)
+	case FormatCSV:
+		return printCSV(w, opts, selected, []gjson.Result{result})
+	case FormatTemplate:
+		return printTemplate(w, opts, []gjson.Result{result})
+	default:
+		// a single result never continues an earlier table, so its widths start from scratch
+		table.WidthPersist = nil
+		tbl := table.New("", "")
+		tbl.WithFirstColumnFormatter(color.New(color.FgGreen).SprintfFunc())
+		for _, c := range selected {
+			tbl.AddRow(c.Header+": ", c.value(result, false))
+		}
+		_, err := w.Write(tbl.GetByteFormat())
+		return err
+	}
+}
+
+// Marshal encodes a single API result in the given structured format; used when the result is written to a file
+func Marshal(format string, item interface{}) ([]byte, error) {
+	if format != FormatYAML {
+		return json.MarshalIndent(item, "", " ")
+	}
+
+	raw, err := json.Marshal(item)
+	if err != nil {
+		return nil, err
+	}
+	return toYAML(raw)
+}
+
+// FormatTime returns a Column.Format that prints RFC 3339 timestamps with the given layout
+func FormatTime(layout string) func(value gjson.Result) string {
+	return func(value gjson.Result) string {
+		if value.Type != gjson.String {
+			return ""
+		}
+		t, err := time.Parse(time.RFC3339Nano, value.String())
+		if err != nil {
+			return value.String()
+		}
+		return t.Format(layout)
+	}
+}
+
+// Join is a Column.Format that prints the items of an array separated by commas
+func Join(value gjson.Result) string {
+	items := value.Array()
+	list := make([]string, 0, len(items))
+	for _, item := range items {
+		list = append(list, item.String())
+	}
+	return strings.Join(list, ", ")
+}
+
+func selectColumns(columns []Column, names []string, details bool) ([]Column, error) {
+	if len(names) == 0 {
+		selected := make([]Column, 0, len(columns))
+		for _, c := range columns {
+			if !c.Details || details {
+				selected = append(selected, c)
+			}
+		}
+		return selected, nil
+	}
+
+	selected := make([]Column, 0, len(names))
+	for _, name := range names {
+		c, ok := findColumn(columns, name)
+		if !ok {
+			available := make([]string, 0, len(columns))
+			for _, c := range columns {
+				available = append(available, c.Path)
+			}
+			return nil, fmt.Errorf(msg.ErrorUnknownColumn.Error(), name, strings.Join(available, ", "))
+		}
+		selected = append(selected, c)
+	}
+	return selected, nil
+}
+
+// findColumn matches a name given to --columns against the path or the header of a column
+func findColumn(columns []Column, name string) (Column, bool) {
+	normalized := strings.ReplaceAll(strings.ToLower(strings.TrimSpace(name)), " ", "_")
+	for _, c := range columns {
+		if strings.ToLower(c.Path) == normalized || strings.ReplaceAll(strings.ToLower(c.Header), " ", "_") == normalized {
+			return c, true
+		}
+	}
+	return Column{}, false
+}
+
+func (c Column) value(result gjson.Result, truncate bool) string {
+	value := result.Get(c.Path)
+	var formatted string
+	if c.Format != nil {
+		formatted = c.Format(value)
+	} else if value.Exists() && value.Type != gjson.Null {
+		formatted = value.String()
+	}
+
+	if truncate && c.Truncate && len(formatted) > truncateAt {
+		return formatted[:truncateAt] + "..."
+	}
+	return formatted
+}
+
+func printTable(w io.Writer, opts *Options, columns []Column, results []gjson.Result) {
+	headers := make([]interface{}, 0, len(columns))
+	for _, c := range columns {
+		headers = append(headers, c.Header)
+	}
+
+	tbl := table.New(headers...)
+	tbl.WithWriter(w)
+	tbl.WithHeaderFormatter(color.New(color.FgBlue, color.Underline).SprintfFunc())
+	tbl.WithFirstColumnFormatter(color.New(color.FgGreen).SprintfFunc())
+
+	for _, result := range results {
+		row := make([]interface{}, 0, len(columns))
+		for _, c := range columns {
+			row = append(row, c.value(result, true))
+		}
+		tbl.AddRow(row...)
+	}
+
+	// tablecli keeps the widths of the previous table to align paginated output;
+	// they can't be reused for a table with a different number of columns
+	if len(table.WidthPersist) != len(columns) {
+		table.WidthPersist = nil
+	}
+	format := strings.Repeat("%s", len(tbl.GetHeader())) + "\n"
+	tbl.CalculateWidths([]string{})
+	if !opts.NoHeaders {
+		logger.PrintHeader(tbl, format)
+	}
+	for _, row := range tbl.GetRows() {
+		logger.PrintRow(tbl, format, row)
+	}
+}
+
+func printCSV(w io.Writer, opts *Options, columns []Column, results []gjson.Result) error {
+	writer := csv.NewWriter(w)
+	if !opts.NoHeaders {
+		headers := make([]string, 0, len(columns))
+		for _, c := range columns {
+			headers = append(headers, c.Path)
+		}
+		if err := writer.Write(headers); err != nil {
+			return err
+		}
+	}
+
+	for _, result := range results {
+		row := make([]string, 0, len(columns))
+		for _, c := range columns {
+			row = append(row, c.value(result, false))
+		}
+		if err := writer.Write(row); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// printStructured writes the JSON or YAML document of the results as the API returned them;
+// when --columns is given, each result is reduced to the selected paths
+func printStructured(w io.Writer, opts *Options, columns []Column, raw []byte, results []gjson.Result, list bool) error {
+	if len(opts.Columns) > 0 {
+		projected := make([]map[string]interface{}, 0, len(results))
+		for _, result := range results {
+			item := make(map[string]interface{}, len(columns))
+			for _, c := range columns {
+				item[c.Path] = result.Get(c.Path).Value()
+			}
+			projected = append(projected, item)
+		}
+
+		var err error
+		if list {
+			raw, err = json.Marshal(projected)
+		} else {
+			raw, err = json.Marshal(projected[0])
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	var out []byte
+	if opts.Format == FormatYAML {
+		converted, err := toYAML(raw)
+		if err != nil {
+			return err
+		}
+		out = converted
+	} else {
+		var indented bytes.Buffer
+		if err := json.Indent(&indented, raw, "", " "); err != nil {
+			return err
+		}
+		indented.WriteByte('\n')
+		out = indented.Bytes()
+	}
+
+	_, err := w.Write(out)
+	return err
+}
+
+// printTemplate executes the Go template once per result, against its JSON fields
+func printTemplate(w io.Writer, opts *Options, results []gjson.Result) error {
+	tmpl, err := template.New("output").Parse(opts.Template)
+	if err != nil {
+		return fmt.Errorf(msg.ErrorInvalidTemplate.Error(), err)
+	}
+
+	for _, result := range results {
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, result.Value()); err != nil {
+			return fmt.Errorf(msg.ErrorInvalidTemplate.Error(), err)
+		}
+		if !bytes.HasSuffix(buf.Bytes(), []byte("\n")) {
+			buf.WriteByte('\n')
+		}
+		if _, err := w.Write(buf.Bytes()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func toYAML(raw []byte) ([]byte, error) {
+	return toYAMLValue(gjson.ParseBytes(raw))
+}
+
+// toYAMLValue converts JSON to YAML keeping the order of the object keys
+func toYAMLValue(result gjson.Result) ([]byte, error) {
+	return yaml.Marshal(yamlValue(result))
+}
+
+func yamlValue(result gjson.Result) interface{} {
+	switch {
+	case result.IsObject():
+		var object yaml.MapSlice
+		result.ForEach(func(key, value gjson.Result) bool {
+			object = append(object, yaml.MapItem{Key: key.String(), Value: yamlValue(value)})
+			return true
+		})
+		return object
+	case result.IsArray():
+		list := []interface{}{}
+		for _, item := range result.Array() {
+			list = append(list, yamlValue(item))
+		}
+		return list
+	default:
+		return result.Value()
+	}
+}
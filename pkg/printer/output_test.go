@@ -0,0 +1,138 @@
+package printer
+
+import (
+	"bytes"
+	"testing"
+
+	msg "github.com/aziontech/azion-cli/messages/printer"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+type resource struct {
+	ID     int64    `json:"id"`
+	Name   string   `json:"name"`
+	Active bool     `json:"active"`
+	Tags   []string `json:"tags"`
+}
+
+var (
+	resourceColumns = []Column{
+		{Header: "ID", Path: "id"},
+		{Header: "NAME", Path: "name"},
+		{Header: "ACTIVE", Path: "active", Details: true},
+		{Header: "TAGS", Path: "tags", Details: true, Format: Join},
+	}
+
+	resources = []resource{
+		{ID: 1, Name: "first", Active: true, Tags: []string{"a", "b"}},
+		{ID: 2, Name: "second"},
+	}
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name string
+		opts Options
+		err  error
+	}{
+		{name: "default", opts: Options{}},
+		{name: "yaml", opts: Options{Format: FormatYAML}},
+		{name: "unknown format", opts: Options{Format: "xml"}, err: msg.ErrorInvalidFormat},
+		{name: "template without template", opts: Options{Format: FormatTemplate}, err: msg.ErrorMissingTemplate},
+		{name: "template", opts: Options{Format: FormatTemplate, Template: "{{.id}}"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.opts.Validate()
+			if tt.err == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, tt.err)
+		})
+	}
+
+	t.Run("invalid template", func(t *testing.T) {
+		opts := Options{Format: FormatTemplate, Template: "{{.id"}
+		require.Error(t, opts.Validate())
+	})
+}
+
+func TestPrintList(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+	t.Run("table", func(t *testing.T) {
+		out := bytes.NewBuffer(nil)
+		err := PrintList(out, &Options{}, resourceColumns, resources, false)
+		require.NoError(t, err)
+		assert.Equal(t, "ID  NAME    \n1   first   \n2   second  \n", out.String())
+	})
+
+	t.Run("table with details and no headers", func(t *testing.T) {
+		out := bytes.NewBuffer(nil)
+		err := PrintList(out, &Options{NoHeaders: true}, resourceColumns, resources, true)
+		require.NoError(t, err)
+		assert.Equal(t, "1   first   true    a, b  \n2   second  false         \n", out.String())
+	})
+
+	t.Run("selected columns", func(t *testing.T) {
+		out := bytes.NewBuffer(nil)
+		err := PrintList(out, &Options{Format: FormatCSV, Columns: []string{"name", "Active"}}, resourceColumns, resources, false)
+		require.NoError(t, err)
+		assert.Equal(t, "name,active\nfirst,true\nsecond,false\n", out.String())
+	})
+
+	t.Run("unknown column", func(t *testing.T) {
+		out := bytes.NewBuffer(nil)
+		err := PrintList(out, &Options{Columns: []string{"color"}}, resourceColumns, resources, false)
+		require.EqualError(t, err, "Unknown column 'color'. The available columns are: id, name, active, tags")
+	})
+
+	t.Run("json keeps every field", func(t *testing.T) {
+		out := bytes.NewBuffer(nil)
+		err := PrintList(out, &Options{Format: FormatJSON}, resourceColumns, resources[1:], false)
+		require.NoError(t, err)
+		assert.Equal(t, "[\n {\n  \"id\": 2,\n  \"name\": \"second\",\n  \"active\": false,\n  \"tags\": null\n }\n]\n", out.String())
+	})
+
+	t.Run("empty json list", func(t *testing.T) {
+		out := bytes.NewBuffer(nil)
+		err := PrintList(out, &Options{Format: FormatJSON}, resourceColumns, []resource(nil), false)
+		require.NoError(t, err)
+		assert.Equal(t, "[]\n", out.String())
+	})
+
+	t.Run("yaml with columns", func(t *testing.T) {
+		out := bytes.NewBuffer(nil)
+		err := PrintList(out, &Options{Format: FormatYAML, Columns: []string{"id"}}, resourceColumns, resources, false)
+		require.NoError(t, err)
+		assert.Equal(t, "- id: 1\n- id: 2\n", out.String())
+	})
+
+	t.Run("template", func(t *testing.T) {
+		out := bytes.NewBuffer(nil)
+		err := PrintList(out, &Options{Format: FormatTemplate, Template: "{{.id}}={{.name}}"}, resourceColumns, resources, false)
+		require.NoError(t, err)
+		assert.Equal(t, "1=first\n2=second\n", out.String())
+	})
+}
+
+func TestPrintItem(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+	t.Run("table", func(t *testing.T) {
+		out := bytes.NewBuffer(nil)
+		err := PrintItem(out, &Options{Columns: []string{"id", "tags"}}, resourceColumns, resources[0])
+		require.NoError(t, err)
+		assert.Equal(t, "ID:     1     \nTAGS:   a, b  \n", out.String())
+	})
+
+	t.Run("yaml", func(t *testing.T) {
+		out := bytes.NewBuffer(nil)
+		err := PrintItem(out, &Options{Format: FormatYAML}, resourceColumns, resources[0])
+		require.NoError(t, err)
+		assert.Equal(t, "id: 1\nname: first\nactive: true\ntags:\n- a\n- b\n", out.String())
+	})
+}
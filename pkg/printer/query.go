@@ -0,0 +1,187 @@
+package printer
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	msg "github.com/aziontech/azion-cli/messages/printer"
+	"github.com/tidwall/gjson"
+)
+
+// predicate is one --filter expression. The supported forms are
+// path=value, path!=value, path~regex and path!~regex; a value without
+// an operator matches results whose name contains it
+type predicate struct {
+	path   string
+	negate bool
+	value  string
+	regex  *regexp.Regexp
+}
+
+// the operator found first in the expression wins, so values may contain the other ones
+var operators = []string{"!=", "!~", "=", "~"}
+
+// NameFilter returns the first filter without an operator. The lists whose API filters by name
+// send it along, so that the filter isn't limited to the page fetched
+func NameFilter(filters []string) string {
+	for _, filter := range filters {
+		if !strings.ContainsAny(filter, "=~") {
+			return filter
+		}
+	}
+	return ""
+}
+
+func parseFilters(filters []string) ([]predicate, error) {
+	predicates := make([]predicate, 0, len(filters))
+	for _, filter := range filters {
+		p, err := parseFilter(filter)
+		if err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, p)
+	}
+	return predicates, nil
+}
+
+func parseFilter(filter string) (predicate, error) {
+	index, operator := -1, ""
+	for _, op := range operators {
+		if i := strings.Index(filter, op); i >= 0 && (index < 0 || i < index) {
+			index, operator = i, op
+		}
+	}
+
+	if index < 0 {
+		return predicate{path: "name", regex: regexp.MustCompile("(?i)" + regexp.QuoteMeta(filter))}, nil
+	}
+
+	path := strings.TrimSpace(filter[:index])
+	if path == "" {
+		return predicate{}, fmt.Errorf(msg.ErrorInvalidFilter.Error(), filter)
+	}
+
+	p := predicate{
+		path:   path,
+		negate: strings.HasPrefix(operator, "!"),
+		value:  filter[index+len(operator):],
+	}
+
+	if strings.HasSuffix(operator, "~") {
+		regex, err := regexp.Compile(p.value)
+		if err != nil {
+			return predicate{}, fmt.Errorf(msg.ErrorInvalidRegex.Error(), p.value, err)
+		}
+		p.regex = regex
+	}
+	return p, nil
+}
+
+func (p predicate) match(result gjson.Result) bool {
+	value := result.Get(p.path)
+
+	var matched bool
+	switch {
+	case p.regex != nil:
+		matched = value.Exists() && p.regex.MatchString(value.String())
+	case !value.Exists() || value.Type == gjson.Null:
+		matched = p.value == "" || p.value == "null"
+	default:
+		matched = value.String() == p.value
+	}
+
+	if p.negate {
+		return !matched
+	}
+	return matched
+}
+
+// filterResults keeps the results matching every predicate and returns them with their JSON array
+func filterResults(results []gjson.Result, predicates []predicate) ([]gjson.Result, []byte) {
+	kept := make([]gjson.Result, 0, len(results))
+	var raw bytes.Buffer
+	raw.WriteByte('[')
+	for _, result := range results {
+		matched := true
+		for _, p := range predicates {
+			if !p.match(result) {
+				matched = false
+				break
+			}
+		}
+		if !matched {
+			continue
+		}
+
+		if len(kept) > 0 {
+			raw.WriteByte(',')
+		}
+		raw.WriteString(result.Raw)
+		kept = append(kept, result)
+	}
+	raw.WriteByte(']')
+	return kept, raw.Bytes()
+}
+
+// printQuery prints the value a --query path selected. Strings and numbers are printed as they
+// are, one per line when the query returns a list of them, so they can be piped to other programs;
+// anything else is printed as JSON, or YAML when --format yaml is given
+func printQuery(w io.Writer, opts *Options, result gjson.Result) error {
+	if opts.Format == FormatYAML {
+		out, err := toYAMLValue(result)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(out)
+		return err
+	}
+
+	if opts.Format != FormatJSON {
+		if lines, ok := scalars(result); ok {
+			for _, line := range lines {
+				if _, err := fmt.Fprintln(w, line); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}
+
+	if !result.Exists() {
+		_, err := fmt.Fprintln(w, "null")
+		return err
+	}
+
+	var indented bytes.Buffer
+	if err := json.Indent(&indented, []byte(result.Raw), "", " "); err != nil {
+		return err
+	}
+	indented.WriteByte('\n')
+	_, err := w.Write(indented.Bytes())
+	return err
+}
+
+func scalars(result gjson.Result) ([]string, bool) {
+	if !result.Exists() {
+		return nil, true
+	}
+	if !result.IsArray() && !result.IsObject() {
+		return []string{result.String()}, true
+	}
+	if result.IsObject() {
+		return nil, false
+	}
+
+	var lines []string
+	for _, item := range result.Array() {
+		if item.IsArray() || item.IsObject() {
+			return nil, false
+		}
+		lines = append(lines, item.String())
+	}
+	return lines, true
+}
@@ -0,0 +1,99 @@
+package printer
+
+import (
+	"bytes"
+	"testing"
+
+	table "github.com/MaxwelMazur/tablecli"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestFilter(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+
+	tests := []struct {
+		name    string
+		filters []string
+		want    string
+	}{
+		{name: "equality", filters: []string{"active=true"}, want: "1\n"},
+		{name: "inequality", filters: []string{"active!=true"}, want: "2\n"},
+		{name: "regex", filters: []string{"name~^sec"}, want: "2\n"},
+		{name: "negated regex", filters: []string{"name!~^sec"}, want: "1\n"},
+		{name: "name contains", filters: []string{"IRS"}, want: "1\n"},
+		{name: "every filter must match", filters: []string{"active=true", "name~second"}, want: ""},
+		{name: "missing field", filters: []string{"color="}, want: "1\n2\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := bytes.NewBuffer(nil)
+			opts := &Options{Filters: tt.filters, Query: "#.id"}
+			require.NoError(t, opts.Validate())
+			require.NoError(t, PrintList(out, opts, resourceColumns, resources, false))
+			assert.Equal(t, tt.want, out.String())
+		})
+	}
+
+	t.Run("invalid regex", func(t *testing.T) {
+		opts := &Options{Filters: []string{"name~("}}
+		require.Error(t, opts.Validate())
+	})
+
+	t.Run("missing field name", func(t *testing.T) {
+		opts := &Options{Filters: []string{"=value"}}
+		require.EqualError(t, opts.Validate(), "The filter '=value' is invalid. Use 'field=value', 'field!=value', 'field~regex' or 'field!~regex' and try again")
+	})
+
+	t.Run("table keeps the filtered rows", func(t *testing.T) {
+		table.WidthPersist = nil
+		out := bytes.NewBuffer(nil)
+		err := PrintList(out, &Options{Filters: []string{"id=2"}, NoHeaders: true}, resourceColumns, resources, false)
+		require.NoError(t, err)
+		assert.Equal(t, "2   second  \n", out.String())
+	})
+}
+
+func TestNameFilter(t *testing.T) {
+	assert.Equal(t, "web", NameFilter([]string{"active=true", "web", "api"}))
+	assert.Equal(t, "", NameFilter([]string{"name~^web", "id!=3"}))
+	assert.Equal(t, "", NameFilter(nil))
+}
+
+func TestQuery(t *testing.T) {
+	t.Run("scalar", func(t *testing.T) {
+		out := bytes.NewBuffer(nil)
+		err := PrintItem(out, &Options{Query: "name"}, resourceColumns, resources[0])
+		require.NoError(t, err)
+		assert.Equal(t, "first\n", out.String())
+	})
+
+	t.Run("object as json", func(t *testing.T) {
+		out := bytes.NewBuffer(nil)
+		err := PrintList(out, &Options{Query: "#(id==2)"}, resourceColumns, resources, false)
+		require.NoError(t, err)
+		assert.Equal(t, "{\n \"id\": 2,\n \"name\": \"second\",\n \"active\": false,\n \"tags\": null\n}\n", out.String())
+	})
+
+	t.Run("list as json", func(t *testing.T) {
+		out := bytes.NewBuffer(nil)
+		err := PrintList(out, &Options{Query: "#.name", Format: FormatJSON}, resourceColumns, resources, false)
+		require.NoError(t, err)
+		assert.Equal(t, "[\n \"first\",\n \"second\"\n]\n", out.String())
+	})
+
+	t.Run("yaml", func(t *testing.T) {
+		out := bytes.NewBuffer(nil)
+		err := PrintItem(out, &Options{Query: "tags", Format: FormatYAML}, resourceColumns, resources[0])
+		require.NoError(t, err)
+		assert.Equal(t, "- a\n- b\n", out.String())
+	})
+
+	t.Run("not combined with csv", func(t *testing.T) {
+		opts := &Options{Query: "#.id", Format: FormatCSV}
+		require.Error(t, opts.Validate())
+	})
+}
@@ -0,0 +1,130 @@
+package token
+
+import (
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/aziontech/azion-cli/messages/root"
+	"github.com/aziontech/azion-cli/pkg/credential"
+	"github.com/aziontech/azion-cli/pkg/logger"
+)
+
+// helper is the credential helper the settings keep their tokens with
+func (s Settings) helper() credential.Helper {
+	return credential.New(s.CredentialHelper)
+}
+
+// HelperName names the credential helper the settings keep their tokens with
+func (s Settings) HelperName() string {
+	if s.CredentialHelper == "" {
+		return credential.FileHelper
+	}
+	return s.CredentialHelper
+}
+
+// tokens returns the tokens written in the settings file, by profile
+func (s Settings) tokens() map[string]string {
+	tokens := map[string]string{}
+	if s.Token != "" {
+		tokens[DefaultProfile] = s.Token
+	}
+	for name, profile := range s.Profiles {
+		if profile.Token != "" {
+			tokens[name] = profile.Token
+		}
+	}
+	return tokens
+}
+
+// withoutTokens returns the settings as written to the file, once their tokens are kept by the helper
+func (s Settings) withoutTokens() Settings {
+	s.Token = ""
+	profiles := make(map[string]Profile, len(s.Profiles))
+	for name, profile := range s.Profiles {
+		profile.Token = ""
+		profiles[name] = profile
+	}
+	if s.Profiles != nil {
+		s.Profiles = profiles
+	}
+	return s
+}
+
+// migrateTokens hands the tokens that settings files written by older versions keep in plain text
+// to the credential helper. Tokens the helper fails to keep stay in the file, to be tried again
+// on the next write
+func (s *Settings) migrateTokens() bool {
+	tokens := s.tokens()
+	if len(tokens) == 0 {
+		return false
+	}
+
+	helper := s.helper()
+	for name, token := range tokens {
+		if err := helper.Store(name, token); err != nil {
+			logger.Debug("Error while migrating the personal token to the credential helper", zap.String("profile", name), zap.Error(err))
+			return false
+		}
+	}
+	*s = s.withoutTokens()
+	return true
+}
+
+// loadToken gets the token of the profile in use from the credential helper
+func (s *Settings) loadToken() error {
+	if s.Token == "" {
+		token, err := s.helper().Get(s.ActiveProfile())
+		if err != nil {
+			return fmt.Errorf(root.ErrorCredentialHelper.Error(), "read", s.HelperName(), err)
+		}
+		s.Token = token
+	}
+	s.loaded = s.Token
+	return nil
+}
+
+// storeTokens hands the tokens of the settings to the credential helper. The token of the profile
+// in use is only handed over when it changed since it was read
+func (s Settings) storeTokens() (Settings, error) {
+	stored := s.stored()
+	active := s.ActiveProfile()
+	helper := s.helper()
+
+	tokens := stored.tokens()
+	if _, ok := tokens[active]; !ok && s.loaded != "" {
+		if err := helper.Erase(active); err != nil {
+			return Settings{}, fmt.Errorf(root.ErrorCredentialHelper.Error(), "erase", s.HelperName(), err)
+		}
+	}
+	for name, token := range tokens {
+		if name == active && token == s.loaded {
+			continue
+		}
+		if err := helper.Store(name, token); err != nil {
+			return Settings{}, fmt.Errorf(root.ErrorCredentialHelper.Error(), "store", s.HelperName(), err)
+		}
+	}
+	return stored.withoutTokens(), nil
+}
+
+// EraseToken removes the token of the given profile from the credential helper
+func (s Settings) EraseToken(profile string) error {
+	if err := s.helper().Erase(profile); err != nil {
+		return fmt.Errorf(root.ErrorCredentialHelper.Error(), "erase", s.HelperName(), err)
+	}
+	return nil
+}
+
+// ProfileToken returns the token of the given profile. Only the token of the profile in use is
+// read with the settings, the other ones are asked to the credential helper
+func (s Settings) ProfileToken(profile string) (string, error) {
+	if profile == s.ActiveProfile() {
+		return s.Token, nil
+	}
+	token, err := s.helper().Get(profile)
+	if err != nil {
+		return "", fmt.Errorf(root.ErrorCredentialHelper.Error(), "read", s.HelperName(), err)
+	}
+	return token, nil
+}
@@ -0,0 +1,64 @@
+package token
+
+import (
+	"os"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/aziontech/azion-cli/pkg/credential"
+	"github.com/aziontech/azion-cli/pkg/logger"
+)
+
+func TestCredentials(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+
+	t.Run("migrate plain text tokens", func(t *testing.T) {
+		path := useSettingsFile(t, profilesFile)
+
+		settings, err := ReadSettings()
+		require.NoError(t, err)
+		assert.Equal(t, "stagingtoken", settings.Token)
+
+		content, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.NotContains(t, string(content), "defaulttoken")
+		assert.NotContains(t, string(content), "stagingtoken")
+		assert.Contains(t, string(content), "stage@example.com")
+
+		token, err := credential.New("").Get(DefaultProfile)
+		require.NoError(t, err)
+		assert.Equal(t, "defaulttoken", token)
+
+		if runtime.GOOS != "windows" {
+			info, err := os.Stat(path)
+			require.NoError(t, err)
+			assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+		}
+	})
+
+	t.Run("erase the token of the profile in use", func(t *testing.T) {
+		useSettingsFile(t, profilesFile)
+
+		settings, err := ReadSettings()
+		require.NoError(t, err)
+		settings.Token = ""
+		require.NoError(t, WriteSettings(settings))
+
+		settings, err = ReadSettings()
+		require.NoError(t, err)
+		assert.Empty(t, settings.Token)
+		assert.Equal(t, "staging", settings.ActiveProfile())
+	})
+
+	t.Run("failing helper", func(t *testing.T) {
+		useSettingsFile(t, "CredentialHelper = 'missing'\n")
+
+		_, err := ReadSettings()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Failed to read the personal token with the missing credential helper")
+	})
+}
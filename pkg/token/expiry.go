@@ -0,0 +1,45 @@
+package token
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"time"
+
+	"github.com/aziontech/azion-cli/messages/root"
+	"github.com/aziontech/azion-cli/pkg/logger"
+)
+
+// ExpiryWarning is how long before the saved token expires the CLI starts warning about it
+const ExpiryWarning = 7 * 24 * time.Hour
+
+// ExpiresIn describes how long a token has left before it expires, as in "in 3 days"
+func ExpiresIn(left time.Duration) string {
+	days := int(math.Floor(left.Hours() / 24))
+	switch {
+	case left <= 0:
+		return "expired"
+	case days == 0:
+		return "in less than a day"
+	case days == 1:
+		return "in 1 day"
+	}
+	return fmt.Sprintf("in %d days", days)
+}
+
+// WarnExpiry warns when the saved token has expired or expires within ExpiryWarning. Tokens whose
+// expiration isn't known, such as the ones given by the --token flag, aren't warned about
+func WarnExpiry(w io.Writer, settings Settings, now time.Time) {
+	if settings.Token == "" || settings.ExpiresAt.IsZero() {
+		return
+	}
+
+	left := settings.ExpiresAt.Sub(now)
+	date := settings.ExpiresAt.Local().Format(time.DateOnly)
+	switch {
+	case left <= 0:
+		logger.LogWarning(w, fmt.Sprintf(root.TokenExpired, date))
+	case left <= ExpiryWarning:
+		logger.LogWarning(w, fmt.Sprintf(root.TokenExpiresIn, ExpiresIn(left), date))
+	}
+}
@@ -0,0 +1,73 @@
+package token
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/aziontech/azion-cli/pkg/logger"
+)
+
+func TestExpiresIn(t *testing.T) {
+	tests := []struct {
+		left time.Duration
+		want string
+	}{
+		{-time.Hour, "expired"},
+		{0, "expired"},
+		{5 * time.Hour, "in less than a day"},
+		{30 * time.Hour, "in 1 day"},
+		{10*24*time.Hour + time.Minute, "in 10 days"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, ExpiresIn(tt.left))
+	}
+}
+
+func TestWarnExpiry(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+	now := time.Date(2024, 7, 1, 12, 0, 0, 0, time.Local)
+
+	tests := []struct {
+		name     string
+		settings Settings
+		want     string
+	}{
+		{
+			name:     "expired",
+			settings: Settings{Token: "token", ExpiresAt: now.Add(-time.Hour)},
+			want:     "Your personal token expired on 2024-07-01",
+		},
+		{
+			name:     "expiring soon",
+			settings: Settings{Token: "token", ExpiresAt: now.Add(3*24*time.Hour + time.Hour)},
+			want:     "Your personal token expires in 3 days, on 2024-07-04",
+		},
+		{
+			name:     "far from expiring",
+			settings: Settings{Token: "token", ExpiresAt: now.Add(30 * 24 * time.Hour)},
+		},
+		{
+			name:     "unknown expiration",
+			settings: Settings{Token: "token"},
+		},
+		{
+			name:     "not logged in",
+			settings: Settings{ExpiresAt: now.Add(-time.Hour)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := &bytes.Buffer{}
+			WarnExpiry(out, tt.settings, now)
+			if tt.want == "" {
+				assert.Empty(t, out.String())
+				return
+			}
+			assert.Contains(t, out.String(), tt.want)
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package token
+
+import (
+	"os"
+	"strings"
+)
+
+// Hostname names the machine the CLI runs on; tests replace it
+var Hostname = os.Hostname
+
+const machineTagPrefix = " (azion-cli@"
+
+// MachineTag is the suffix the CLI appends to the names of the personal tokens it issues on hostname
+func MachineTag(hostname string) string {
+	return machineTagPrefix + hostname + ")"
+}
+
+// TagName appends this machine's tag to a personal token name, replacing the tag of the machine
+// the token was first issued on, so a token can later be revoked with the machine that holds it
+func TagName(name string) string {
+	if i := strings.LastIndex(name, machineTagPrefix); i >= 0 && strings.HasSuffix(name, ")") {
+		name = name[:i]
+	}
+	hostname, err := Hostname()
+	if err != nil || hostname == "" {
+		hostname = "unknown"
+	}
+	return name + MachineTag(hostname)
+}
+
+// IssuedFor reports whether a personal token name carries the tag of hostname
+func IssuedFor(name, hostname string) bool {
+	return strings.HasSuffix(strings.ToLower(name), strings.ToLower(MachineTag(hostname)))
+}
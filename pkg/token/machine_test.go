@@ -0,0 +1,23 @@
+package token
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTagName(t *testing.T) {
+	Hostname = func() (string, error) { return "laptop", nil }
+	t.Cleanup(func() { Hostname = os.Hostname })
+
+	assert.Equal(t, "dev@example.com (azion-cli@laptop)", TagName("dev@example.com"))
+	assert.Equal(t, "ci deploys (azion-cli@laptop)", TagName("ci deploys (azion-cli@old-laptop)"))
+	assert.True(t, IssuedFor(TagName("ci deploys"), "Laptop"))
+	assert.False(t, IssuedFor(TagName("ci deploys"), "top"))
+	assert.False(t, IssuedFor("ci deploys", "laptop"))
+
+	Hostname = func() (string, error) { return "", errors.New("no hostname") }
+	assert.Equal(t, "ci deploys (azion-cli@unknown)", TagName("ci deploys"))
+}
@@ -13,7 +13,6 @@ type HTTPClient interface {
 type Token struct {
 	endpoint string
 	client   HTTPClient
-	filePath string
 	valid    bool
 	out      io.Writer
 }
@@ -28,11 +27,24 @@ type UserInfo struct {
 type Settings struct {
 	Token                      string
 	UUID                       string
+	ExpiresAt                  time.Time
 	LastCheck                  time.Time
 	LastVulcanVersion          string
 	AuthorizeMetricsCollection int
 	ClientId                   string
 	Email                      string
+	CurrentProfile             string             `toml:",omitempty"`
+	Profiles                   map[string]Profile `toml:",omitempty"`
+	CredentialHelper           string             `toml:",omitempty"`
+	ApiURL                     string             `toml:",omitempty"`
+	StorageURL                 string             `toml:",omitempty"`
+	Defaults                   map[string]string  `toml:",omitempty"`
+
+	// base holds the default credentials while the ones of the active profile are in use
+	base   credentials
+	active string
+	// loaded is the token of the active profile as the credential helper returned it
+	loaded string
 }
 
 type Config struct {
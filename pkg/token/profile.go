@@ -0,0 +1,163 @@
+package token
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/aziontech/azion-cli/messages/root"
+)
+
+// DefaultProfile names the credentials kept at the top of settings.toml, which are used when no
+// profile is selected
+const DefaultProfile = "default"
+
+// Profile holds the credentials and settings of an account or environment other than the default one
+type Profile struct {
+	Token      string
+	UUID       string
+	ExpiresAt  time.Time
+	ClientId   string
+	Email      string
+	ApiURL     string            `toml:",omitempty"`
+	StorageURL string            `toml:",omitempty"`
+	Defaults   map[string]string `toml:",omitempty"`
+}
+
+// credentials are the settings each profile has its own copy of
+type credentials struct {
+	Token     string
+	UUID      string
+	ExpiresAt time.Time
+	ClientId  string
+	Email     string
+}
+
+// selected is the profile given by the --profile flag or the AZIONCLI_PROFILE variable, which
+// takes precedence over the one saved by 'azion profile use'
+var selected string
+
+// SelectProfile makes the settings read from now on use the profile with the given name
+func SelectProfile(name string) {
+	selected = name
+}
+
+// ActiveProfile is the name of the profile whose credentials the settings hold
+func (s Settings) ActiveProfile() string {
+	if s.active == "" {
+		return DefaultProfile
+	}
+	return s.active
+}
+
+// ProfileNames lists the profiles, starting with the default one
+func (s Settings) ProfileNames() []string {
+	names := make([]string, 0, len(s.Profiles))
+	for name := range s.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return append([]string{DefaultProfile}, names...)
+}
+
+// Profile returns the profile with the given name. The default profile is made of the credentials
+// of the top of the file
+func (s Settings) Profile(name string) (Profile, bool) {
+	if name == DefaultProfile {
+		c := s.base
+		if s.active == "" {
+			c = s.credentials()
+		}
+		return Profile{
+			Token:      c.Token,
+			UUID:       c.UUID,
+			ExpiresAt:  c.ExpiresAt,
+			ClientId:   c.ClientId,
+			Email:      c.Email,
+			ApiURL:     s.ApiURL,
+			StorageURL: s.StorageURL,
+			Defaults:   s.Defaults,
+		}, true
+	}
+	profile, ok := s.Profiles[name]
+	if ok && name == s.active {
+		c := s.credentials()
+		profile.Token, profile.UUID, profile.ExpiresAt, profile.ClientId, profile.Email = c.Token, c.UUID, c.ExpiresAt, c.ClientId, c.Email
+	}
+	return profile, ok
+}
+
+// applyProfile puts the credentials of the selected profile in place of the default ones, keeping
+// the default ones to be written back
+func (s *Settings) applyProfile() error {
+	name := selected
+	if name == "" {
+		name = s.CurrentProfile
+	}
+	if name == "" || name == DefaultProfile {
+		return nil
+	}
+
+	profile, ok := s.Profiles[name]
+	if !ok {
+		return fmt.Errorf(root.ErrorProfileNotFound.Error(), name)
+	}
+	s.base = s.credentials()
+	s.active = name
+	s.setCredentials(credentials{
+		Token:     profile.Token,
+		UUID:      profile.UUID,
+		ExpiresAt: profile.ExpiresAt,
+		ClientId:  profile.ClientId,
+		Email:     profile.Email,
+	})
+	return nil
+}
+
+// stored returns the settings as they're written to the file, with the credentials of the active
+// profile moved back into it
+func (s Settings) stored() Settings {
+	if s.active == "" {
+		return s
+	}
+
+	profiles := make(map[string]Profile, len(s.Profiles))
+	for name, profile := range s.Profiles {
+		profiles[name] = profile
+	}
+	if profile, ok := s.Profile(s.active); ok {
+		profiles[s.active] = profile
+	}
+	s.Profiles = profiles
+	s.setCredentials(s.base)
+	return s
+}
+
+// ClearCredentials logs the given profile out, removing its credentials from the settings. The token
+// of the profile in use is erased from the credential helper when the settings are written, and
+// the ones of the other profiles with EraseToken
+func (s *Settings) ClearCredentials(name string) {
+	switch {
+	case name == s.ActiveProfile():
+		s.setCredentials(credentials{})
+	case name == DefaultProfile:
+		s.base = credentials{}
+	default:
+		profiles := make(map[string]Profile, len(s.Profiles))
+		for key, profile := range s.Profiles {
+			if key == name {
+				profile.Token, profile.UUID, profile.ExpiresAt, profile.ClientId, profile.Email = "", "", time.Time{}, "", ""
+			}
+			profiles[key] = profile
+		}
+		s.Profiles = profiles
+	}
+}
+
+func (s Settings) credentials() credentials {
+	return credentials{Token: s.Token, UUID: s.UUID, ExpiresAt: s.ExpiresAt, ClientId: s.ClientId, Email: s.Email}
+}
+
+func (s *Settings) setCredentials(c credentials) {
+	s.Token, s.UUID, s.ExpiresAt, s.ClientId, s.Email = c.Token, c.UUID, c.ExpiresAt, c.ClientId, c.Email
+}
@@ -0,0 +1,124 @@
+package token
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/aziontech/azion-cli/pkg/config"
+	"github.com/aziontech/azion-cli/pkg/credential"
+	"github.com/aziontech/azion-cli/pkg/logger"
+)
+
+func useSettingsFile(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "settings.toml")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+	require.NoError(t, config.SetPath(path))
+	t.Cleanup(func() {
+		SelectProfile("")
+		_ = config.SetPath(filepath.Join(config.DEFAULT_DIR, config.DEFAULT_SETTINGS))
+	})
+	return path
+}
+
+const profilesFile = `
+Token = 'defaulttoken'
+Email = 'prod@example.com'
+CurrentProfile = 'staging'
+
+[Profiles.staging]
+Token = 'stagingtoken'
+Email = 'stage@example.com'
+ApiURL = 'https://stage-api.azion.net'
+
+[Profiles.sandbox]
+Token = ''
+Email = ''
+`
+
+func TestProfiles(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+
+	t.Run("read the current profile", func(t *testing.T) {
+		useSettingsFile(t, profilesFile)
+
+		settings, err := ReadSettings()
+		require.NoError(t, err)
+		assert.Equal(t, "staging", settings.ActiveProfile())
+		assert.Equal(t, "stagingtoken", settings.Token)
+		assert.Equal(t, "stage@example.com", settings.Email)
+		assert.Equal(t, []string{"default", "sandbox", "staging"}, settings.ProfileNames())
+
+		profile, ok := settings.Profile("staging")
+		require.True(t, ok)
+		assert.Equal(t, "https://stage-api.azion.net", profile.ApiURL)
+
+		profile, ok = settings.Profile(DefaultProfile)
+		require.True(t, ok)
+		assert.Equal(t, "prod@example.com", profile.Email)
+		assert.Equal(t, "", profile.Token, "only the token of the profile in use is read")
+	})
+
+	t.Run("selected profile takes precedence", func(t *testing.T) {
+		useSettingsFile(t, profilesFile)
+		SelectProfile("default")
+
+		settings, err := ReadSettings()
+		require.NoError(t, err)
+		assert.Equal(t, "default", settings.ActiveProfile())
+		assert.Equal(t, "defaulttoken", settings.Token)
+	})
+
+	t.Run("write to the profile in use", func(t *testing.T) {
+		useSettingsFile(t, profilesFile)
+		SelectProfile("sandbox")
+
+		settings, err := ReadSettings()
+		require.NoError(t, err)
+		settings.Token = "sandboxtoken"
+		settings.ExpiresAt = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		require.NoError(t, WriteSettings(settings))
+
+		SelectProfile("")
+		settings, err = ReadSettings()
+		require.NoError(t, err)
+		assert.Equal(t, "stagingtoken", settings.Token)
+
+		sandbox, _ := settings.Profile("sandbox")
+		assert.Equal(t, 2025, sandbox.ExpiresAt.Year())
+
+		helper := credential.New("")
+		token, err := helper.Get("sandbox")
+		require.NoError(t, err)
+		assert.Equal(t, "sandboxtoken", token)
+		token, err = helper.Get(DefaultProfile)
+		require.NoError(t, err)
+		assert.Equal(t, "defaulttoken", token)
+	})
+
+	t.Run("unknown profile", func(t *testing.T) {
+		useSettingsFile(t, profilesFile)
+		SelectProfile("qa")
+
+		_, err := ReadSettings()
+		require.EqualError(t, err, "The profile qa doesn't exist. Run the command 'azion profile list' to check your profiles and try again")
+	})
+
+	t.Run("settings without profiles", func(t *testing.T) {
+		path := useSettingsFile(t, "Token = 'defaulttoken'\n")
+
+		settings, err := ReadSettings()
+		require.NoError(t, err)
+		assert.Equal(t, "default", settings.ActiveProfile())
+		require.NoError(t, WriteSettings(settings))
+
+		content, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.NotContains(t, string(content), "Profile")
+	})
+}
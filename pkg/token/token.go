@@ -16,18 +16,13 @@ import (
 
 	"github.com/aziontech/azion-cli/pkg/config"
 	"github.com/aziontech/azion-cli/pkg/constants"
+	"github.com/aziontech/azion-cli/pkg/credential"
 )
 
 func New(c *Config) (*Token, error) {
-	dir, err := config.Dir()
-	if err != nil {
-		return nil, err
-	}
-
 	return &Token{
 		client:   c.Client,
 		endpoint: constants.AuthURL,
-		filePath: filepath.Join(dir.Dir, dir.Settings),
 		out:      c.Out,
 	}, nil
 }
@@ -62,25 +57,6 @@ func (t *Token) Validate(token *string) (bool, UserInfo, error) {
 	return true, userInfo, nil
 }
 
-func (t *Token) Save(b []byte) (string, error) {
-	dir, err := config.Dir()
-	if err != nil {
-		return "", err
-	}
-
-	err = os.MkdirAll(dir.Dir, os.ModePerm)
-	if err != nil {
-		return "", err
-	}
-
-	err = os.WriteFile(t.filePath, b, 0777)
-	if err != nil {
-		return "", err
-	}
-
-	return t.filePath, nil
-}
-
 func (t *Token) Create(b64 string) (*Response, error) {
 	logger.Debug("Create token", zap.Any("base64", b64))
 	req, err := http.NewRequest(http.MethodPost, utils.Concat(t.endpoint, "/tokens"), nil)
@@ -119,7 +95,16 @@ func (t *Token) Create(b64 string) (*Response, error) {
 	return &result, nil
 }
 
+// WriteSettings writes the settings file, handing the personal tokens to the credential helper
 func WriteSettings(settings Settings) error {
+	stored, err := settings.storeTokens()
+	if err != nil {
+		return err
+	}
+	return writeSettingsFile(stored)
+}
+
+func writeSettingsFile(settings Settings) error {
 	dir, err := config.Dir()
 	if err != nil {
 		return fmt.Errorf("Failed to get token dir: %w", err)
@@ -130,12 +115,7 @@ func WriteSettings(settings Settings) error {
 		return err
 	}
 
-	// Check if the directory exists, create it if not
-	if err := os.MkdirAll(dir.Dir, 0777); err != nil {
-		return fmt.Errorf("Error creating directory: %w", err)
-	}
-
-	if err := os.WriteFile(filepath.Join(dir.Dir, dir.Settings), b, 0777); err != nil {
+	if err := credential.WritePrivate(filepath.Join(dir.Dir, dir.Settings), b); err != nil {
 		return fmt.Errorf(utils.ErrorWriteSettings.Error(), err)
 	}
 
@@ -159,6 +139,12 @@ func ReadSettings() (Settings, error) {
 			if err != nil {
 				return Settings{}, fmt.Errorf("failed to create settings file: %w", err)
 			}
+			if err := defaultSettings.applyProfile(); err != nil {
+				return Settings{}, err
+			}
+			if err := defaultSettings.loadToken(); err != nil {
+				return Settings{}, err
+			}
 			return defaultSettings, nil
 		}
 
@@ -177,5 +163,19 @@ func ReadSettings() (Settings, error) {
 		return Settings{}, fmt.Errorf("Failed parse byte to struct settings: %w", err)
 	}
 
+	if settings.migrateTokens() {
+		if err := writeSettingsFile(settings); err != nil {
+			return Settings{}, err
+		}
+		logger.Debug("Moved the personal tokens of settings.toml to the credential helper")
+	}
+
+	if err := settings.applyProfile(); err != nil {
+		return Settings{}, err
+	}
+	if err := settings.loadToken(); err != nil {
+		return Settings{}, err
+	}
+
 	return settings, nil
 }
@@ -5,11 +5,8 @@ import (
 	"os"
 	"testing"
 
-	"github.com/aziontech/azion-cli/pkg/config"
 	"github.com/aziontech/azion-cli/pkg/httpmock"
 	"github.com/aziontech/azion-cli/pkg/logger"
-	"github.com/pelletier/go-toml/v2"
-	"github.com/stretchr/testify/require"
 	"go.uber.org/zap/zapcore"
 )
 
@@ -65,34 +62,3 @@ func Test_Validate(t *testing.T) {
 	})
 
 }
-
-func Test_Save(t *testing.T) {
-	logger.New(zapcore.DebugLevel)
-
-	errSetPath := config.SetPath("/tmp/testazion/test.toml")
-	if errSetPath != nil {
-		t.Fatalf("SetPath() error: %s;", errSetPath.Error())
-	}
-
-	t.Run("save token to disk", func(t *testing.T) {
-		token, err := New(&Config{
-			Out: os.Stdout,
-		})
-
-		if err != nil {
-			t.Fatalf("New() = %v; want nil", err)
-		}
-
-		settings := Settings{
-			Token: "asdfdsafsdfasd",
-			UUID:  "asdfadsfads",
-		}
-
-		b, err := toml.Marshal(settings)
-		require.NoError(t, err)
-
-		if _, err := token.Save(b); err != nil {
-			t.Fatalf("Save() = %v; want nil", err)
-		}
-	})
-}
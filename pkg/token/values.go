@@ -0,0 +1,183 @@
+package token
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+
+	"github.com/spf13/pflag"
+
+	msg "github.com/aziontech/azion-cli/messages/config"
+	"github.com/aziontech/azion-cli/pkg/config"
+	"github.com/aziontech/azion-cli/pkg/constants"
+	"github.com/aziontech/azion-cli/pkg/credential"
+	"github.com/aziontech/azion-cli/pkg/httpclient"
+)
+
+// Value is the value of a setting and where it comes from
+type Value struct {
+	Key    string `json:"key"`
+	Value  string `json:"value"`
+	Origin string `json:"origin"`
+}
+
+// Resolve returns the value of the setting, taken from the first source that has it: the flags,
+// the environment, the project's settings, the profile in use and the defaults. Flags are
+// skipped when nil. Projects only set the known settings that allow it
+func (s Settings) Resolve(name string, flags *pflag.FlagSet, project map[string]string) Value {
+	key, known := config.FindKey(name)
+
+	if flags != nil {
+		if flag := flags.Lookup(config.FlagName(name)); flag != nil && flag.Changed {
+			return Value{Key: name, Value: flag.Value.String(), Origin: fmt.Sprintf(msg.OriginFlag, flag.Name)}
+		}
+	}
+	if value := os.Getenv(config.EnvName(name)); value != "" {
+		return Value{Key: name, Value: value, Origin: fmt.Sprintf(msg.OriginEnv, config.EnvName(name))}
+	}
+	if value := project[name]; value != "" && known && key.Project {
+		return Value{Key: name, Value: value, Origin: fmt.Sprintf(msg.OriginProject, config.ProjectFile)}
+	}
+	if value := s.profileValue(name); value != "" {
+		if key.Global {
+			return Value{Key: name, Value: value, Origin: fmt.Sprintf(msg.OriginSettings, settingsPath())}
+		}
+		return Value{Key: name, Value: value, Origin: fmt.Sprintf(msg.OriginProfile, s.ActiveProfile(), settingsPath())}
+	}
+	return Value{Key: name, Value: defaultValue(name), Origin: msg.OriginDefault}
+}
+
+// Keys lists the known settings, followed by the other ones the profile in use sets
+func (s Settings) Keys() []string {
+	names := make([]string, 0, len(config.Keys))
+	for _, key := range config.Keys {
+		names = append(names, key.Name)
+	}
+
+	var extra []string
+	profile, _ := s.Profile(s.ActiveProfile())
+	for name := range profile.Defaults {
+		extra = append(extra, name)
+	}
+	sort.Strings(extra)
+	for _, name := range extra {
+		if _, known := config.FindKey(name); !known {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// SetValue changes the setting for the profile in use. An empty value removes it
+func (s *Settings) SetValue(name, value string) {
+	if s.active == "" {
+		s.ApiURL, s.StorageURL, s.Defaults = setProfileValue(s.ApiURL, s.StorageURL, s.Defaults, name, value)
+		return
+	}
+
+	profiles := make(map[string]Profile, len(s.Profiles))
+	for key, profile := range s.Profiles {
+		profiles[key] = profile
+	}
+	profile := profiles[s.active]
+	profile.ApiURL, profile.StorageURL, profile.Defaults = setProfileValue(profile.ApiURL, profile.StorageURL, profile.Defaults, name, value)
+	profiles[s.active] = profile
+	s.Profiles = profiles
+}
+
+// SetCredentialHelper moves the tokens of every profile to the given credential helper
+func (s *Settings) SetCredentialHelper(name string) error {
+	from, to := s.helper(), credential.New(name)
+	for _, profile := range s.ProfileNames() {
+		token, err := from.Get(profile)
+		if err != nil {
+			return err
+		}
+		if profile == s.ActiveProfile() {
+			token = s.Token
+		}
+		if token == "" {
+			continue
+		}
+		if err := to.Store(profile, token); err != nil {
+			return err
+		}
+		if err := from.Erase(profile); err != nil {
+			return err
+		}
+	}
+
+	s.CredentialHelper = name
+	if name == credential.FileHelper {
+		s.CredentialHelper = ""
+	}
+	return nil
+}
+
+func (s Settings) profileValue(name string) string {
+	profile, _ := s.Profile(s.ActiveProfile())
+	switch name {
+	case "api_url":
+		return profile.ApiURL
+	case "storage_url":
+		return profile.StorageURL
+	case "token":
+		return profile.Token
+	case "credential_helper":
+		return s.CredentialHelper
+	}
+	return profile.Defaults[name]
+}
+
+func setProfileValue(apiURL, storageURL string, defaults map[string]string, name, value string) (string, string, map[string]string) {
+	switch name {
+	case "api_url":
+		return value, storageURL, defaults
+	case "storage_url":
+		return apiURL, value, defaults
+	}
+
+	values := make(map[string]string, len(defaults)+1)
+	for key, v := range defaults {
+		values[key] = v
+	}
+	if value == "" {
+		delete(values, name)
+	} else {
+		values[name] = value
+	}
+	if len(values) == 0 {
+		values = nil
+	}
+	return apiURL, storageURL, values
+}
+
+func defaultValue(name string) string {
+	switch name {
+	case "api_url":
+		return constants.ApiURL
+	case "storage_url":
+		return constants.StorageApiURL
+	case "credential_helper":
+		return credential.FileHelper
+	case "timeout":
+		return httpclient.DefaultTimeout.String()
+	case "upload_timeout":
+		return httpclient.DefaultUploadTimeout.String()
+	case "max_retries":
+		return strconv.Itoa(httpclient.DefaultMaxRetries)
+	case "retry_max_wait":
+		return httpclient.DefaultRetryMaxWait.String()
+	}
+	return ""
+}
+
+func settingsPath() string {
+	dir, err := config.Dir()
+	if err != nil {
+		return config.DEFAULT_SETTINGS
+	}
+	return filepath.Join(dir.Dir, dir.Settings)
+}
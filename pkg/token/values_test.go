@@ -0,0 +1,83 @@
+package token
+
+import (
+	"os"
+	"testing"
+
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/aziontech/azion-cli/pkg/logger"
+)
+
+func TestResolve(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+	path := useSettingsFile(t, profilesFile)
+	os.Unsetenv("AZIONCLI_API_URL")
+
+	settings, err := ReadSettings()
+	require.NoError(t, err)
+	project := map[string]string{"api_url": "https://project-api.azion.net", "token": "projecttoken", "timeout": "90s", "log_level": "debug"}
+
+	value := settings.Resolve("storage_url", nil, project)
+	assert.Equal(t, "default", value.Origin)
+
+	value = settings.Resolve("timeout", nil, project)
+	assert.Equal(t, "90s", value.Value)
+	assert.Equal(t, "project file azion/config", value.Origin)
+
+	value = settings.Resolve("log_level", nil, project)
+	assert.Equal(t, "default", value.Origin, "projects can't set unknown settings")
+
+	value = settings.Resolve("token", nil, project)
+	assert.Equal(t, "stagingtoken", value.Value, "projects can't set tokens")
+	assert.Equal(t, "profile staging in "+path, value.Origin)
+
+	value = settings.Resolve("api_url", nil, nil)
+	assert.Equal(t, "https://stage-api.azion.net", value.Value)
+
+	value = settings.Resolve("api_url", nil, project)
+	assert.Equal(t, "https://stage-api.azion.net", value.Value, "projects can't send the token to other hosts")
+
+	t.Setenv("AZIONCLI_API_URL", "https://env-api.azion.net")
+	value = settings.Resolve("api_url", nil, project)
+	assert.Equal(t, "https://env-api.azion.net", value.Value)
+	assert.Equal(t, "environment variable AZIONCLI_API_URL", value.Origin)
+
+	flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	flags.String("api-url", "", "")
+	require.NoError(t, flags.Parse([]string{"--api-url", "https://flag-api.azion.net"}))
+	value = settings.Resolve("api_url", flags, project)
+	assert.Equal(t, "https://flag-api.azion.net", value.Value)
+	assert.Equal(t, "flag --api-url", value.Origin)
+}
+
+func TestSetValue(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+	useSettingsFile(t, profilesFile)
+
+	settings, err := ReadSettings()
+	require.NoError(t, err)
+	settings.SetValue("api_url", "")
+	settings.SetValue("log_level", "debug")
+	require.NoError(t, WriteSettings(settings))
+
+	SelectProfile(DefaultProfile)
+	settings, err = ReadSettings()
+	require.NoError(t, err)
+	settings.SetValue("storage_url", "https://storage.example.com")
+	require.NoError(t, WriteSettings(settings))
+
+	SelectProfile("")
+	settings, err = ReadSettings()
+	require.NoError(t, err)
+	staging, _ := settings.Profile("staging")
+	assert.Equal(t, "", staging.ApiURL)
+	assert.Equal(t, map[string]string{"log_level": "debug"}, staging.Defaults)
+	assert.Equal(t, []string{"api_url", "storage_url", "token", "credential_helper", "timeout", "upload_timeout", "max_retries", "retry_max_wait", "proxy", "ca_bundle", "log_level"}, settings.Keys())
+
+	defaults, _ := settings.Profile(DefaultProfile)
+	assert.Equal(t, "https://storage.example.com", defaults.StorageURL)
+}
@@ -9,7 +9,6 @@ import (
 	"github.com/aziontech/azion-cli/pkg/cmdutil"
 	"github.com/aziontech/azion-cli/pkg/logger"
 	"github.com/aziontech/azion-cli/pkg/token"
-	"github.com/pelletier/go-toml"
 	"go.uber.org/zap"
 )
 
@@ -54,18 +53,7 @@ func CheckVulcanMajor(currentVersion string, f *cmdutil.Factory) error {
 		}
 
 		config.LastVulcanVersion = currentVersion
-		client, err := token.New(&token.Config{Client: f.HttpClient})
-		if err != nil {
-			return err
-		}
-
-		byteSettings, err := toml.Marshal(config)
-		if err != nil {
-			logger.Debug("Error while marshalling settings.toml", zap.Error(err))
-			return err
-		}
-
-		_, err = client.Save(byteSettings)
+		err = token.WriteSettings(config)
 		if err != nil {
 			logger.Debug("Error while saving settings", zap.Error(err))
 			return err
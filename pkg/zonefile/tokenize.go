@@ -0,0 +1,145 @@
+package zonefile
+
+import (
+	"fmt"
+	"strings"
+)
+
+type token struct {
+	text   string
+	quoted bool
+}
+
+// entry is a directive or a record, which parentheses may spread over many lines
+type entry struct {
+	line       int
+	blankOwner bool
+	tokens     []token
+}
+
+// tokenize splits a zone file into entries, dropping the comments
+func tokenize(data string) ([]entry, error) {
+	var entries []entry
+	var current *entry
+	line, depth := 1, 0
+	blankStart := startsBlank(data, 0)
+
+	for i := 0; i < len(data); {
+		c := data[i]
+		switch {
+		case c == '\n':
+			line++
+			i++
+			if depth == 0 {
+				if current != nil {
+					entries = append(entries, *current)
+					current = nil
+				}
+				blankStart = startsBlank(data, i)
+			}
+			continue
+		case c == ' ' || c == '\t' || c == '\r':
+			i++
+			continue
+		case c == ';':
+			for i < len(data) && data[i] != '\n' {
+				i++
+			}
+			continue
+		case c == '(':
+			depth++
+			i++
+			continue
+		case c == ')':
+			if depth == 0 {
+				return nil, &ParseError{Line: line, Err: "unbalanced parentheses"}
+			}
+			depth--
+			i++
+			continue
+		}
+
+		var t token
+		var err error
+		if c == '"' {
+			t, i, err = quoted(data, i+1)
+		} else {
+			t, i = word(data, i)
+		}
+		if err != nil {
+			return nil, &ParseError{Line: line, Err: err.Error()}
+		}
+
+		if current == nil {
+			current = &entry{line: line, blankOwner: blankStart}
+		}
+		current.tokens = append(current.tokens, t)
+	}
+
+	if depth > 0 {
+		return nil, &ParseError{Line: line, Err: "unbalanced parentheses"}
+	}
+	if current != nil {
+		entries = append(entries, *current)
+	}
+	return entries, nil
+}
+
+func startsBlank(data string, i int) bool {
+	return i < len(data) && (data[i] == ' ' || data[i] == '\t')
+}
+
+// word reads an unquoted field, keeping its escapes as they are
+func word(data string, i int) (token, int) {
+	start := i
+	for i < len(data) && !strings.ContainsRune(" \t\r\n;()\"", rune(data[i])) {
+		if data[i] == '\\' && i+1 < len(data) {
+			i++
+		}
+		i++
+	}
+	return token{text: data[start:i]}, i
+}
+
+// quoted reads a string from after its opening quote, decoding its escapes
+func quoted(data string, i int) (token, int, error) {
+	var text strings.Builder
+	for i < len(data) {
+		c := data[i]
+		switch {
+		case c == '"':
+			return token{text: text.String(), quoted: true}, i + 1, nil
+		case c == '\n':
+			return token{}, i, fmt.Errorf("unterminated string")
+		case c == '\\' && i+3 < len(data) && isDigits(data[i+1:i+4]):
+			code := int(data[i+1]-'0')*100 + int(data[i+2]-'0')*10 + int(data[i+3]-'0')
+			if code > 255 {
+				return token{}, i, fmt.Errorf("invalid escape \\%s", data[i+1:i+4])
+			}
+			text.WriteByte(byte(code))
+			i += 4
+			continue
+		case c == '\\' && i+1 < len(data):
+			i++
+			c = data[i]
+		}
+		text.WriteByte(c)
+		i++
+	}
+	return token{}, i, fmt.Errorf("unterminated string")
+}
+
+func isDigits(text string) bool {
+	for i := 0; i < len(text); i++ {
+		if text[i] < '0' || text[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// quote writes text as a zone file string, escaping its quotes and backslashes
+func quote(text string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+	return `"` + replacer.Replace(text) + `"`
+}
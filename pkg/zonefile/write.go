@@ -0,0 +1,65 @@
+package zonefile
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// maxString is the longest string a TXT record holds; longer texts are split into many strings
+const maxString = 255
+
+// Write writes the record sets as a zone file for origin, with ttl as the default TTL
+func Write(w io.Writer, origin string, ttl uint32, sets []RecordSet) error {
+	out := bufio.NewWriter(w)
+	origin = strings.TrimSuffix(origin, ".")
+
+	fmt.Fprintf(out, "$ORIGIN %s.\n", origin)
+	if ttl > 0 {
+		fmt.Fprintf(out, "$TTL %d\n", ttl)
+	}
+	fmt.Fprintln(out)
+
+	for _, set := range sets {
+		recordType := strings.ToUpper(set.Type)
+		for _, answer := range set.Answers {
+			name := set.Name
+			if name == "" {
+				name = Apex
+			}
+			fmt.Fprintf(out, "%s\t", name)
+			if set.TTL > 0 {
+				fmt.Fprintf(out, "%d", set.TTL)
+			}
+			fmt.Fprintf(out, "\tIN\t%s\t%s\n", recordType, writeValue(recordType, answer))
+		}
+	}
+	return out.Flush()
+}
+
+// writeValue writes an answer as record data, ending domain names with a dot and quoting texts
+func writeValue(recordType, answer string) string {
+	if recordType == "TXT" || recordType == "SPF" {
+		// texts that were already written as strings are kept as they are
+		if strings.HasPrefix(answer, `"`) && strings.HasSuffix(answer, `"`) && len(answer) > 1 {
+			return answer
+		}
+		var strs []string
+		for len(answer) > maxString {
+			strs = append(strs, quote(answer[:maxString]))
+			answer = answer[maxString:]
+		}
+		return strings.Join(append(strs, quote(answer)), " ")
+	}
+
+	i, ok := nameFields[recordType]
+	if !ok {
+		return answer
+	}
+	fields := strings.Fields(answer)
+	if i < len(fields) && !strings.HasSuffix(fields[i], ".") {
+		fields[i] += "."
+	}
+	return strings.Join(fields, " ")
+}
@@ -0,0 +1,326 @@
+// Package zonefile reads and writes DNS zones in the BIND zone file format (RFC 1035), the one
+// most DNS providers import and export
+package zonefile
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Apex is how the records of the zone's own domain are named
+const Apex = "@"
+
+// Record is a resource record of the zone
+type Record struct {
+	Line int
+	// Name is relative to the zone's origin, or Apex
+	Name string
+	// TTL is 0 when neither the record nor the zone file set one
+	TTL  uint32
+	Type string
+	// Value is the record data as a single answer. Domain names in it are absolute, without the
+	// trailing dot, and the strings of TXT records are joined and unquoted
+	Value string
+}
+
+// Zone is the content of a zone file
+type Zone struct {
+	// Origin is the zone's domain, without the trailing dot
+	Origin  string
+	Records []Record
+}
+
+// RecordSet is the records of a name and type, which are served together
+type RecordSet struct {
+	Name    string
+	Type    string
+	TTL     uint32
+	Answers []string
+}
+
+// ParseError is a zone file line that can't be read
+type ParseError struct {
+	Line int
+	Err  string
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("line %d: %s", e.Line, e.Err)
+}
+
+// ErrNoOrigin is returned when the zone's domain is neither given nor set before the records
+var ErrNoOrigin = errors.New("the zone file has no $ORIGIN directive and no domain was given")
+
+// nameFields is which field of the record data holds a domain name, for the types that have one
+var nameFields = map[string]int{
+	"CNAME": 0,
+	"NS":    0,
+	"PTR":   0,
+	"ANAME": 0,
+	"DNAME": 0,
+	"MX":    1,
+	"SRV":   3,
+}
+
+// Parse reads a zone file. Relative names are completed with origin until a $ORIGIN directive
+// changes it, and origin may be empty when a $ORIGIN directive comes before the records
+func Parse(r io.Reader, origin string) (*Zone, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := tokenize(string(data))
+	if err != nil {
+		return nil, err
+	}
+
+	zone := &Zone{Origin: strings.ToLower(strings.TrimSuffix(origin, "."))}
+	current := zone.Origin
+	var defaultTTL, lastTTL uint32
+	var lastOwner string
+
+	for _, e := range entries {
+		fail := func(format string, args ...interface{}) error {
+			return &ParseError{Line: e.line, Err: fmt.Sprintf(format, args...)}
+		}
+
+		tokens := e.tokens
+		if first := tokens[0]; !first.quoted && strings.HasPrefix(first.text, "$") {
+			directive := strings.ToUpper(first.text)
+			switch directive {
+			case "$ORIGIN":
+				if len(tokens) != 2 {
+					return nil, fail("$ORIGIN takes a single domain name")
+				}
+				name, err := absolute(tokens[1].text, current)
+				if err != nil {
+					return nil, fail(err.Error())
+				}
+				current = strings.ToLower(name)
+				if zone.Origin == "" {
+					zone.Origin = current
+				}
+			case "$TTL":
+				if len(tokens) != 2 {
+					return nil, fail("$TTL takes a single time value")
+				}
+				ttl, ok := parseTTL(tokens[1].text)
+				if !ok {
+					return nil, fail("invalid TTL %q", tokens[1].text)
+				}
+				defaultTTL = ttl
+			default:
+				return nil, fail("the %s directive is not supported", directive)
+			}
+			continue
+		}
+
+		if zone.Origin == "" {
+			return nil, ErrNoOrigin
+		}
+
+		// records that start with a blank belong to the previous name
+		owner := lastOwner
+		if !e.blankOwner {
+			name, err := absolute(tokens[0].text, current)
+			if err != nil {
+				return nil, fail(err.Error())
+			}
+			owner = strings.ToLower(name)
+			tokens = tokens[1:]
+		}
+		if owner == "" {
+			return nil, fail("the record has no name")
+		}
+		lastOwner = owner
+
+		// the TTL and the class come before the type, in either order
+		var ttl uint32
+		hasTTL := false
+		for len(tokens) > 0 {
+			if isClass(tokens[0].text) {
+				tokens = tokens[1:]
+				continue
+			}
+			if value, ok := parseTTL(tokens[0].text); ok && !hasTTL {
+				ttl, hasTTL = value, true
+				tokens = tokens[1:]
+				continue
+			}
+			break
+		}
+		if len(tokens) == 0 {
+			return nil, fail("the record has no type")
+		}
+
+		switch {
+		case hasTTL:
+			lastTTL = ttl
+		case defaultTTL > 0:
+			ttl = defaultTTL
+		default:
+			ttl = lastTTL
+		}
+
+		recordType := strings.ToUpper(tokens[0].text)
+		value, err := recordValue(recordType, tokens[1:], current)
+		if err != nil {
+			return nil, fail(err.Error())
+		}
+
+		name, err := relative(owner, zone.Origin)
+		if err != nil {
+			return nil, fail(err.Error())
+		}
+
+		zone.Records = append(zone.Records, Record{
+			Line:  e.line,
+			Name:  name,
+			TTL:   ttl,
+			Type:  recordType,
+			Value: value,
+		})
+	}
+
+	if zone.Origin == "" {
+		return nil, ErrNoOrigin
+	}
+	return zone, nil
+}
+
+// RecordSets groups the records by name and type, in the order they first appear. A set has the
+// lowest TTL of its records
+func (z *Zone) RecordSets() []RecordSet {
+	var sets []RecordSet
+	index := map[string]int{}
+	for _, record := range z.Records {
+		key := record.Name + " " + record.Type
+		i, ok := index[key]
+		if !ok {
+			index[key] = len(sets)
+			sets = append(sets, RecordSet{Name: record.Name, Type: record.Type, TTL: record.TTL})
+			i = len(sets) - 1
+		}
+
+		set := &sets[i]
+		if record.TTL > 0 && (set.TTL == 0 || record.TTL < set.TTL) {
+			set.TTL = record.TTL
+		}
+		if !contains(set.Answers, record.Value) {
+			set.Answers = append(set.Answers, record.Value)
+		}
+	}
+	return sets
+}
+
+// recordValue writes the record data as a single answer
+func recordValue(recordType string, tokens []token, origin string) (string, error) {
+	if len(tokens) == 0 {
+		return "", fmt.Errorf("the %s record has no data", recordType)
+	}
+
+	if recordType == "TXT" || recordType == "SPF" {
+		var text strings.Builder
+		for _, t := range tokens {
+			text.WriteString(t.text)
+		}
+		return text.String(), nil
+	}
+
+	fields := make([]string, len(tokens))
+	for i, t := range tokens {
+		fields[i] = t.text
+		if t.quoted {
+			fields[i] = quote(t.text)
+		}
+	}
+	if i, ok := nameFields[recordType]; ok {
+		if i >= len(fields) {
+			return "", fmt.Errorf("the %s record has no domain name", recordType)
+		}
+		if fields[i] != "." {
+			name, err := absolute(fields[i], origin)
+			if err != nil {
+				return "", err
+			}
+			fields[i] = name
+		}
+	}
+	return strings.Join(fields, " "), nil
+}
+
+// absolute completes a name relative to origin. The result has no trailing dot
+func absolute(name, origin string) (string, error) {
+	switch {
+	case name == Apex:
+		if origin == "" {
+			return "", fmt.Errorf("%s is used before the zone's domain is known", Apex)
+		}
+		return origin, nil
+	case strings.HasSuffix(name, "."):
+		return strings.TrimSuffix(name, "."), nil
+	case origin == "":
+		return "", fmt.Errorf("the relative name %s is used before the zone's domain is known", name)
+	}
+	return name + "." + origin, nil
+}
+
+// relative returns name without the zone's domain
+func relative(name, origin string) (string, error) {
+	if name == origin {
+		return Apex, nil
+	}
+	if entry, ok := strings.CutSuffix(name, "."+origin); ok {
+		return entry, nil
+	}
+	return "", fmt.Errorf("%s is out of the %s zone", name, origin)
+}
+
+func isClass(text string) bool {
+	switch strings.ToUpper(text) {
+	case "IN", "CH", "HS", "CS":
+		return true
+	}
+	return false
+}
+
+// parseTTL reads a TTL in seconds or with BIND's units, such as 1h30m
+func parseTTL(text string) (uint32, bool) {
+	units := map[byte]uint64{'s': 1, 'm': 60, 'h': 3600, 'd': 86400, 'w': 604800}
+
+	var total, number uint64
+	digits := false
+	for i := 0; i < len(text); i++ {
+		c := text[i]
+		if c >= '0' && c <= '9' {
+			number = number*10 + uint64(c-'0')
+			digits = true
+			if number > 1<<32-1 {
+				return 0, false
+			}
+			continue
+		}
+		unit, ok := units[c|0x20]
+		if !ok || !digits {
+			return 0, false
+		}
+		total += number * unit
+		number, digits = 0, false
+	}
+	total += number
+	if text == "" || total > 1<<32-1 {
+		return 0, false
+	}
+	return uint32(total), true
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
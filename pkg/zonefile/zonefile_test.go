@@ -0,0 +1,122 @@
+package zonefile
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const zoneFile = `$ORIGIN example.com.
+$TTL 1h
+@	IN	SOA	ns1.example.com. hostmaster.example.com. (
+		2024010101 ; serial
+		7200       ; refresh
+		3600 1209600 300 )
+@		IN	NS	ns1.other.net.
+		IN	A	192.0.2.1
+www	300	IN	CNAME	@
+mail	IN	300	A	192.0.2.10
+	IN	A	192.0.2.11 ; same name
+@	MX	10 mail
+@	TXT	"v=spf1 include:_spf.example.net ~all"
+dkim._domainkey	TXT	( "v=DKIM1; k=rsa; "
+		"p=MIIB" )
+_sip._tcp	SRV	10 60 5060 sip.example.net.
+@	CAA	0 issue "letsencrypt.org"
+$ORIGIN sub.example.com.
+api	A	192.0.2.20
+`
+
+func TestParse(t *testing.T) {
+	zone, err := Parse(strings.NewReader(zoneFile), "")
+	require.NoError(t, err)
+	assert.Equal(t, "example.com", zone.Origin)
+
+	assert.Equal(t, []Record{
+		{Line: 3, Name: "@", TTL: 3600, Type: "SOA", Value: "ns1.example.com. hostmaster.example.com. 2024010101 7200 3600 1209600 300"},
+		{Line: 7, Name: "@", TTL: 3600, Type: "NS", Value: "ns1.other.net"},
+		{Line: 8, Name: "@", TTL: 3600, Type: "A", Value: "192.0.2.1"},
+		{Line: 9, Name: "www", TTL: 300, Type: "CNAME", Value: "example.com"},
+		{Line: 10, Name: "mail", TTL: 300, Type: "A", Value: "192.0.2.10"},
+		{Line: 11, Name: "mail", TTL: 3600, Type: "A", Value: "192.0.2.11"},
+		{Line: 12, Name: "@", TTL: 3600, Type: "MX", Value: "10 mail.example.com"},
+		{Line: 13, Name: "@", TTL: 3600, Type: "TXT", Value: "v=spf1 include:_spf.example.net ~all"},
+		{Line: 14, Name: "dkim._domainkey", TTL: 3600, Type: "TXT", Value: "v=DKIM1; k=rsa; p=MIIB"},
+		{Line: 16, Name: "_sip._tcp", TTL: 3600, Type: "SRV", Value: "10 60 5060 sip.example.net"},
+		{Line: 17, Name: "@", TTL: 3600, Type: "CAA", Value: `0 issue "letsencrypt.org"`},
+		{Line: 19, Name: "api.sub", TTL: 3600, Type: "A", Value: "192.0.2.20"},
+	}, zone.Records)
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		origin string
+		file   string
+		err    string
+	}{
+		{"no origin", "", "www.example.com. A 192.0.2.1\n", ErrNoOrigin.Error()},
+		{"out of zone", "example.com", "www.example.net. A 192.0.2.1\n", "line 1: www.example.net is out of the example.com zone"},
+		{"unbalanced parentheses", "example.com", "@ SOA ns1 host ( 1 2\n", "line 2: unbalanced parentheses"},
+		{"unterminated string", "example.com", "@ TXT \"text\n", "line 1: unterminated string"},
+		{"include", "example.com", "$INCLUDE other.zone\n", "line 1: the $INCLUDE directive is not supported"},
+		{"no type", "example.com", "www 300 IN\n", "line 1: the record has no type"},
+		{"no data", "example.com", "www A\n", "line 1: the A record has no data"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(strings.NewReader(tt.file), tt.origin)
+			require.Error(t, err)
+			assert.Equal(t, tt.err, err.Error())
+		})
+	}
+}
+
+func TestRecordSets(t *testing.T) {
+	zone, err := Parse(strings.NewReader(zoneFile), "")
+	require.NoError(t, err)
+
+	sets := zone.RecordSets()
+	require.Len(t, sets, 11)
+	assert.Equal(t, RecordSet{Name: "mail", Type: "A", TTL: 300, Answers: []string{"192.0.2.10", "192.0.2.11"}}, sets[4])
+}
+
+func TestParseTTL(t *testing.T) {
+	for text, want := range map[string]uint32{"300": 300, "1h": 3600, "1h30m": 5400, "1W": 604800, "2d1s": 172801} {
+		ttl, ok := parseTTL(text)
+		assert.True(t, ok, text)
+		assert.Equal(t, want, ttl, text)
+	}
+	for _, text := range []string{"", "A", "h1", "MX", "99999999999"} {
+		_, ok := parseTTL(text)
+		assert.False(t, ok, text)
+	}
+}
+
+func TestWrite(t *testing.T) {
+	long := strings.Repeat("a", 300)
+	sets := []RecordSet{
+		{Name: "@", Type: "A", TTL: 300, Answers: []string{"192.0.2.1", "192.0.2.2"}},
+		{Name: "www", Type: "CNAME", TTL: 3600, Answers: []string{"example.com"}},
+		{Name: "@", Type: "MX", Answers: []string{"10 mail.example.com"}},
+		{Name: "@", Type: "TXT", TTL: 3600, Answers: []string{`say "hi"`, long}},
+	}
+
+	var out bytes.Buffer
+	require.NoError(t, Write(&out, "example.com.", 3600, sets))
+	assert.Equal(t, "$ORIGIN example.com.\n$TTL 3600\n\n"+
+		"@\t300\tIN\tA\t192.0.2.1\n"+
+		"@\t300\tIN\tA\t192.0.2.2\n"+
+		"www\t3600\tIN\tCNAME\texample.com.\n"+
+		"@\t\tIN\tMX\t10 mail.example.com.\n"+
+		"@\t3600\tIN\tTXT\t\"say \\\"hi\\\"\"\n"+
+		"@\t3600\tIN\tTXT\t\""+long[:255]+"\" \""+long[255:]+"\"\n", out.String())
+
+	// what is written reads back the same
+	zone, err := Parse(&out, "")
+	require.NoError(t, err)
+	assert.Equal(t, sets[3].Answers, zone.RecordSets()[3].Answers)
+}
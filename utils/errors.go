@@ -50,4 +50,6 @@ var (
 	ErrorNameInUse                  = errors.New("The name you've selected is already in use by another resource. Please choose a different name. Run 'azion list [resource]' to see all your resources")
 	ErrorCancelledContextInput      = errors.New("Execution interrupted by the user. All interactions of this flow were lost.")
 	ErrorWriteSettings              = errors.New("Failed to write settings.toml file: %w")
+	ErrorMissingFlags               = errors.New("missing required flag %s. The CLI doesn't prompt for values when the input isn't a terminal or --no-interactive is used; pass the flags and try again")
+	ErrorMissingInput               = errors.New("missing required input '%s'. The CLI doesn't prompt for values when the input isn't a terminal or --no-interactive is used; use the flags -h or --help with the command to display its flags and try again")
 )
@@ -3,6 +3,7 @@ package utils
 import (
 	"bufio"
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -307,7 +308,8 @@ func ErrorPerStatusCode(httpResp *http.Response, err error) error {
 // checks varying errors that may occur when status code is 500
 func checkStatusCode500Error(err error) error {
 
-	if strings.Contains(err.Error(), "Client.Timeout") {
+	// the attempts of a request time out through their context
+	if strings.Contains(err.Error(), "Client.Timeout") || errors.Is(err, context.DeadlineExceeded) {
 		return ErrorTimeoutAPICall
 	}
 
@@ -440,6 +442,9 @@ func IsEmpty(value interface{}) bool {
 }
 
 func GetPackageManager() (string, error) {
+	if !interactive {
+		return "", PromptError("Choose a package manager:")
+	}
 	opts := []string{"npm", "yarn"}
 	answer := ""
 	prompt := &survey.Select{
@@ -454,6 +459,10 @@ func GetPackageManager() (string, error) {
 }
 
 func AskInputEmpty(msg string) (string, error) {
+	if !interactive {
+		return "", PromptError(msg)
+	}
+
 	qs := []*survey.Question{
 		{
 			Name:     "id",
@@ -467,7 +476,7 @@ func AskInputEmpty(msg string) (string, error) {
 	err := survey.Ask(qs, &answer)
 	if err == terminal.InterruptErr {
 		logger.Error(ErrorCancelledContextInput.Error())
-		os.Exit(0)
+		os.Exit(cmdutil.ExitAborted)
 	} else if err != nil {
 		logger.Debug("Error while parsing answer", zap.Error(err))
 		return "", ErrorParseResponse
@@ -477,6 +486,10 @@ func AskInputEmpty(msg string) (string, error) {
 }
 
 func AskInput(msg string) (string, error) {
+	if !interactive {
+		return "", PromptError(msg)
+	}
+
 	qs := []*survey.Question{
 		{
 			Name:     "id",
@@ -490,7 +503,7 @@ func AskInput(msg string) (string, error) {
 	err := survey.Ask(qs, &answer)
 	if err == terminal.InterruptErr {
 		logger.Error(ErrorCancelledContextInput.Error())
-		os.Exit(0)
+		os.Exit(cmdutil.ExitAborted)
 	} else if err != nil {
 		logger.Debug("Error while parsing answer", zap.Error(err))
 		return "", ErrorParseResponse
@@ -500,6 +513,10 @@ func AskInput(msg string) (string, error) {
 }
 
 func AskPassword(msg string) (string, error) {
+	if !interactive {
+		return "", PromptError(msg)
+	}
+
 	qs := []*survey.Question{
 		{
 			Name:     "id",
@@ -513,7 +530,7 @@ func AskPassword(msg string) (string, error) {
 	err := survey.Ask(qs, &answer)
 	if err == terminal.InterruptErr {
 		logger.Error(ErrorCancelledContextInput.Error())
-		os.Exit(0)
+		os.Exit(cmdutil.ExitAborted)
 	} else if err != nil {
 		logger.Debug("Error while parsing answer", zap.Error(err))
 		return "", ErrorParseResponse
@@ -541,8 +558,8 @@ func LogAndRewindBody(httpResp *http.Response) error {
 	return nil
 }
 
-// FlagINUnmarshalFileJSON
-// request interface{} always as a pointer
+// FlagFileUnmarshalJSON reads the JSON or YAML file given in a --file flag, or the JSON in
+// stdin when path is -, into request, which must be a pointer
 func FlagFileUnmarshalJSON(path string, request interface{}) error {
 	var (
 		file *os.File
@@ -559,10 +576,24 @@ func FlagFileUnmarshalJSON(path string, request interface{}) error {
 		defer file.Close()
 	}
 
-	return cmdutil.UnmarshallJsonFromReader(file, &request)
+	data, err := io.ReadAll(file)
+	if err != nil {
+		return err
+	}
+	if cmdutil.IsYAML(path) {
+		data, err = cmdutil.YAMLToJSON(data)
+		if err != nil {
+			return err
+		}
+	}
+	return cmdutil.UnmarshallJsonFromReader(bytes.NewReader(data), &request)
 }
 
 func Select(label string, items []string) (string, error) {
+	if !interactive {
+		return "", PromptError(label)
+	}
+
 	prompt := promptui.Select{
 		Label: label,
 		Items: items,
@@ -589,9 +620,13 @@ func Concat(strs ...string) string {
 // - globalFlagAll: a boolean flag to skip the confirmation and return true directly.
 // - msg: the message to display as part of the confirmation prompt.
 // - defaultYes: a boolean flag indicating whether pressing enter should default to 'yes'.
-func Confirm(globalFlagAll bool, msg string, defaultYes bool) bool {
+// When prompts are disabled, it fails asking for --yes instead of guessing the answer.
+func Confirm(globalFlagAll bool, msg string, defaultYes bool) (bool, error) {
 	if globalFlagAll {
-		return true
+		return true, nil
+	}
+	if !interactive {
+		return false, confirmError()
 	}
 
 	fmt.Printf("🤔 \x1b[32m%s \x1b[0m", msg)
@@ -600,16 +635,16 @@ func Confirm(globalFlagAll bool, msg string, defaultYes bool) bool {
 	confirm := scanner.Text()
 
 	if confirm == "" && !defaultYes {
-		return false
+		return false, nil
 	} else if confirm == "" && defaultYes {
-		return true
+		return true, nil
 	}
 
 	switch confirm {
 	case "y", "Y":
-		return true
+		return true, nil
 	case "n", "N":
-		return false
+		return false, nil
 	default:
 		fmt.Printf("\x1b[33m%s\x1b[0m", "⚠️ Invalid input. Please enter 'y' or 'n'.\n")
 		return Confirm(globalFlagAll, msg, defaultYes)
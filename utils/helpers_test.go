@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"encoding/json"
 	"os"
 	"path/filepath"
 	"testing"
@@ -52,6 +53,12 @@ func TestCobraCmd(t *testing.T) {
 		require.NoError(t, err)
 	})
 
+	// azion.json is written to the working directory
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(t.TempDir()))
+	t.Cleanup(func() { _ = os.Chdir(wd) })
+
 	t.Run("write json content", func(t *testing.T) {
 		path, _ := GetWorkingDir()
 
@@ -158,3 +165,37 @@ func TestIsEmpty(t *testing.T) {
 		})
 	}
 }
+
+func TestFlagFileUnmarshalJSON(t *testing.T) {
+	type request struct {
+		Name    string            `json:"name"`
+		Active  bool              `json:"active"`
+		Domains []int64           `json:"domains_ids"`
+		Headers map[string]string `json:"headers"`
+	}
+	want := request{Name: "siem", Active: true, Domains: []int64{1337}, Headers: map[string]string{"X-Token": "abc"}}
+
+	dir := t.TempDir()
+	files := map[string]string{
+		"create.json": `{"name": "siem", "active": true, "domains_ids": [1337], "headers": {"X-Token": "abc"}}`,
+		"create.yaml": "name: siem\nactive: true\ndomains_ids:\n  - 1337\nheaders:\n  X-Token: abc\n",
+		"create.yml":  "name: siem\nactive: true\ndomains_ids: [1337]\nheaders: {X-Token: abc}\n",
+		"create":      `{"name": "siem", "active": true, "domains_ids": [1337], "headers": {"X-Token": "abc"}}`,
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+
+		got := request{}
+		require.NoError(t, FlagFileUnmarshalJSON(path, &got), name)
+		require.Equal(t, want, got, name)
+	}
+
+	t.Run("files without a YAML extension are JSON", func(t *testing.T) {
+		path := filepath.Join(dir, "malformed")
+		require.NoError(t, os.WriteFile(path, []byte(`"name": "siem"}`), 0644))
+
+		var syntaxErr *json.SyntaxError
+		require.ErrorAs(t, FlagFileUnmarshalJSON(path, &request{}), &syntaxErr)
+	})
+}
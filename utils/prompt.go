@@ -0,0 +1,45 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+)
+
+var (
+	interactive = true
+	// missingFlags lists the flags of the running command that it would prompt for
+	missingFlags = func() []string { return nil }
+)
+
+// SetInteractive enables or disables the prompts. While disabled, they fail naming the flags
+// returned by missing instead of waiting for an answer that never comes, as in CI pipelines
+func SetInteractive(enabled bool, missing func() []string) {
+	interactive = enabled
+	if missing == nil {
+		missing = func() []string { return nil }
+	}
+	missingFlags = missing
+}
+
+// Interactive tells whether the commands may prompt for the values their flags weren't given
+func Interactive() bool {
+	return interactive
+}
+
+// PromptError is the error the prompt with the given message fails with when prompts are
+// disabled. It names every flag still needed, so that all of them can be fixed at once
+func PromptError(msg string) error {
+	if missing := missingFlags(); len(missing) > 0 {
+		return cmdutil.FlagErrorWrap(fmt.Errorf(ErrorMissingFlags.Error(), strings.Join(missing, ", ")))
+	}
+	return cmdutil.FlagErrorWrap(fmt.Errorf(ErrorMissingInput.Error(), strings.TrimRight(strings.TrimSpace(msg), ":?")))
+}
+
+// confirmError is the error of a confirmation when prompts are disabled. Besides the flags still
+// needed, it asks for --yes, which answers the confirmations
+func confirmError() error {
+	missing := append(missingFlags(), "--yes")
+	return cmdutil.FlagErrorWrap(fmt.Errorf(ErrorMissingFlags.Error(), strings.Join(missing, ", ")))
+}
@@ -0,0 +1,72 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func promptedCmd(args ...string) *cobra.Command {
+	cmd := &cobra.Command{Use: "create", RunE: func(*cobra.Command, []string) error { return nil }}
+	cmd.Flags().String("application-id", "", "")
+	cmd.Flags().String("name", "", "")
+	cmd.Flags().String("file", "", "")
+	cmdutil.MarkPrompted(cmd, "application-id")
+	cmdutil.MarkPromptedUnless(cmd, "file", "name")
+	cmd.SetArgs(args)
+	_ = cmd.Execute()
+	return cmd
+}
+
+func TestNonInteractive(t *testing.T) {
+	t.Cleanup(func() { SetInteractive(true, nil) })
+
+	t.Run("missing flags", func(t *testing.T) {
+		assert.Equal(t, []string{"--application-id", "--name"}, cmdutil.MissingPrompted(promptedCmd()))
+		assert.Equal(t, []string{"--application-id"}, cmdutil.MissingPrompted(promptedCmd("--file", "domain.json")))
+		assert.Empty(t, cmdutil.MissingPrompted(promptedCmd("--application-id", "1234", "--name", "www")))
+	})
+
+	t.Run("prompts fail naming every missing flag", func(t *testing.T) {
+		cmd := promptedCmd("--name", "www")
+		SetInteractive(false, func() []string { return cmdutil.MissingPrompted(cmd) })
+
+		_, err := AskInput("Enter the Edge Application's ID:")
+		require.ErrorContains(t, err, "missing required flag --application-id. ")
+		_, err = Select("Choose a phase", []string{"request", "response"})
+		require.ErrorContains(t, err, "missing required flag --application-id. ")
+	})
+
+	t.Run("prompts without a flag", func(t *testing.T) {
+		SetInteractive(false, nil)
+
+		_, err := AskPassword("Enter the profile's name:")
+		require.ErrorContains(t, err, "missing required input 'Enter the profile's name'. ")
+		_, err = GetPackageManager()
+		require.ErrorContains(t, err, "missing required input 'Choose a package manager'. ")
+	})
+
+	t.Run("confirmations ask for --yes", func(t *testing.T) {
+		SetInteractive(false, nil)
+
+		_, err := Confirm(false, "Do you agree? (Y/n)", true)
+		require.EqualError(t, err, "missing required flag --yes. The CLI doesn't prompt for values when the input isn't a terminal or --no-interactive is used; pass the flags and try again")
+		var flagErr *cmdutil.FlagError
+		require.ErrorAs(t, err, &flagErr)
+
+		confirmed, err := Confirm(true, "Do you want to continue? (y/N)", false)
+		require.NoError(t, err)
+		assert.True(t, confirmed)
+	})
+
+	t.Run("confirmations after missing flags", func(t *testing.T) {
+		cmd := promptedCmd("--name", "www")
+		SetInteractive(false, func() []string { return cmdutil.MissingPrompted(cmd) })
+
+		_, err := Confirm(false, "Do you want to continue? (y/N)", false)
+		require.ErrorContains(t, err, "missing required flag --application-id, --yes. ")
+	})
+}